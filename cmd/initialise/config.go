@@ -10,8 +10,10 @@ import (
 
 type Config struct {
 	Database database.Config
-	Machine  *id.Config
-	Log      *logging.Config
+	// Shards are the databases of the instances routed to other databases
+	Shards  map[string]database.Config
+	Machine *id.Config
+	Log     *logging.Config
 }
 
 func MustNewConfig(v *viper.Viper) *Config {
@@ -57,14 +57,22 @@ The user provided by flags needs privileges to
 }
 
 func InitAll(config *Config) {
-	err := initialise(config.Database,
-		VerifyUser(config.Database.Username(), config.Database.Password()),
-		VerifyDatabase(config.Database.DatabaseName()),
-		VerifyGrant(config.Database.DatabaseName(), config.Database.Username()),
+	initDatabase(config.Database)
+	for name, shard := range config.Shards {
+		logging.WithFields("shard", name).Info("initialize database of shard")
+		initDatabase(shard)
+	}
+}
+
+func initDatabase(config database.Config) {
+	err := initialise(config,
+		VerifyUser(config.Username(), config.Password()),
+		VerifyDatabase(config.DatabaseName()),
+		VerifyGrant(config.DatabaseName(), config.Username()),
 	)
 	logging.OnError(err).Fatal("unable to initialize the database")
 
-	err = verifyZitadel(config.Database)
+	err = verifyZitadel(config)
 	logging.OnError(err).Fatal("unable to initialize ZITADEL")
 }
 
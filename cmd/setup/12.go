@@ -0,0 +1,23 @@
+package setup
+
+import (
+	"embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	//go:embed 12/cockroach/index.sql
+	//go:embed 12/postgres/index.sql
+	stmts12 embed.FS
+)
+
+func New12(db *database.DB) *EventstoreIndexesNew {
+	return &EventstoreIndexesNew{
+		dbClient: db,
+		name:     "12_events_created_at_index",
+		step:     "12",
+		fileName: "index.sql",
+		stmts:    stmts12,
+	}
+}
@@ -0,0 +1,2 @@
+-- used to poll for events of other nodes
+CREATE INDEX IF NOT EXISTS created_at ON eventstore.events (created_at) STORING (aggregate_type);
@@ -0,0 +1,2 @@
+-- used to poll for events of other nodes if listening is not possible
+CREATE INDEX IF NOT EXISTS created_at ON eventstore.events (created_at) INCLUDE (instance_id, aggregate_type);
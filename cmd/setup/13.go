@@ -0,0 +1,25 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+)
+
+var (
+	//go:embed 13/snapshots.sql
+	createSnapshotsTable13 string
+)
+
+type SnapshotsTable struct {
+	dbClient *sql.DB
+}
+
+func (mig *SnapshotsTable) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createSnapshotsTable13)
+	return err
+}
+
+func (mig *SnapshotsTable) String() string {
+	return "13_eventstore_snapshots"
+}
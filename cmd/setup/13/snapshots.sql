@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+    instance_id TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+    , snapshot_type TEXT NOT NULL
+    , resource_owner TEXT NOT NULL
+    , version TEXT NOT NULL
+    , sequence BIGINT NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , state JSONB NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (instance_id, aggregate_id, snapshot_type)
+);
@@ -0,0 +1,25 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+)
+
+var (
+	//go:embed 14/archive.sql
+	createArchiveTables14 string
+)
+
+type ArchiveTables struct {
+	dbClient *sql.DB
+}
+
+func (mig *ArchiveTables) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createArchiveTables14)
+	return err
+}
+
+func (mig *ArchiveTables) String() string {
+	return "14_eventstore_archive"
+}
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS eventstore.events_archive (
+    id UUID NOT NULL
+    , event_type TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+    , aggregate_version TEXT NOT NULL
+    , event_sequence BIGINT NOT NULL
+    , previous_aggregate_sequence BIGINT
+    , previous_aggregate_type_sequence INT8
+    , creation_date TIMESTAMPTZ NOT NULL
+    , event_data JSONB
+    , editor_user TEXT NOT NULL
+    , editor_service TEXT NOT NULL
+    , resource_owner TEXT NOT NULL
+    , instance_id TEXT NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+    , archived_at TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (instance_id, event_sequence)
+    , INDEX archive_agg (instance_id, aggregate_type, aggregate_id)
+);
+
+CREATE TABLE IF NOT EXISTS eventstore.archive_reports (
+    instance_id TEXT NOT NULL
+    , archived_at TIMESTAMPTZ NOT NULL
+    , policy TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , event_type TEXT NOT NULL
+    , event_count BIGINT NOT NULL
+    , oldest_event TIMESTAMPTZ NOT NULL
+    , newest_event TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (instance_id, archived_at, policy, aggregate_type, event_type)
+);
+
+CREATE TABLE IF NOT EXISTS eventstore.archived_sequences (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , sequence BIGINT NOT NULL
+
+    , PRIMARY KEY (instance_id, aggregate_type)
+);
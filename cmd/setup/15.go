@@ -0,0 +1,33 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+var (
+	//go:embed 15/auth_views.sql
+	migrateAuthViews15 string
+	//go:embed 15/sequences.sql
+	migrateViewSequences15 string
+)
+
+type MigrateAuthViews struct {
+	dbClient *sql.DB
+}
+
+func (mig *MigrateAuthViews) Execute(ctx context.Context) error {
+	// the projection tables are created by the projections themselves
+	if err := projection.Init(ctx); err != nil {
+		return err
+	}
+	_, err := mig.dbClient.ExecContext(ctx, migrateAuthViews15+migrateViewSequences15)
+	return err
+}
+
+func (mig *MigrateAuthViews) String() string {
+	return "15_auth_view_projections"
+}
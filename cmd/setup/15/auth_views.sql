@@ -0,0 +1,47 @@
+INSERT INTO projections.auth_users (
+    id, creation_date, change_date, resource_owner, user_state, password_set, password_change_required, password_change
+    , last_login, user_name, login_names, preferred_login_name, first_name, last_name, nick_name, display_name
+    , preferred_language, gender, email, is_email_verified, phone, is_phone_verified, country, locality, postal_code
+    , region, street_address, otp_state, mfa_max_set_up, mfa_init_skipped, sequence, init_required
+    , username_change_required, machine_name, machine_description, user_type, u2f_tokens, passwordless_tokens
+    , avatar_key, passwordless_init_required, password_init_required, instance_id, owner_removed
+) SELECT
+    id, creation_date, change_date, resource_owner, user_state, password_set, password_change_required, password_change
+    , last_login, user_name, login_names, preferred_login_name, first_name, last_name, nick_name, display_name
+    , preferred_language, gender, email, is_email_verified, phone, is_phone_verified, country, locality, postal_code
+    , region, street_address, otp_state, mfa_max_set_up, mfa_init_skipped, sequence, init_required
+    , username_change_required, machine_name, machine_description, user_type, u2f_tokens, passwordless_tokens
+    , avatar_key, passwordless_init_required, password_init_required, instance_id, COALESCE(owner_removed, false)
+FROM auth.users2
+ON CONFLICT DO NOTHING;
+
+INSERT INTO projections.auth_user_sessions (
+    creation_date, change_date, resource_owner, state, user_agent_id, user_id, user_name, password_verification
+    , second_factor_verification, multi_factor_verification, sequence, second_factor_verification_type
+    , multi_factor_verification_type, user_display_name, login_name, external_login_verification
+    , selected_idp_config_id, passwordless_verification, avatar_key, instance_id
+) SELECT
+    creation_date, change_date, resource_owner, state, user_agent_id, user_id, user_name, password_verification
+    , second_factor_verification, multi_factor_verification, sequence, second_factor_verification_type
+    , multi_factor_verification_type, user_display_name, login_name, external_login_verification
+    , selected_idp_config_id, passwordless_verification, avatar_key, instance_id
+FROM auth.user_sessions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO projections.auth_tokens (
+    id, creation_date, change_date, sequence, resource_owner, instance_id, user_id, application_id, user_agent_id
+    , expiration, scopes, audience, preferred_language, refresh_token_id, is_pat
+) SELECT
+    id, creation_date, change_date, sequence, resource_owner, instance_id, user_id, application_id, user_agent_id
+    , expiration, scopes, audience, preferred_language, refresh_token_id, is_pat
+FROM auth.tokens
+ON CONFLICT DO NOTHING;
+
+INSERT INTO projections.auth_refresh_tokens (
+    id, creation_date, change_date, sequence, resource_owner, instance_id, token, user_id, client_id, user_agent_id
+    , audience, scopes, amr, auth_time, idle_expiration, expiration
+) SELECT
+    id, creation_date, change_date, sequence, resource_owner, instance_id, token, user_id, client_id, user_agent_id
+    , audience, scopes, amr, auth_time, idle_expiration, expiration
+FROM auth.refresh_tokens
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,36 @@
+INSERT INTO projections.current_sequences (projection_name, aggregate_type, current_sequence, instance_id, timestamp)
+SELECT p.projection_name, p.aggregate_type, COALESCE((
+    -- the projections store the sequence of the last event per aggregate type,
+    -- the view stored the sequence of the last event of all aggregate types
+    SELECT MAX(e.event_sequence) FROM eventstore.events e
+    WHERE e.instance_id = s.instance_id AND e.aggregate_type = p.aggregate_type AND e.event_sequence <= s.current_sequence
+), 0), s.instance_id, s.last_successful_spooler_run
+FROM (VALUES
+    ('auth.users2', 'projections.auth_users', 'user')
+    , ('auth.users2', 'projections.auth_users', 'org')
+    , ('auth.users2', 'projections.auth_users', 'instance')
+    , ('auth.user_sessions', 'projections.auth_user_sessions', 'user')
+    , ('auth.user_sessions', 'projections.auth_user_sessions', 'org')
+    , ('auth.user_sessions', 'projections.auth_user_sessions', 'instance')
+    , ('auth.tokens', 'projections.auth_tokens', 'user')
+    , ('auth.tokens', 'projections.auth_tokens', 'project')
+    , ('auth.tokens', 'projections.auth_tokens', 'org')
+    , ('auth.tokens', 'projections.auth_tokens', 'instance')
+    , ('auth.refresh_tokens', 'projections.auth_refresh_tokens', 'user')
+    , ('auth.refresh_tokens', 'projections.auth_refresh_tokens', 'org')
+    , ('auth.refresh_tokens', 'projections.auth_refresh_tokens', 'instance')
+) AS p (view_name, projection_name, aggregate_type)
+JOIN auth.current_sequences s ON s.view_name = p.view_name
+ON CONFLICT DO NOTHING;
+
+INSERT INTO projections.current_sequences (projection_name, aggregate_type, current_sequence, instance_id, timestamp)
+SELECT p.projection_name, p.aggregate_type, COALESCE((
+    SELECT MAX(e.event_sequence) FROM eventstore.events e
+    WHERE e.instance_id = s.instance_id AND e.aggregate_type = p.aggregate_type AND e.event_sequence <= s.current_sequence
+), 0), s.instance_id, s.last_successful_spooler_run
+FROM (VALUES
+    ('adminapi.styling2', 'projections.styling', 'org')
+    , ('adminapi.styling2', 'projections.styling', 'instance')
+) AS p (view_name, projection_name, aggregate_type)
+JOIN adminapi.current_sequences s ON s.view_name = p.view_name
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+)
+
+var (
+	//go:embed 16/instance_shards.sql
+	createInstanceShardsTable16 string
+)
+
+// InstanceShardsTable creates the routing of the instances to the shards,
+// it is only stored in the default database
+type InstanceShardsTable struct {
+	dbClient *sql.DB
+}
+
+func (mig *InstanceShardsTable) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createInstanceShardsTable16)
+	return err
+}
+
+func (mig *InstanceShardsTable) String() string {
+	return "16_instance_shards"
+}
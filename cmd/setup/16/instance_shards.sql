@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS system.instance_shards (
+    instance_id TEXT NOT NULL
+    , shard TEXT NOT NULL
+    , moving BOOLEAN NOT NULL DEFAULT FALSE
+
+    , PRIMARY KEY (instance_id)
+);
@@ -31,6 +31,7 @@ type Config struct {
 	DefaultInstance command.InstanceSetup
 	Machine         *id.Config
 	Projections     projection.Config
+	Shards          map[string]database.Config
 }
 
 func MustNewConfig(v *viper.Viper) *Config {
@@ -56,22 +57,29 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable    *ProjectionTable
-	s2AssetsTable        *AssetTable
-	FirstInstance        *FirstInstance
-	s4EventstoreIndexes  *EventstoreIndexesNew
-	s5LastFailed         *LastFailed
-	s6OwnerRemoveColumns *OwnerRemoveColumns
-	s7LogstoreTables     *LogstoreTables
-	s8AuthTokens         *AuthTokenIndexes
-	s9EventstoreIndexes2 *EventstoreIndexesNew
-	CorrectCreationDate  *CorrectCreationDate
-	s11AddEventCreatedAt *AddEventCreatedAt
+	s1ProjectionTable      *ProjectionTable
+	s2AssetsTable          *AssetTable
+	FirstInstance          *FirstInstance
+	s4EventstoreIndexes    *EventstoreIndexesNew
+	s5LastFailed           *LastFailed
+	s6OwnerRemoveColumns   *OwnerRemoveColumns
+	s7LogstoreTables       *LogstoreTables
+	s8AuthTokens           *AuthTokenIndexes
+	s9EventstoreIndexes2   *EventstoreIndexesNew
+	CorrectCreationDate    *CorrectCreationDate
+	s11AddEventCreatedAt   *AddEventCreatedAt
+	s12EventstoreIndexes   *EventstoreIndexesNew
+	s13SnapshotsTable      *SnapshotsTable
+	s14ArchiveTables       *ArchiveTables
+	s15MigrateAuthViews    *MigrateAuthViews
+	s16InstanceShardsTable *InstanceShardsTable
 }
 
 type encryptionKeyConfig struct {
 	User *crypto.KeyConfig
 	SMTP *crypto.KeyConfig
+	OIDC *crypto.KeyConfig
+	SAML *crypto.KeyConfig
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -10,16 +10,38 @@ import (
 type projectionTables struct {
 	es             *eventstore.Eventstore
 	currentVersion string
+	currentShards  []string
 
 	Version string `json:"version"`
+	// Shards are the names of the databases the projections are initialized in
+	Shards []string `json:"shards"`
 }
 
 func (mig *projectionTables) SetLastExecution(lastRun map[string]interface{}) {
 	mig.currentVersion, _ = lastRun["version"].(string)
+	shards, _ := lastRun["shards"].([]interface{})
+	mig.currentShards = make([]string, 0, len(shards))
+	for _, shard := range shards {
+		name, _ := shard.(string)
+		mig.currentShards = append(mig.currentShards, name)
+	}
 }
 
+// Check initializes the projections if the version changed or shards were added
 func (mig *projectionTables) Check() bool {
-	return mig.currentVersion != mig.Version
+	if mig.currentVersion != mig.Version {
+		return true
+	}
+	initialized := make(map[string]bool, len(mig.currentShards))
+	for _, shard := range mig.currentShards {
+		initialized[shard] = true
+	}
+	for _, shard := range mig.Shards {
+		if !initialized[shard] {
+			return true
+		}
+	}
+	return false
 }
 
 func (mig *projectionTables) Execute(ctx context.Context) error {
@@ -0,0 +1,127 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	"github.com/zitadel/zitadel/internal/crypto"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+func NewProjections() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projections",
+		Short: "inspects and rebuilds projections",
+		Long:  `inspects and rebuilds projections`,
+	}
+	cmd.AddCommand(newProjectionsList(), newProjectionsRebuild())
+	key.AddMasterKeyFlag(cmd)
+	return cmd
+}
+
+func newProjectionsList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "lists the projections with their current sequence and lag per instance",
+		Long: `lists the projections with their current sequence and lag per instance.
+The lag is the count of sequences of the instance between the latest event the projection handles and the last reduced event.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			logging.OnError(err).Panic("No master key provided")
+
+			ctx := context.Background()
+			mustCreateProjections(ctx, config, masterKey)
+
+			statuses, err := projection.Status(ctx)
+			logging.OnError(err).Fatal("unable to query status of projections")
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "PROJECTION\tINSTANCE\tSEQUENCE\tLATEST\tLAG\tLAST RUN")
+			for _, status := range statuses {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\n",
+					status.ProjectionName,
+					status.InstanceID,
+					status.CurrentSequence,
+					status.LatestSequence,
+					status.Lag(),
+					status.LastRun.Format(time.RFC3339),
+				)
+			}
+			logging.OnError(w.Flush()).Fatal("unable to print status of projections")
+		},
+	}
+}
+
+func newProjectionsRebuild() *cobra.Command {
+	var all bool
+	cmd := &cobra.Command{
+		Use:   "rebuild [projection...]",
+		Short: "rebuilds projections",
+		Long: `rebuilds the given projections, e.g. "zitadel projections rebuild orgs users" or all projections using --all.
+The events are reduced into shadow tables, which replace the tables of the projection as soon as they caught up.
+The projections stay readable during the rebuild.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if all == (len(args) > 0) {
+				logging.Fatal("provide either the names of the projections or --all")
+			}
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			logging.OnError(err).Panic("No master key provided")
+
+			ctx := context.Background()
+			mustCreateProjections(ctx, config, masterKey)
+
+			names := args
+			if all {
+				names = projection.Names()
+			}
+			for _, name := range names {
+				logging.WithFields("projection", name).Info("rebuild started")
+				err = projection.Rebuild(ctx, name)
+				logging.WithFields("projection", name).OnError(err).Fatal("rebuild failed")
+				logging.WithFields("projection", name).Info("rebuild done")
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&all, "all", false, "rebuild all projections")
+	return cmd
+}
+
+// mustCreateProjections creates the projections of all shards without starting them
+func mustCreateProjections(ctx context.Context, config *Config, masterKey string) {
+	dbClient, err := database.Connect(config.Database, false)
+	logging.OnError(err).Fatal("unable to connect to database")
+	err = dbClient.ConnectShards(config.Shards)
+	logging.OnError(err).Fatal("unable to connect to databases of shards")
+
+	keyStorage, err := crypto_db.NewKeyStorage(dbClient.DB, masterKey)
+	logging.OnError(err).Fatal("unable to start key storage")
+
+	es, err := eventstore.Start(&eventstore.Config{
+		Client:       dbClient,
+		PersonalData: crypto.NewPersonalDataEncryption(keyStorage),
+	})
+	logging.OnError(err).Fatal("unable to start eventstore")
+	query.RegisterEventMappers(es)
+
+	keyEncryption, err := crypto.NewAESCrypto(config.EncryptionKeys.OIDC, keyStorage)
+	logging.OnError(err).Fatal("unable to create oidc key encryption")
+	certEncryption, err := crypto.NewAESCrypto(config.EncryptionKeys.SAML, keyStorage)
+	logging.OnError(err).Fatal("unable to create saml key encryption")
+
+	err = projection.Create(ctx, dbClient, es, config.Projections, keyEncryption, certEncryption)
+	logging.OnError(err).Fatal("unable to create projections")
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/zitadel/zitadel/cmd/build"
 	"github.com/zitadel/zitadel/cmd/key"
 	"github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/crypto"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/migration"
@@ -64,8 +66,16 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 
 	dbClient, err := database.Connect(config.Database, false)
 	logging.OnError(err).Fatal("unable to connect to database")
+	err = dbClient.ConnectShards(config.Shards)
+	logging.OnError(err).Fatal("unable to connect to databases of shards")
 
-	eventstoreClient, err := eventstore.Start(&eventstore.Config{Client: dbClient})
+	keyStorage, err := crypto_db.NewKeyStorage(dbClient.DB, masterKey)
+	logging.OnError(err).Fatal("unable to start key storage")
+
+	eventstoreClient, err := eventstore.Start(&eventstore.Config{
+		Client:       dbClient,
+		PersonalData: crypto.NewPersonalDataEncryption(keyStorage),
+	})
 	logging.OnError(err).Fatal("unable to start eventstore")
 	migration.RegisterMappers(eventstoreClient)
 
@@ -92,6 +102,11 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	steps.s9EventstoreIndexes2 = New09(dbClient)
 	steps.CorrectCreationDate.dbClient = dbClient
 	steps.s11AddEventCreatedAt = &AddEventCreatedAt{dbClient: dbClient, step10: steps.CorrectCreationDate}
+	steps.s12EventstoreIndexes = New12(dbClient)
+	steps.s13SnapshotsTable = &SnapshotsTable{dbClient: dbClient.DB}
+	steps.s14ArchiveTables = &ArchiveTables{dbClient: dbClient.DB}
+	steps.s15MigrateAuthViews = &MigrateAuthViews{dbClient: dbClient.DB}
+	steps.s16InstanceShardsTable = &InstanceShardsTable{dbClient: dbClient.DB}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -106,9 +121,13 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 		&projectionTables{
 			es:      eventstoreClient,
 			Version: build.Version(),
+			Shards:  dbClient.ShardNames(),
 		},
 	}
 
+	// the projections of the shards are initialized with the projections of the default database
+	setupShards(ctx, dbClient, eventstoreClient, steps)
+
 	err = migration.Migrate(ctx, eventstoreClient, steps.s1ProjectionTable)
 	logging.OnError(err).Fatal("unable to migrate step 1")
 	err = migration.Migrate(ctx, eventstoreClient, steps.s2AssetsTable)
@@ -131,6 +150,16 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	logging.OnError(err).Fatal("unable to migrate step 10")
 	err = migration.Migrate(ctx, eventstoreClient, steps.s11AddEventCreatedAt)
 	logging.OnError(err).Fatal("unable to migrate step 11")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s12EventstoreIndexes)
+	logging.OnError(err).Fatal("unable to migrate step 12")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s13SnapshotsTable)
+	logging.OnError(err).Fatal("unable to migrate step 13")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s14ArchiveTables)
+	logging.OnError(err).Fatal("unable to migrate step 14")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s15MigrateAuthViews)
+	logging.OnError(err).Fatal("unable to migrate step 15")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s16InstanceShardsTable)
+	logging.OnError(err).Fatal("unable to migrate step 16")
 
 	for _, repeatableStep := range repeatableSteps {
 		err = migration.Migrate(ctx, eventstoreClient, repeatableStep)
@@ -0,0 +1,53 @@
+package setup
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/migration"
+)
+
+// shardMigration executes a migration in the database of a shard,
+// the executions of the migrations of all shards are stored in the default database
+type shardMigration struct {
+	migration.Migration
+	shard string
+}
+
+func (mig *shardMigration) String() string {
+	return mig.Migration.String() + "_" + mig.shard
+}
+
+// setupShards migrates the schema of the databases of the shards.
+// Instances are only set up in the default database and moved to the shards using the system API,
+// the logs and the routing of the instances are only stored in the default database.
+func setupShards(ctx context.Context, dbClient *database.DB, eventstoreClient *eventstore.Eventstore, steps *Steps) {
+	for _, shard := range dbClient.ShardNames() {
+		if shard == "" {
+			continue
+		}
+		shardClient := dbClient.Shard(database.WithShard(ctx, shard))
+		correctCreationDate := &CorrectCreationDate{dbClient: shardClient, FailAfter: steps.CorrectCreationDate.FailAfter}
+		migrations := []migration.Migration{
+			&ProjectionTable{dbClient: shardClient.DB},
+			&AssetTable{dbClient: shardClient.DB},
+			New04(shardClient),
+			&LastFailed{dbClient: shardClient.DB},
+			&OwnerRemoveColumns{dbClient: shardClient.DB},
+			&AuthTokenIndexes{dbClient: shardClient},
+			New09(shardClient),
+			correctCreationDate,
+			&AddEventCreatedAt{dbClient: shardClient, step10: correctCreationDate},
+			New12(shardClient),
+			&SnapshotsTable{dbClient: shardClient.DB},
+			&ArchiveTables{dbClient: shardClient.DB},
+		}
+		for _, mig := range migrations {
+			err := migration.Migrate(ctx, eventstoreClient, &shardMigration{Migration: mig, shard: shard})
+			logging.WithFields("shard", shard).OnError(err).Fatalf("unable to migrate step %s", mig.String())
+		}
+	}
+}
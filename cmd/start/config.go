@@ -16,6 +16,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
+	cache_config "github.com/zitadel/zitadel/internal/cache/config"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/hook"
 	"github.com/zitadel/zitadel/internal/config/network"
@@ -23,12 +24,16 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/archive"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idp/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/shard"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
 	tracing "github.com/zitadel/zitadel/internal/telemetry/tracing/config"
+	"github.com/zitadel/zitadel/internal/usergrant"
 )
 
 type Config struct {
@@ -42,6 +47,8 @@ type Config struct {
 	HTTP1HostHeader   string
 	WebAuthNName      string
 	Database          database.Config
+	Shards            map[string]database.Config
+	InstanceMove      *shard.Config
 	Tracing           tracing.Config
 	Metrics           metrics.Config
 	Projections       projection.Config
@@ -65,12 +72,20 @@ type Config struct {
 	Eventstore        *eventstore.Config
 	LogStore          *logstore.Configs
 	Quotas            *QuotasConfig
+	UserGrants        *UserGrantsConfig
+	LDAPSync          *ldapsync.Config
+	EventArchive      *archive.Config
+	Caches            *cache_config.Connectors
 }
 
 type QuotasConfig struct {
 	Access *middleware.AccessConfig
 }
 
+type UserGrantsConfig struct {
+	Expiration *usergrant.ExpirationConfig
+}
+
 func MustNewConfig(v *viper.Viper) *Config {
 	config := new(Config)
 
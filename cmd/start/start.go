@@ -29,6 +29,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/events"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
 	"github.com/zitadel/zitadel/internal/api/grpc/auth"
 	"github.com/zitadel/zitadel/internal/api/grpc/management"
@@ -53,14 +54,18 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/archive"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idp/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/execution"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/stdout"
 	"github.com/zitadel/zitadel/internal/notification"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/shard"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/usergrant"
 	"github.com/zitadel/zitadel/internal/webauthn"
 	"github.com/zitadel/zitadel/openapi"
 )
@@ -109,12 +114,17 @@ type Server struct {
 }
 
 func startZitadel(config *Config, masterKey string, server chan<- *Server) error {
-	ctx := context.Background()
+	// ctx is cancelled after the server shut down
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	dbClient, err := database.Connect(config.Database, false)
 	if err != nil {
 		return fmt.Errorf("cannot start client for projection: %w", err)
 	}
+	if err = dbClient.ConnectShards(config.Shards); err != nil {
+		return fmt.Errorf("cannot start clients for shards: %w", err)
+	}
 
 	keyStorage, err := cryptoDB.NewKeyStorage(dbClient.DB, masterKey)
 	if err != nil {
@@ -126,13 +136,21 @@ func startZitadel(config *Config, masterKey string, server chan<- *Server) error
 	}
 
 	config.Eventstore.Client = dbClient
+	config.Eventstore.PersonalData = crypto.NewPersonalDataEncryption(keyStorage)
+	config.Eventstore.ReadArchive = config.EventArchive != nil && config.EventArchive.Enabled
 	eventstoreClient, err := eventstore.Start(config.Eventstore)
 	if err != nil {
 		return fmt.Errorf("cannot start eventstore for queries: %w", err)
 	}
+	eventstoreClient.ListenNodes(ctx)
 
 	sessionTokenVerifier := internal_authz.SessionTokenVerifier(keys.OIDC)
 
+	queryCache, err := config.Caches.NewCache()
+	if err != nil {
+		return fmt.Errorf("cannot start cache for queries: %w", err)
+	}
+
 	queries, err := query.StartQueries(
 		ctx,
 		eventstoreClient,
@@ -145,12 +163,13 @@ func startZitadel(config *Config, masterKey string, server chan<- *Server) error
 		keys.SAML,
 		config.InternalAuthZ.RolePermissionMappings,
 		sessionTokenVerifier,
+		queryCache,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot start queries: %w", err)
 	}
 
-	authZRepo, err := authz.Start(queries, dbClient, keys.OIDC, config.ExternalSecure, config.Eventstore.AllowOrderByCreationDate)
+	authZRepo, err := authz.Start(queries, dbClient, keys.OIDC, config.ExternalSecure, config.Eventstore.AllowOrderByCreationDate, config.Eventstore.PersonalData)
 	if err != nil {
 		return fmt.Errorf("error starting authz repo: %w", err)
 	}
@@ -158,7 +177,15 @@ func startZitadel(config *Config, masterKey string, server chan<- *Server) error
 		return internal_authz.CheckPermission(ctx, authZRepo, config.InternalAuthZ.RolePermissionMappings, permission, orgID, resourceID)
 	}
 
-	storage, err := config.AssetStorage.NewStorage(dbClient.DB)
+	loginAttempts := func(ctx context.Context, keyType domain.LoginBlockKeyType, key string, since time.Time) (uint64, time.Time, error) {
+		attempts, err := queries.LoginAttempts(ctx, true, keyType, key, since)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		return attempts.Failures, attempts.LastFailure, nil
+	}
+
+	storage, err := config.AssetStorage.NewStorage(dbClient)
 	if err != nil {
 		return fmt.Errorf("cannot start asset storage client: %w", err)
 	}
@@ -186,6 +213,7 @@ func startZitadel(config *Config, masterKey string, server chan<- *Server) error
 		&http.Client{},
 		permissionCheck,
 		sessionTokenVerifier,
+		loginAttempts,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot start commands: %w", err)
@@ -209,6 +237,9 @@ func startZitadel(config *Config, masterKey string, server chan<- *Server) error
 	actions.SetLogstoreService(actionsLogstoreSvc)
 
 	notification.Start(ctx, config.Projections.Customizations["notifications"], config.Projections.Customizations["notificationsquotas"], config.ExternalPort, config.ExternalSecure, commands, queries, eventstoreClient, assets.AssetAPIFromDomain(config.ExternalSecure, config.ExternalPort), config.SystemDefaults.Notifications.FileSystemPath, keys.User, keys.SMTP, keys.SMS)
+	usergrant.StartExpiration(ctx, config.UserGrants.Expiration, commands, queries, dbClient)
+	ldapsync.Start(ctx, config.LDAPSync, commands, queries, dbClient, keys.User)
+	archive.Start(ctx, config.EventArchive, dbClient)
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -316,11 +347,11 @@ func startAPIs(
 	if err != nil {
 		return fmt.Errorf("error starting auth repo: %w", err)
 	}
-	adminRepo, err := admin_es.Start(ctx, config.Admin, store, dbClient, eventstore, config.Eventstore.AllowOrderByCreationDate)
+	adminRepo, err := admin_es.Start(ctx, config.Admin, store, dbClient, eventstore, config.Projections.Customizations["styling"])
 	if err != nil {
 		return fmt.Errorf("error starting admin repo: %w", err)
 	}
-	if err := apis.RegisterServer(ctx, system.CreateServer(commands, queries, adminRepo, config.Database.DatabaseName(), config.DefaultInstance, config.ExternalDomain)); err != nil {
+	if err := apis.RegisterServer(ctx, system.CreateServer(commands, queries, adminRepo, config.Database.DatabaseName(), config.DefaultInstance, config.ExternalDomain, shard.NewMover(dbClient, config.InstanceMove, queries.InstanceRoutingChanged))); err != nil {
 		return err
 	}
 	if err := apis.RegisterServer(ctx, admin.CreateServer(config.Database.DatabaseName(), commands, queries, config.SystemDefaults, adminRepo, config.ExternalSecure, keys.User, config.AuditLogRetention)); err != nil {
@@ -332,7 +363,7 @@ func startAPIs(
 	if err := apis.RegisterServer(ctx, auth.CreateServer(commands, queries, authRepo, config.SystemDefaults, keys.User, config.ExternalSecure, config.AuditLogRetention)); err != nil {
 		return err
 	}
-	if err := apis.RegisterService(ctx, user.CreateServer(commands, queries, keys.User, keys.IDPConfig, idp.CallbackURL(config.ExternalSecure))); err != nil {
+	if err := apis.RegisterService(ctx, user.CreateServer(commands, queries, keys.User, keys.IDPConfig, idp.CallbackURL(config.ExternalSecure), assets.AssetAPI(config.ExternalSecure), permissionCheck)); err != nil {
 		return err
 	}
 	if err := apis.RegisterService(ctx, session.CreateServer(commands, queries, permissionCheck)); err != nil {
@@ -346,6 +377,7 @@ func startAPIs(
 	apis.RegisterHandlerOnPrefix(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, middleware.CallDurationHandler, instanceInterceptor.Handler, assetsCache.Handler, limitingAccessInterceptor.Handle))
 
 	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, config.ExternalSecure, instanceInterceptor.Handler))
+	apis.RegisterHandlerOnPrefix(events.HandlerPrefix, events.NewHandler(queries, verifier, config.InternalAuthZ, config.AuditLogRetention, instanceInterceptor.Handler))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources)
 	if err != nil {
@@ -370,6 +402,8 @@ func startAPIs(
 	if err != nil {
 		return fmt.Errorf("unable to start oidc provider: %w", err)
 	}
+	// the client registration must be registered before the provider, which handles all other /oauth/v2 endpoints
+	apis.RegisterHandlerOnPrefix(oidc.ClientRegistrationHandlerPrefix, oidc.NewClientRegistrationHandler(commands, queries, crypto.NewBCrypt(config.SystemDefaults.SecretGenerators.PasswordSaltCost), config.ExternalSecure, instanceInterceptor.Handler))
 	apis.RegisterHandlerPrefixes(oidcProvider.HttpHandler(), "/.well-known/openid-configuration", "/oidc/v1", "/oauth/v2")
 
 	samlProvider, err := saml.NewProvider(config.SAML, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.SAML, eventstore, dbClient, instanceInterceptor.Handler, userAgentInterceptor, limitingAccessInterceptor.Handle)
@@ -51,6 +51,7 @@ func New(out io.Writer, in io.Reader, args []string, server chan<- *start.Server
 		admin.New(), //is now deprecated, remove later on
 		initialise.New(),
 		setup.New(),
+		setup.NewProjections(),
 		start.New(server),
 		start.NewStartFromInit(server),
 		start.NewStartFromSetup(server),
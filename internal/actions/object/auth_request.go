@@ -56,6 +56,7 @@ func AuthRequestFromDomain(c *actions.FieldConfig, request *domain.AuthRequest)
 		MfasVerified:             request.MFAsVerified,
 		Audience:                 request.Audience,
 		AuthTime:                 request.AuthTime,
+		RiskAssessment:           riskAssessmentFromDomain(request.RiskAssessment),
 	})
 }
 
@@ -97,6 +98,7 @@ type authRequest struct {
 	MfasVerified             []domain.MFAType
 	Audience                 []string
 	AuthTime                 time.Time
+	RiskAssessment           *riskAssessment
 }
 
 func browserInfoFromDomain(info *domain.BrowserInfo) *browserInfo {
@@ -110,6 +112,19 @@ func browserInfoFromDomain(info *domain.BrowserInfo) *browserInfo {
 	}
 }
 
+func riskAssessmentFromDomain(assessment *domain.RiskAssessment) *riskAssessment {
+	if assessment == nil {
+		return nil
+	}
+	return &riskAssessment{
+		Level:          assessment.Level,
+		Signals:        assessment.Signals,
+		Country:        assessment.Country,
+		EvaluatedAt:    assessment.EvaluatedAt,
+		StepUpRequired: assessment.StepUpRequired(),
+	}
+}
+
 func requestFromDomain(req domain.Request) *request {
 	r := new(request)
 
@@ -133,3 +148,11 @@ type browserInfo struct {
 	AcceptLanguage string
 	RemoteIp       net.IP
 }
+
+type riskAssessment struct {
+	Level          domain.RiskLevel
+	Signals        []domain.RiskSignal
+	Country        string
+	EvaluatedAt    time.Time
+	StepUpRequired bool
+}
@@ -9,171 +9,150 @@ import (
 
 	"github.com/lucasb-eyer/go-colorful"
 	"github.com/muesli/gamut"
-	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
-	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
-	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
-	"github.com/zitadel/zitadel/internal/eventstore/v1/query"
-	"github.com/zitadel/zitadel/internal/eventstore/v1/spooler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
 	iam_model "github.com/zitadel/zitadel/internal/iam/repository/view/model"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/static"
 )
 
 const (
-	stylingTable = "adminapi.styling2"
+	StylingProjectionTable = "projections.styling"
 )
 
+// Styling generates the css file of the activated label policy and uploads it to the static storage
+// it has no projection table
 type Styling struct {
-	handler
-	static       static.Storage
-	subscription *v1.Subscription
+	crdb.StatementHandler
+	static static.Storage
 }
 
-func newStyling(ctx context.Context, handler handler, static static.Storage) *Styling {
-	h := &Styling{
-		handler: handler,
-		static:  static,
-	}
-	h.subscribe(ctx)
-
-	return h
-}
-
-func (m *Styling) subscribe(ctx context.Context) {
-	m.subscription = m.es.Subscribe(m.AggregateTypes()...)
-	go func() {
-		for event := range m.subscription.Events {
-			query.ReduceEvent(ctx, m, event)
-		}
-	}()
-}
-
-func (m *Styling) ViewModel() string {
-	return stylingTable
-}
-
-func (m *Styling) Subscription() *v1.Subscription {
-	return m.subscription
-}
-
-func (_ *Styling) AggregateTypes() []models.AggregateType {
-	return []models.AggregateType{org.AggregateType, instance.AggregateType}
+func NewStyling(ctx context.Context, config crdb.StatementHandlerConfig, static static.Storage) *Styling {
+	p := new(Styling)
+	config.ProjectionName = StylingProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	p.static = static
+	projection.StylingProjection = p
+	return p
 }
 
-func (m *Styling) CurrentSequence(ctx context.Context, instanceID string) (uint64, error) {
-	sequence, err := m.view.GetLatestStylingSequence(ctx, instanceID)
-	if err != nil {
-		return 0, err
+func (m *Styling) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.LabelPolicyActivatedEventType,
+					Reduce: m.reduceLabelPolicyActivated,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.LabelPolicyActivatedEventType,
+					Reduce: m.reduceLabelPolicyActivated,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: m.reduceInstanceRemoved,
+				},
+			},
+		},
 	}
-	return sequence.CurrentSequence, nil
 }
 
-func (m *Styling) EventQuery(ctx context.Context, instanceIDs []string) (*models.SearchQuery, error) {
-	sequences, err := m.view.GetLatestStylingSequences(ctx, instanceIDs)
+func (m *Styling) reduceLabelPolicyActivated(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *org.LabelPolicyActivatedEvent, *instance.LabelPolicyActivatedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sb3kQ", "reduce.wrong.event.type %v", []eventstore.EventType{org.LabelPolicyActivatedEventType, instance.LabelPolicyActivatedEventType})
+	}
+	policy, err := m.labelPolicy(event)
 	if err != nil {
 		return nil, err
 	}
-	searchQuery := models.NewSearchQuery()
-	for _, instanceID := range instanceIDs {
-		var seq uint64
-		for _, sequence := range sequences {
-			if sequence.InstanceID == instanceID {
-				seq = sequence.CurrentSequence
-				break
-			}
-		}
-		searchQuery.AddQuery().
-			AggregateTypeFilter(m.AggregateTypes()...).
-			LatestSequenceFilter(seq).
-			InstanceIDFilter(instanceID)
+	if err = m.generateStylingFile(policy); err != nil {
+		return nil, err
 	}
-	return searchQuery, nil
+	return crdb.NewNoOpStatement(event), nil
 }
 
-func (m *Styling) Reduce(event *models.Event) (err error) {
-	switch event.AggregateType {
-	case org.AggregateType, instance.AggregateType:
-		err = m.processLabelPolicy(event)
+func (m *Styling) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.InstanceRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Pj7wR", "reduce.wrong.event.type %s", instance.InstanceRemovedEventType)
 	}
-	return err
+	if err := m.deleteInstanceFilesFromStorage(e.Aggregate().InstanceID); err != nil {
+		return nil, err
+	}
+	return crdb.NewNoOpStatement(e), nil
 }
 
-func (m *Styling) processLabelPolicy(event *models.Event) (err error) {
+// labelPolicy reduces the label policy of the aggregate up to the activation event
+func (m *Styling) labelPolicy(event eventstore.Event) (*iam_model.LabelPolicyView, error) {
+	events, err := m.Eventstore.Filter(
+		authz.WithInstanceID(context.Background(), event.Aggregate().InstanceID),
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(event.Aggregate().InstanceID).
+			AddQuery().
+			AggregateTypes(event.Aggregate().Type).
+			AggregateIDs(event.Aggregate().ID).
+			SequenceLess(event.Sequence()+1).
+			EventTypes(labelPolicyEventTypes...).
+			Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	policy := new(iam_model.LabelPolicyView)
-	switch eventstore.EventType(event.Type) {
-	case instance.LabelPolicyAddedEventType,
-		org.LabelPolicyAddedEventType:
-		err = policy.AppendEvent(event)
-	case instance.LabelPolicyChangedEventType,
-		org.LabelPolicyChangedEventType,
-		instance.LabelPolicyLogoAddedEventType,
-		org.LabelPolicyLogoAddedEventType,
-		instance.LabelPolicyLogoRemovedEventType,
-		org.LabelPolicyLogoRemovedEventType,
-		instance.LabelPolicyIconAddedEventType,
-		org.LabelPolicyIconAddedEventType,
-		instance.LabelPolicyIconRemovedEventType,
-		org.LabelPolicyIconRemovedEventType,
-		instance.LabelPolicyLogoDarkAddedEventType,
-		org.LabelPolicyLogoDarkAddedEventType,
-		instance.LabelPolicyLogoDarkRemovedEventType,
-		org.LabelPolicyLogoDarkRemovedEventType,
-		instance.LabelPolicyIconDarkAddedEventType,
-		org.LabelPolicyIconDarkAddedEventType,
-		instance.LabelPolicyIconDarkRemovedEventType,
-		org.LabelPolicyIconDarkRemovedEventType,
-		instance.LabelPolicyFontAddedEventType,
-		org.LabelPolicyFontAddedEventType,
-		instance.LabelPolicyFontRemovedEventType,
-		org.LabelPolicyFontRemovedEventType,
-		instance.LabelPolicyAssetsRemovedEventType,
-		org.LabelPolicyAssetsRemovedEventType:
-		policy, err = m.view.StylingByAggregateIDAndState(event.AggregateID, event.InstanceID, int32(domain.LabelPolicyStatePreview))
-		if err != nil {
-			return err
-		}
-		err = policy.AppendEvent(event)
-
-	case instance.LabelPolicyActivatedEventType,
-		org.LabelPolicyActivatedEventType:
-		policy, err = m.view.StylingByAggregateIDAndState(event.AggregateID, event.InstanceID, int32(domain.LabelPolicyStatePreview))
-		if err != nil {
-			return err
+	for _, e := range events {
+		if err = policy.AppendEvent(eventstore.MapEventToV1Event(e)); err != nil {
+			return nil, err
 		}
-		err = policy.AppendEvent(event)
-		if err != nil {
-			return err
-		}
-		err = m.generateStylingFile(policy)
-	case instance.InstanceRemovedEventType:
-		err = m.deleteInstanceFilesFromStorage(event.InstanceID)
-		if err != nil {
-			return err
-		}
-		return m.view.DeleteInstanceStyling(event)
-	case org.OrgRemovedEventType:
-		return m.view.UpdateOrgOwnerRemovedStyling(event)
-	default:
-		return m.view.ProcessedStylingSequence(event)
-	}
-	if err != nil {
-		return err
 	}
-	return m.view.PutStyling(policy, event)
-}
-
-func (m *Styling) OnError(event *models.Event, err error) error {
-	logging.WithFields("id", event.AggregateID).WithError(err).Warn("something went wrong in label policy handler")
-	return spooler.HandleError(event, err, m.view.GetLatestStylingFailedEvent, m.view.ProcessedStylingFailedEvent, m.view.ProcessedStylingSequence, m.errorCountUntilSkip)
+	return policy, nil
 }
 
-func (m *Styling) OnSuccess(instanceIDs []string) error {
-	return spooler.HandleSuccess(m.view.UpdateStylingSpoolerRunTimestamp, instanceIDs)
+var labelPolicyEventTypes = []eventstore.EventType{
+	instance.LabelPolicyAddedEventType,
+	org.LabelPolicyAddedEventType,
+	instance.LabelPolicyChangedEventType,
+	org.LabelPolicyChangedEventType,
+	instance.LabelPolicyLogoAddedEventType,
+	org.LabelPolicyLogoAddedEventType,
+	instance.LabelPolicyLogoRemovedEventType,
+	org.LabelPolicyLogoRemovedEventType,
+	instance.LabelPolicyIconAddedEventType,
+	org.LabelPolicyIconAddedEventType,
+	instance.LabelPolicyIconRemovedEventType,
+	org.LabelPolicyIconRemovedEventType,
+	instance.LabelPolicyLogoDarkAddedEventType,
+	org.LabelPolicyLogoDarkAddedEventType,
+	instance.LabelPolicyLogoDarkRemovedEventType,
+	org.LabelPolicyLogoDarkRemovedEventType,
+	instance.LabelPolicyIconDarkAddedEventType,
+	org.LabelPolicyIconDarkAddedEventType,
+	instance.LabelPolicyIconDarkRemovedEventType,
+	org.LabelPolicyIconDarkRemovedEventType,
+	instance.LabelPolicyFontAddedEventType,
+	org.LabelPolicyFontAddedEventType,
+	instance.LabelPolicyFontRemovedEventType,
+	org.LabelPolicyFontRemovedEventType,
+	instance.LabelPolicyAssetsRemovedEventType,
+	org.LabelPolicyAssetsRemovedEventType,
+	instance.LabelPolicyActivatedEventType,
+	org.LabelPolicyActivatedEventType,
 }
 
 func (m *Styling) generateStylingFile(policy *iam_model.LabelPolicyView) error {
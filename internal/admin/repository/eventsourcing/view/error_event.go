@@ -5,22 +5,21 @@ import (
 )
 
 const (
-	errTable  = "adminapi.failed_events"
 	errColumn = "failed_events"
 )
 
-func (v *View) saveFailedEvent(failedEvent *repository.FailedEvent) error {
-	return repository.SaveFailedEvent(v.Db, errTable, failedEvent)
-}
-
 func (v *View) RemoveFailedEvent(database string, failedEvent *repository.FailedEvent) error {
-	return repository.RemoveFailedEvent(v.Db, database+"."+errColumn, failedEvent)
-}
-
-func (v *View) latestFailedEvent(viewName, instanceID string, sequence uint64) (*repository.FailedEvent, error) {
-	return repository.LatestFailedEvent(v.Db, errTable, viewName, instanceID, sequence)
+	db, err := v.db(failedEvent.InstanceID)
+	if err != nil {
+		return err
+	}
+	return repository.RemoveFailedEvent(db, database+"."+errColumn, failedEvent)
 }
 
 func (v *View) AllFailedEvents(db, instanceID string) ([]*repository.FailedEvent, error) {
-	return repository.AllFailedEvents(v.Db, db+"."+errColumn, instanceID)
+	shardDB, err := v.db(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return repository.AllFailedEvents(shardDB, db+"."+errColumn, instanceID)
 }
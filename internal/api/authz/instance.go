@@ -20,6 +20,8 @@ type Instance interface {
 	DefaultLanguage() language.Tag
 	DefaultOrganisationID() string
 	SecurityPolicyAllowedOrigins() []string
+	// Shard is the name of the database shard the instance is stored in, empty for the default database
+	Shard() string
 }
 
 type InstanceVerifier interface {
@@ -72,6 +74,10 @@ func (i *instance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
 
+func (i *instance) Shard() string {
+	return ""
+}
+
 func GetInstance(ctx context.Context) Instance {
 	instance, ok := ctx.Value(instanceKey).(Instance)
 	if !ok {
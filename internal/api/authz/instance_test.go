@@ -103,3 +103,7 @@ func (m *mockInstance) RequestedHost() string {
 func (m *mockInstance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
+
+func (m *mockInstance) Shard() string {
+	return ""
+}
@@ -22,8 +22,8 @@ func CheckPermission(ctx context.Context, resolver MembershipsResolver, roleMapp
 	return nil
 }
 
-// getUserPermissions retrieves the memberships of the authenticated user (on instance and provided organisation level),
-// and maps them to permissions. It will return the requested permission(s) and all other granted permissions separately.
+// getUserPermissions retrieves the memberships of the authenticated user (on instance, provided organisation
+// and its parent organisations level), and maps them to permissions. It will return the requested permission(s) and all other granted permissions separately.
 func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requiredPerm string, roleMappings []RoleMapping, ctxData CtxData, orgID string) (requestedPermissions, allPermissions []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -37,6 +37,11 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 	if err != nil {
 		return nil, nil, err
 	}
+	inheritedMemberships, err := inheritedOrgMemberships(ctx, resolver, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberships = append(memberships, inheritedMemberships...)
 	if len(memberships) == 0 {
 		err = retry(func() error {
 			memberships, err = resolver.SearchMyMemberships(ctx, orgID)
@@ -52,10 +57,68 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 			return nil, nil, nil
 		}
 	}
+	roleMappings, err = withCustomRoleMappings(ctx, resolver, memberships, roleMappings)
+	if err != nil {
+		return nil, nil, err
+	}
 	requestedPermissions, allPermissions = mapMembershipsToPermissions(requiredPerm, memberships, roleMappings)
 	return requestedPermissions, allPermissions, nil
 }
 
+// inheritedOrgMemberships returns the organisation memberships of the authenticated user
+// on the parent organisations of the provided organisation
+func inheritedOrgMemberships(ctx context.Context, resolver MembershipsResolver, orgID string) (_ []*Membership, err error) {
+	if orgID == "" {
+		return nil, nil
+	}
+	memberships, err := resolver.SearchMyInheritedMemberships(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	inherited := make([]*Membership, 0, len(memberships))
+	for _, membership := range memberships {
+		if membership.MemberType == MemberTypeOrganisation && membership.AggregateID != orgID {
+			inherited = append(inherited, membership)
+		}
+	}
+	return inherited, nil
+}
+
+// withCustomRoleMappings adds the custom roles of the instance to the configured role mappings,
+// but only queries them if at least one of the memberships contains a role which is not configured
+func withCustomRoleMappings(ctx context.Context, resolver MembershipsResolver, memberships []*Membership, roleMappings []RoleMapping) (_ []RoleMapping, err error) {
+	if !hasUnmappedRoles(memberships, roleMappings) {
+		return roleMappings, nil
+	}
+	customRoleMappings, err := resolver.SearchCustomRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mappings := make([]RoleMapping, 0, len(roleMappings)+len(customRoleMappings))
+	mappings = append(mappings, roleMappings...)
+	return append(mappings, customRoleMappings...), nil
+}
+
+func hasUnmappedRoles(memberships []*Membership, roleMappings []RoleMapping) bool {
+	for _, membership := range memberships {
+		for _, role := range membership.Roles {
+			if !hasRoleMapping(roleMappings, role) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasRoleMapping(roleMappings []RoleMapping, role string) bool {
+	for _, roleMapping := range roleMappings {
+		if roleMapping.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
 // checkUserResourcePermissions checks that if a user i granted either the requested permission globally (project.write)
 // or the specific resource (project.write:123)
 func checkUserResourcePermissions(userPerms []string, resourceID string) error {
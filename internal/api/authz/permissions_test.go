@@ -12,16 +12,30 @@ func getTestCtx(userID, orgID string) context.Context {
 }
 
 type testVerifier struct {
-	memberships []*Membership
+	memberships          []*Membership
+	orgMemberships       map[string][]*Membership
+	inheritedMemberships []*Membership
+	customRoles          []RoleMapping
 }
 
 func (v *testVerifier) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, error) {
 	return "userID", "agentID", "clientID", "de", "orgID", nil
 }
 func (v *testVerifier) SearchMyMemberships(ctx context.Context, orgID string) ([]*Membership, error) {
+	if memberships, ok := v.orgMemberships[orgID]; ok {
+		return memberships, nil
+	}
 	return v.memberships, nil
 }
 
+func (v *testVerifier) SearchCustomRoleMappings(ctx context.Context) ([]RoleMapping, error) {
+	return v.customRoles, nil
+}
+
+func (v *testVerifier) SearchMyInheritedMemberships(ctx context.Context, orgID string) ([]*Membership, error) {
+	return v.inheritedMemberships, nil
+}
+
 func (v *testVerifier) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (string, []string, error) {
 	return "", nil, nil
 }
@@ -136,6 +150,77 @@ func Test_GetUserPermissions(t *testing.T) {
 			},
 			result: []string{"project.read"},
 		},
+		{
+			name: "Get Permissions of custom role",
+			args: args{
+				ctxData: CtxData{UserID: "userID", OrgID: "orgID"},
+				verifier: Start(&testVerifier{
+					memberships: []*Membership{
+						{
+							AggregateID: "orgID",
+							ObjectID:    "orgID",
+							MemberType:  MemberTypeOrganisation,
+							Roles:       []string{"ORG_AUDITOR"},
+						},
+					},
+					customRoles: []RoleMapping{
+						{
+							Role:        "ORG_AUDITOR",
+							Permissions: []string{"org.read"},
+						},
+					},
+				}, "", nil),
+				requiredPerm: "org.read",
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER",
+							Permissions: []string{"org.read", "project.read"},
+						},
+					},
+				},
+			},
+			result: []string{"org.read"},
+		},
+		{
+			name: "Get Permissions of parent organisation",
+			args: args{
+				ctxData: CtxData{UserID: "userID", OrgID: "orgID"},
+				verifier: Start(&testVerifier{
+					orgMemberships: map[string][]*Membership{
+						"orgID": {},
+					},
+					inheritedMemberships: []*Membership{
+						{
+							AggregateID: "parentID",
+							ObjectID:    "parentID",
+							MemberType:  MemberTypeOrganisation,
+							Roles:       []string{"ORG_OWNER"},
+						},
+						{
+							AggregateID: "projectID",
+							ObjectID:    "projectID",
+							MemberType:  MemberTypeProject,
+							Roles:       []string{"PROJECT_OWNER"},
+						},
+					},
+				}, "", nil),
+				requiredPerm: "org.read",
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER",
+							Permissions: []string{"org.read"},
+						},
+						{
+							Role:        "PROJECT_OWNER",
+							Permissions: []string{"project.read"},
+						},
+					},
+				},
+			},
+			result: []string{"org.read"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
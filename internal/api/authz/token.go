@@ -32,12 +32,16 @@ type TokenVerifier struct {
 
 type MembershipsResolver interface {
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*Membership, error)
+	SearchCustomRoleMappings(ctx context.Context) ([]RoleMapping, error)
+	SearchMyInheritedMemberships(ctx context.Context, orgID string) ([]*Membership, error)
 }
 
 type authZRepo interface {
 	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error)
 	VerifierClientID(ctx context.Context, name string) (clientID, projectID string, err error)
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*Membership, error)
+	SearchCustomRoleMappings(ctx context.Context) ([]RoleMapping, error)
+	SearchMyInheritedMemberships(ctx context.Context, orgID string) ([]*Membership, error)
 	ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (projectID string, origins []string, err error)
 	ExistsOrg(ctx context.Context, id, domain string) (string, error)
 }
@@ -140,6 +144,18 @@ func (v *TokenVerifier) SearchMyMemberships(ctx context.Context, orgID string) (
 	return v.authZRepo.SearchMyMemberships(ctx, orgID)
 }
 
+func (v *TokenVerifier) SearchCustomRoleMappings(ctx context.Context) (_ []RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return v.authZRepo.SearchCustomRoleMappings(ctx)
+}
+
+func (v *TokenVerifier) SearchMyInheritedMemberships(ctx context.Context, orgID string) (_ []*Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return v.authZRepo.SearchMyInheritedMemberships(ctx, orgID)
+}
+
 func (v *TokenVerifier) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (_ string, _ []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -0,0 +1,43 @@
+package call
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type positionKey struct{}
+
+// WithPosition sets the position of the eventstore
+// the projections must have processed to answer the call.
+// The position is raised by [RaisePosition] during the call, e.g. after events were pushed.
+func WithPosition(parent context.Context, position uint64) context.Context {
+	p := new(atomic.Uint64)
+	p.Store(position)
+	return context.WithValue(parent, positionKey{}, p)
+}
+
+// RaisePosition raises the position of the call set by [WithPosition]
+// so the following reads of the call see the pushed events (read-your-writes)
+// lower positions are ignored
+func RaisePosition(ctx context.Context, position uint64) {
+	p, ok := ctx.Value(positionKey{}).(*atomic.Uint64)
+	if !ok {
+		return
+	}
+	for {
+		current := p.Load()
+		if position <= current || p.CompareAndSwap(current, position) {
+			return
+		}
+	}
+}
+
+// PositionFromContext returns the position set by [WithPosition] and [RaisePosition]
+// 0 means the call tolerates any staleness
+func PositionFromContext(ctx context.Context) uint64 {
+	p, ok := ctx.Value(positionKey{}).(*atomic.Uint64)
+	if !ok {
+		return 0
+	}
+	return p.Load()
+}
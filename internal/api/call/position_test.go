@@ -0,0 +1,57 @@
+package call
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPositionFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want uint64
+	}{
+		{
+			name: "no position",
+			ctx:  context.Background(),
+			want: 0,
+		},
+		{
+			name: "zero position",
+			ctx:  WithPosition(context.Background(), 0),
+			want: 0,
+		},
+		{
+			name: "position",
+			ctx:  WithPosition(context.Background(), 42),
+			want: 42,
+		},
+		{
+			name: "raised position",
+			ctx:  raisedPosition(WithPosition(context.Background(), 42), 43),
+			want: 43,
+		},
+		{
+			name: "lower position ignored",
+			ctx:  raisedPosition(WithPosition(context.Background(), 42), 41),
+			want: 42,
+		},
+		{
+			name: "raised without position",
+			ctx:  raisedPosition(context.Background(), 43),
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PositionFromContext(tt.ctx); got != tt.want {
+				t.Errorf("PositionFromContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func raisedPosition(ctx context.Context, position uint64) context.Context {
+	RaisePosition(ctx, position)
+	return ctx
+}
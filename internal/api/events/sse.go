@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	event_grpc "github.com/zitadel/zitadel/internal/api/grpc/event"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	HandlerPrefix = "/events/v1"
+	streamPath    = "/stream"
+
+	queryCursor        = "cursor"
+	queryAggregateType = "aggregate_type"
+	queryEventType     = "event_type"
+	queryResourceOwner = "resource_owner"
+	// lastEventIDHeader is sent by the browsers on reconnect with the id of the last received event
+	lastEventIDHeader = "Last-Event-ID"
+
+	permissionEventsRead = "events.read"
+)
+
+// handler streams the events of the instance as server-sent events,
+// it's the http variant of the SubscribeEvents call of the admin API
+type handler struct {
+	queries           *query.Queries
+	verifier          *authz.TokenVerifier
+	authConfig        authz.Config
+	auditLogRetention time.Duration
+}
+
+func NewHandler(
+	queries *query.Queries,
+	verifier *authz.TokenVerifier,
+	authConfig authz.Config,
+	auditLogRetention time.Duration,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	h := &handler{
+		queries:           queries,
+		verifier:          verifier,
+		authConfig:        authConfig,
+		auditLogRetention: auditLogRetention,
+	}
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor)
+	router.HandleFunc(streamPath, h.stream).Methods(http.MethodGet)
+	return router
+}
+
+func (h *handler) stream(w http.ResponseWriter, r *http.Request) {
+	ctx, err := h.authorize(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	cursorParam := r.Header.Get(lastEventIDHeader)
+	if cursorParam == "" {
+		cursorParam = r.URL.Query().Get(queryCursor)
+	}
+	cursor, err := query.ParseEventCursor(cursorParam)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	params := r.URL.Query()
+	subscription, err := h.queries.NewEventSubscription(ctx, cursor, params[queryAggregateType], params[queryEventType], params.Get(queryResourceOwner))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// the writes block if the consumer does not read, which stops the subscription from querying further events
+	err = h.queries.SubscribeEvents(ctx, subscription, h.auditLogRetention, func(event *query.Event, cursor query.EventCursor) error {
+		pbEvent, err := event_grpc.EventToPb(event)
+		if err != nil {
+			return err
+		}
+		data, err := protojson.Marshal(pbEvent)
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", cursor, event.Type, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	logging.OnError(err).Info("event stream closed")
+}
+
+// authorize checks the permission itself, because the auth interceptor
+// only matches the request uri without query parameters
+func (h *handler) authorize(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	token := http_util.GetAuthorization(r)
+	if token == "" {
+		return nil, fmt.Errorf("auth header missing")
+	}
+	ctxSetter, err := authz.CheckUserAuthorization(ctx, struct{}{}, token, http_util.GetOrgID(r), "", h.verifier, h.authConfig, authz.Option{Permission: permissionEventsRead}, http.MethodGet+":"+HandlerPrefix+streamPath)
+	if err != nil {
+		return nil, err
+	}
+	return ctxSetter(ctx), nil
+}
@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListArchiveReports(ctx context.Context, req *admin_pb.ListArchiveReportsRequest) (*admin_pb.ListArchiveReportsResponse, error) {
+	result, err := s.query.SearchArchiveReports(ctx, listArchiveReportsToModel(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListArchiveReportsResponse{
+		Result:  ArchiveReportsToPb(result.ArchiveReports),
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+	}, nil
+}
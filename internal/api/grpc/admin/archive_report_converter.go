@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func listArchiveReportsToModel(req *admin_pb.ListArchiveReportsRequest) *query.ArchiveReportSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.ArchiveReportSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.ArchiveReportColArchivedAt,
+		},
+	}
+}
+
+func ArchiveReportsToPb(reports []*query.ArchiveReport) []*admin_pb.ArchiveReport {
+	result := make([]*admin_pb.ArchiveReport, len(reports))
+	for i, report := range reports {
+		result[i] = ArchiveReportToPb(report)
+	}
+	return result
+}
+
+func ArchiveReportToPb(report *query.ArchiveReport) *admin_pb.ArchiveReport {
+	return &admin_pb.ArchiveReport{
+		ArchivedAt:    timestamppb.New(report.ArchivedAt),
+		Policy:        report.Policy,
+		AggregateType: report.AggregateType,
+		EventType:     report.EventType,
+		EventCount:    report.EventCount,
+		OldestEvent:   timestamppb.New(report.OldestEvent),
+		NewestEvent:   timestamppb.New(report.NewestEvent),
+	}
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListCustomRoles(ctx context.Context, req *admin_pb.ListCustomRolesRequest) (*admin_pb.ListCustomRolesResponse, error) {
+	result, err := s.query.SearchCustomRoles(ctx, listCustomRolesToModel(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListCustomRolesResponse{
+		Result:  CustomRolesToPb(result.CustomRoles),
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddCustomRole(ctx context.Context, req *admin_pb.AddCustomRoleRequest) (*admin_pb.AddCustomRoleResponse, error) {
+	details, err := s.command.AddCustomRole(ctx, AddCustomRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddCustomRoleResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateCustomRole(ctx context.Context, req *admin_pb.UpdateCustomRoleRequest) (*admin_pb.UpdateCustomRoleResponse, error) {
+	details, err := s.command.ChangeCustomRole(ctx, UpdateCustomRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveCustomRole(ctx context.Context, req *admin_pb.RemoveCustomRoleRequest) (*admin_pb.RemoveCustomRoleResponse, error) {
+	details, err := s.command.RemoveCustomRole(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func listCustomRolesToModel(req *admin_pb.ListCustomRolesRequest) *query.CustomRoleSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.CustomRoleSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+	}
+}
+
+func CustomRolesToPb(roles []*query.CustomRole) []*admin_pb.CustomRole {
+	result := make([]*admin_pb.CustomRole, len(roles))
+	for i, role := range roles {
+		result[i] = CustomRoleToPb(role)
+	}
+	return result
+}
+
+func CustomRoleToPb(role *query.CustomRole) *admin_pb.CustomRole {
+	return &admin_pb.CustomRole{
+		Details:     object.ToViewDetailsPb(role.Sequence, role.CreationDate, role.ChangeDate, role.ResourceOwner),
+		Role:        role.Role,
+		DisplayName: role.DisplayName,
+		Permissions: role.Permissions,
+	}
+}
+
+func AddCustomRoleToDomain(req *admin_pb.AddCustomRoleRequest) *domain.CustomRole {
+	return &domain.CustomRole{
+		Role:        req.Role,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
+
+func UpdateCustomRoleToDomain(req *admin_pb.UpdateCustomRoleRequest) *domain.CustomRole {
+	return &domain.CustomRole{
+		Role:        req.Role,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	event_grpc "github.com/zitadel/zitadel/internal/api/grpc/event"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -25,6 +27,24 @@ func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest)
 	return admin_pb.EventsToPb(ctx, events)
 }
 
+func (s *Server) SubscribeEvents(in *admin_pb.SubscribeEventsRequest, stream admin_pb.AdminService_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	subscription, err := subscribeEventsRequestToSubscription(ctx, s.query, in)
+	if err != nil {
+		return err
+	}
+	return s.query.SubscribeEvents(ctx, subscription, s.auditLogRetention, func(event *query.Event, cursor query.EventCursor) error {
+		pbEvent, err := event_grpc.EventToPb(event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&admin_pb.SubscribeEventsResponse{
+			Event:  pbEvent,
+			Cursor: cursor.String(),
+		})
+	})
+}
+
 func (s *Server) ListEventTypes(ctx context.Context, in *admin_pb.ListEventTypesRequest) (*admin_pb.ListEventTypesResponse, error) {
 	eventTypes := s.query.SearchEventTypes(ctx)
 	return admin_pb.EventTypesToPb(eventTypes), nil
@@ -73,3 +93,11 @@ func eventRequestToFilter(ctx context.Context, req *admin_pb.ListEventsRequest)
 
 	return builder, nil
 }
+
+func subscribeEventsRequestToSubscription(ctx context.Context, queries *query.Queries, req *admin_pb.SubscribeEventsRequest) (*query.EventSubscription, error) {
+	cursor, err := query.ParseEventCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return queries.NewEventSubscription(ctx, cursor, req.AggregateTypes, req.EventTypes, req.ResourceOwner)
+}
@@ -486,6 +486,7 @@ func (s *Server) getLockoutPolicy(ctx context.Context, orgID string) (_ *managem
 	if !queriedLockout.IsDefault {
 		return &management_pb.AddCustomLockoutPolicyRequest{
 			MaxPasswordAttempts: uint32(queriedLockout.MaxPasswordAttempts),
+			MaxMfaAttempts:      uint32(queriedLockout.MaxMFAAttempts),
 		}, nil
 	}
 	return nil, nil
@@ -501,11 +502,12 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:     queriedPasswordComplexity.MinLength,
+			HasUppercase:  queriedPasswordComplexity.HasUppercase,
+			HasLowercase:  queriedPasswordComplexity.HasLowercase,
+			HasNumber:     queriedPasswordComplexity.HasNumber,
+			HasSymbol:     queriedPasswordComplexity.HasSymbol,
+			CheckBreached: queriedPasswordComplexity.CheckBreached,
 		}, nil
 	}
 	return nil, nil
@@ -521,10 +523,11 @@ func (s *Server) getPrivacyPolicy(ctx context.Context, orgID string) (_ *managem
 	}
 	if !queriedPrivacy.IsDefault {
 		return &management_pb.AddCustomPrivacyPolicyRequest{
-			TosLink:      queriedPrivacy.TOSLink,
-			PrivacyLink:  queriedPrivacy.PrivacyLink,
-			HelpLink:     queriedPrivacy.HelpLink,
-			SupportEmail: string(queriedPrivacy.SupportEmail),
+			TosLink:         queriedPrivacy.TOSLink,
+			PrivacyLink:     queriedPrivacy.PrivacyLink,
+			HelpLink:        queriedPrivacy.HelpLink,
+			SupportEmail:    string(queriedPrivacy.SupportEmail),
+			AllowSelfDelete: queriedPrivacy.AllowSelfDelete,
 		}, nil
 	}
 	return nil, nil
@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListLoginBlocks(ctx context.Context, req *admin_pb.ListLoginBlocksRequest) (*admin_pb.ListLoginBlocksResponse, error) {
+	now := time.Now()
+	result, err := s.query.SearchLoginBlocks(ctx, listLoginBlocksToModel(req, &s.loginThrottle, now))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListLoginBlocksResponse{
+		Result:  LoginBlocksToPb(result.LoginBlocks, &s.loginThrottle),
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+	}, nil
+}
+
+func (s *Server) ClearLoginBlock(ctx context.Context, req *admin_pb.ClearLoginBlockRequest) (*admin_pb.ClearLoginBlockResponse, error) {
+	details, err := s.command.ClearLoginBlock(ctx, LoginBlockKeyTypeToDomain(req.KeyType), req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ClearLoginBlockResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
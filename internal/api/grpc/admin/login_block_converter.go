@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/bruteforce"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func listLoginBlocksToModel(req *admin_pb.ListLoginBlocksRequest, throttle *bruteforce.Config, now time.Time) *query.LoginBlockSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.LoginBlockSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Since:       throttle.Since(now),
+		MinFailures: loginBlockMinFailures(throttle),
+	}
+}
+
+// loginBlockMinFailures returns the lowest amount of failed attempts,
+// which either delays the next attempt or requires a captcha
+func loginBlockMinFailures(throttle *bruteforce.Config) uint64 {
+	minFailures := throttle.Threshold
+	if throttle.CaptchaEnabled() && (minFailures == 0 || throttle.CaptchaThreshold < minFailures) {
+		minFailures = throttle.CaptchaThreshold
+	}
+	if minFailures == 0 {
+		return 1
+	}
+	return minFailures
+}
+
+func LoginBlocksToPb(blocks []*query.LoginAttempts, throttle *bruteforce.Config) []*admin_pb.LoginBlock {
+	result := make([]*admin_pb.LoginBlock, len(blocks))
+	for i, block := range blocks {
+		result[i] = LoginBlockToPb(block, throttle)
+	}
+	return result
+}
+
+func LoginBlockToPb(block *query.LoginAttempts, throttle *bruteforce.Config) *admin_pb.LoginBlock {
+	pb := &admin_pb.LoginBlock{
+		KeyType:         LoginBlockKeyTypeToPb(block.KeyType),
+		Key:             block.Key,
+		FailedAttempts:  block.Failures,
+		LastFailure:     timestamppb.New(block.LastFailure),
+		CaptchaRequired: throttle.CaptchaRequired(block.Failures),
+	}
+	if blockedUntil := throttle.BlockedUntil(block.Failures, block.LastFailure); !blockedUntil.IsZero() {
+		pb.BlockedUntil = timestamppb.New(blockedUntil)
+	}
+	return pb
+}
+
+func LoginBlockKeyTypeToPb(keyType domain.LoginBlockKeyType) admin_pb.LoginBlockKeyType {
+	switch keyType {
+	case domain.LoginBlockKeyTypeIP:
+		return admin_pb.LoginBlockKeyType_LOGIN_BLOCK_KEY_TYPE_IP
+	case domain.LoginBlockKeyTypeUser:
+		return admin_pb.LoginBlockKeyType_LOGIN_BLOCK_KEY_TYPE_USER
+	default:
+		return admin_pb.LoginBlockKeyType_LOGIN_BLOCK_KEY_TYPE_UNSPECIFIED
+	}
+}
+
+func LoginBlockKeyTypeToDomain(keyType admin_pb.LoginBlockKeyType) domain.LoginBlockKeyType {
+	switch keyType {
+	case admin_pb.LoginBlockKeyType_LOGIN_BLOCK_KEY_TYPE_IP:
+		return domain.LoginBlockKeyTypeIP
+	case admin_pb.LoginBlockKeyType_LOGIN_BLOCK_KEY_TYPE_USER:
+		return domain.LoginBlockKeyTypeUser
+	default:
+		return domain.LoginBlockKeyTypeUnspecified
+	}
+}
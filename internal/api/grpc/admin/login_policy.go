@@ -93,6 +93,16 @@ func (s *Server) ListLoginPolicySecondFactors(ctx context.Context, req *admin_pb
 	}, nil
 }
 
+func (s *Server) SetDefaultLoginPolicyRiskRules(ctx context.Context, req *admin_pb.SetDefaultLoginPolicyRiskRulesRequest) (*admin_pb.SetDefaultLoginPolicyRiskRulesResponse, error) {
+	objectDetails, err := s.command.SetDefaultLoginPolicyRiskRules(ctx, setDefaultLoginPolicyRiskRulesToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultLoginPolicyRiskRulesResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) AddSecondFactorToLoginPolicy(ctx context.Context, req *admin_pb.AddSecondFactorToLoginPolicyRequest) (*admin_pb.AddSecondFactorToLoginPolicyResponse, error) {
 	objectDetails, err := s.command.AddSecondFactorToDefaultLoginPolicy(ctx, policy_grpc.SecondFactorTypeToDomain(req.Type))
 	if err != nil {
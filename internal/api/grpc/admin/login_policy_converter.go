@@ -4,6 +4,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
@@ -20,6 +21,7 @@ func updateLoginPolicyToCommand(p *admin_pb.UpdateLoginPolicyRequest) *command.C
 		AllowDomainDiscovery:       p.AllowDomainDiscovery,
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 		DefaultRedirectURI:         p.DefaultRedirectUri,
 		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
 		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
@@ -39,3 +41,15 @@ func ListLoginPolicyIDPsRequestToQuery(req *admin_pb.ListLoginPolicyIDPsRequest)
 		},
 	}
 }
+
+func setDefaultLoginPolicyRiskRulesToDomain(req *admin_pb.SetDefaultLoginPolicyRiskRulesRequest) *domain.RiskRules {
+	return &domain.RiskRules{
+		Enabled:               req.Enabled,
+		NewDevice:             req.NewDevice,
+		NewNetwork:            req.NewNetwork,
+		NewCountry:            req.NewCountry,
+		ImpossibleTravelSpeed: req.ImpossibleTravelSpeed,
+		MaxRecentFailures:     req.MaxRecentFailures,
+		RecentFailuresWindow:  req.RecentFailuresWindow.AsDuration(),
+	}
+}
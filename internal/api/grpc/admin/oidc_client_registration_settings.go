@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetOIDCClientRegistrationSettings(ctx context.Context, _ *admin_pb.GetOIDCClientRegistrationSettingsRequest) (*admin_pb.GetOIDCClientRegistrationSettingsResponse, error) {
+	result, err := s.query.OIDCClientRegistrationSettingsByAggID(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetOIDCClientRegistrationSettingsResponse{
+		Settings: OIDCClientRegistrationSettingsToPb(result),
+	}, nil
+}
+
+func (s *Server) SetOIDCClientRegistrationSettings(ctx context.Context, req *admin_pb.SetOIDCClientRegistrationSettingsRequest) (*admin_pb.SetOIDCClientRegistrationSettingsResponse, error) {
+	result, err := s.command.SetOIDCClientRegistrationSettings(ctx, SetOIDCClientRegistrationSettingsToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetOIDCClientRegistrationSettingsResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func OIDCClientRegistrationSettingsToPb(settings *query.OIDCClientRegistrationSettings) *settings_pb.OIDCClientRegistrationSettings {
+	return &settings_pb.OIDCClientRegistrationSettings{
+		Details:                    obj_grpc.ToViewDetailsPb(settings.Sequence, settings.CreationDate, settings.ChangeDate, settings.AggregateID),
+		AllowedGrantTypes:          project.OIDCGrantTypesFromModel(settings.AllowedGrantTypes),
+		AllowedRedirectUriPrefixes: settings.AllowedRedirectURIPrefixes,
+	}
+}
+
+func SetOIDCClientRegistrationSettingsToDomain(req *admin_pb.SetOIDCClientRegistrationSettingsRequest) *domain.OIDCClientRegistrationSettings {
+	settings := &domain.OIDCClientRegistrationSettings{
+		AllowedRedirectURIPrefixes: req.AllowedRedirectUriPrefixes,
+	}
+	// an empty list must not be defaulted, it allows all grant types
+	if len(req.AllowedGrantTypes) > 0 {
+		settings.AllowedGrantTypes = project.OIDCGrantTypesToDomain(req.AllowedGrantTypes)
+	}
+	return settings
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/assets"
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/server"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -34,6 +35,7 @@ type Server struct {
 	userCodeAlg       crypto.EncryptionAlgorithm
 	passwordHashAlg   crypto.HashAlgorithm
 	auditLogRetention time.Duration
+	loginThrottle     bruteforce.Config
 }
 
 type Config struct {
@@ -59,6 +61,7 @@ func CreateServer(
 		userCodeAlg:       userCodeAlg,
 		passwordHashAlg:   crypto.NewBCrypt(sd.SecretGenerators.PasswordSaltCost),
 		auditLogRetention: auditLogRetention,
+		loginThrottle:     sd.LoginThrottle,
 	}
 }
 
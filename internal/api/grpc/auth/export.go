@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+// ExportMyData collects all data stored about the authenticated user,
+// by using the same queries as the corresponding list endpoints without any limit.
+func (s *Server) ExportMyData(ctx context.Context, req *auth_pb.ExportMyDataRequest) (*auth_pb.ExportMyDataResponse, error) {
+	user, err := s.GetMyUser(ctx, &auth_pb.GetMyUserRequest{})
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.ListMyMetadata(ctx, &auth_pb.ListMyMetadataRequest{})
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.ListMyUserGrants(ctx, &auth_pb.ListMyUserGrantsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.ListMyMemberships(ctx, &auth_pb.ListMyMembershipsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	idpLinks, err := s.ListMyLinkedIDPs(ctx, &auth_pb.ListMyLinkedIDPsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.ListMyUserSessions(ctx, &auth_pb.ListMyUserSessionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	authFactors, err := s.ListMyAuthFactors(ctx, &auth_pb.ListMyAuthFactorsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	passwordless, err := s.ListMyPasswordless(ctx, &auth_pb.ListMyPasswordlessRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp := &auth_pb.ExportMyDataResponse{
+		User:         user.GetUser(),
+		Metadata:     metadata.GetResult(),
+		UserGrants:   grants.GetResult(),
+		Memberships:  memberships.GetResult(),
+		IdpLinks:     idpLinks.GetResult(),
+		Sessions:     sessions.GetResult(),
+		AuthFactors:  authFactors.GetResult(),
+		Passwordless: passwordless.GetResult(),
+	}
+	if !req.GetIncludeHistory() {
+		return resp, nil
+	}
+	changes, err := s.ListMyUserChanges(ctx, &auth_pb.ListMyUserChangesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp.Changes = changes.GetResult()
+	return resp, nil
+}
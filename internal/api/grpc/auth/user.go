@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/change"
@@ -48,7 +49,11 @@ func (s *Server) RemoveMyUser(ctx context.Context, _ *auth_pb.RemoveMyUserReques
 	if err != nil {
 		return nil, err
 	}
-	details, err := s.command.RemoveUser(ctx, ctxData.UserID, ctxData.ResourceOwner, cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants)...)
+	sessionIDs, err := s.mySessionIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveMyUser(ctx, sessionIDs, cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants)...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +62,24 @@ func (s *Server) RemoveMyUser(ctx context.Context, _ *auth_pb.RemoveMyUserReques
 	}, nil
 }
 
+func (s *Server) mySessionIDs(ctx context.Context) ([]string, error) {
+	userIDQuery, err := query.NewSessionUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.query.SearchSessions(ctx, &query.SessionsSearchQueries{
+		Queries: []query.SearchQuery{userIDQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(sessions.Sessions))
+	for i, session := range sessions.Sessions {
+		ids[i] = session.ID
+	}
+	return ids, nil
+}
+
 func (s *Server) ListMyUserChanges(ctx context.Context, req *auth_pb.ListMyUserChangesRequest) (*auth_pb.ListMyUserChangesResponse, error) {
 	var (
 		limit    uint64
@@ -162,6 +185,21 @@ func (s *Server) ListMyUserGrants(ctx context.Context, req *auth_pb.ListMyUserGr
 	}, nil
 }
 
+func (s *Server) RequestMyUserGrant(ctx context.Context, req *auth_pb.RequestMyUserGrantRequest) (*auth_pb.RequestMyUserGrantResponse, error) {
+	grant, err := s.command.RequestUserGrant(ctx, RequestMyUserGrantRequestToDomain(ctx, req), authz.GetCtxData(ctx).ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RequestMyUserGrantResponse{
+		UserGrantId: grant.AggregateID,
+		Details: obj_grpc.AddToDetailsPb(
+			grant.Sequence,
+			grant.ChangeDate,
+			grant.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) ListMyProjectOrgs(ctx context.Context, req *auth_pb.ListMyProjectOrgsRequest) (*auth_pb.ListMyProjectOrgsResponse, error) {
 	queries, err := ListMyProjectOrgsRequestToQuery(req)
 	if err != nil {
@@ -187,7 +225,11 @@ func (s *Server) ListMyProjectOrgs(ctx context.Context, req *auth_pb.ListMyProje
 		}
 
 		ids := make([]string, 0, len(grants.UserGrants))
+		now := time.Now()
 		for _, grant := range grants.UserGrants {
+			if !grant.IsEffectiveAt(now) {
+				continue
+			}
 			ids = appendIfNotExists(ids, grant.ResourceOwner)
 		}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
 )
@@ -55,5 +56,19 @@ func UserGrantToPb(grant *query.UserGrant) *auth_pb.UserGrant {
 		ProjectGrantId: grant.GrantID,
 		RoleKeys:       grant.Roles,
 		UserType:       user.TypeToPb(grant.UserType),
+		State:          user.UserGrantStateToPb(grant.State),
+		ValidFrom:      user.ValidityToPb(grant.ValidFrom),
+		ValidUntil:     user.ValidityToPb(grant.ValidUntil),
+	}
+}
+
+func RequestMyUserGrantRequestToDomain(ctx context.Context, req *auth_pb.RequestMyUserGrantRequest) *domain.UserGrant {
+	return &domain.UserGrant{
+		UserID:         authz.GetCtxData(ctx).UserID,
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+		ValidFrom:      user.ValidityToDomain(req.ValidFrom),
+		ValidUntil:     user.ValidityToDomain(req.ValidUntil),
 	}
 }
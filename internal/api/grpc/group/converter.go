@@ -0,0 +1,88 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	group_pb "github.com/zitadel/zitadel/pkg/grpc/group"
+)
+
+func GroupsToPb(groups []*query.Group) []*group_pb.Group {
+	g := make([]*group_pb.Group, len(groups))
+	for i, group := range groups {
+		g[i] = GroupToPb(group)
+	}
+	return g
+}
+
+func GroupToPb(group *query.Group) *group_pb.Group {
+	return &group_pb.Group{
+		Id:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		Details: object.ToViewDetailsPb(
+			group.Sequence,
+			group.CreationDate,
+			group.ChangeDate,
+			group.ResourceOwner,
+		),
+	}
+}
+
+func GroupMembersToPb(members []*query.GroupMember) []*group_pb.GroupMember {
+	m := make([]*group_pb.GroupMember, len(members))
+	for i, member := range members {
+		m[i] = &group_pb.GroupMember{
+			GroupId:   member.GroupID,
+			GroupName: member.GroupName,
+			UserId:    member.UserID,
+			Details: object.ToViewDetailsPb(
+				member.Sequence,
+				member.CreationDate,
+				member.ChangeDate,
+				member.ResourceOwner,
+			),
+		}
+	}
+	return m
+}
+
+func GroupGrantsToPb(grants []*query.GroupGrant) []*group_pb.GroupGrant {
+	g := make([]*group_pb.GroupGrant, len(grants))
+	for i, grant := range grants {
+		g[i] = &group_pb.GroupGrant{
+			Id:             grant.ID,
+			GroupId:        grant.GroupID,
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+			Details: object.ToViewDetailsPb(
+				grant.Sequence,
+				grant.CreationDate,
+				grant.ChangeDate,
+				grant.ResourceOwner,
+			),
+		}
+	}
+	return g
+}
+
+func GroupQueriesToModel(queries []*group_pb.GroupQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = GroupQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func GroupQueryToModel(apiQuery *group_pb.GroupQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *group_pb.GroupQuery_NameQuery:
+		return query.NewGroupNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "GROUP-Qn3mf", "List.Query.Invalid")
+	}
+}
@@ -0,0 +1,147 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetGroupByID(ctx context.Context, req *mgmt_pb.GetGroupByIDRequest) (*mgmt_pb.GetGroupByIDResponse, error) {
+	group, err := s.query.GroupByID(ctx, true, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetGroupByIDResponse{
+		Group: group_grpc.GroupToPb(group),
+	}, nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*mgmt_pb.ListGroupsResponse, error) {
+	queries, err := listGroupsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.query.SearchGroups(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupsResponse{
+		Result:  group_grpc.GroupsToPb(groups.Groups),
+		Details: object_grpc.ToListDetails(groups.Count, groups.Sequence, groups.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroup(ctx context.Context, req *mgmt_pb.AddGroupRequest) (*mgmt_pb.AddGroupResponse, error) {
+	group, err := s.command.AddGroup(ctx, addGroupRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupResponse{
+		Id:      group.AggregateID,
+		Details: object_grpc.AddToDetailsPb(group.Sequence, group.ChangeDate, group.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) (*mgmt_pb.UpdateGroupResponse, error) {
+	group, err := s.command.ChangeGroup(ctx, updateGroupRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupResponse{
+		Details: object_grpc.ChangeToDetailsPb(group.Sequence, group.ChangeDate, group.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveGroup(ctx context.Context, req *mgmt_pb.RemoveGroupRequest) (*mgmt_pb.RemoveGroupResponse, error) {
+	details, err := s.command.RemoveGroup(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupMembers(ctx context.Context, req *mgmt_pb.ListGroupMembersRequest) (*mgmt_pb.ListGroupMembersResponse, error) {
+	queries, err := listGroupMembersRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.query.SearchGroupMembers(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupMembersResponse{
+		Result:  group_grpc.GroupMembersToPb(members.GroupMembers),
+		Details: object_grpc.ToListDetails(members.Count, members.Sequence, members.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroupMember(ctx context.Context, req *mgmt_pb.AddGroupMemberRequest) (*mgmt_pb.AddGroupMemberResponse, error) {
+	details, err := s.command.AddGroupMember(ctx, req.GroupId, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupMemberResponse{
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupMember(ctx context.Context, req *mgmt_pb.RemoveGroupMemberRequest) (*mgmt_pb.RemoveGroupMemberResponse, error) {
+	details, err := s.command.RemoveGroupMember(ctx, req.GroupId, req.UserId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupMemberResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupGrants(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*mgmt_pb.ListGroupGrantsResponse, error) {
+	queries, err := listGroupGrantsRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.query.SearchGroupGrants(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupGrantsResponse{
+		Result:  group_grpc.GroupGrantsToPb(grants.GroupGrants),
+		Details: object_grpc.ToListDetails(grants.Count, grants.Sequence, grants.Timestamp),
+	}, nil
+}
+
+func (s *Server) AddGroupGrant(ctx context.Context, req *mgmt_pb.AddGroupGrantRequest) (*mgmt_pb.AddGroupGrantResponse, error) {
+	grant, err := s.command.AddGroupGrant(ctx, addGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupGrantResponse{
+		GrantId: grant.GrantID,
+		Details: object_grpc.AddToDetailsPb(grant.Sequence, grant.ChangeDate, grant.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateGroupGrant(ctx context.Context, req *mgmt_pb.UpdateGroupGrantRequest) (*mgmt_pb.UpdateGroupGrantResponse, error) {
+	grant, err := s.command.ChangeGroupGrant(ctx, updateGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupGrantResponse{
+		Details: object_grpc.ChangeToDetailsPb(grant.Sequence, grant.ChangeDate, grant.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveGroupGrant(ctx context.Context, req *mgmt_pb.RemoveGroupGrantRequest) (*mgmt_pb.RemoveGroupGrantResponse, error) {
+	details, err := s.command.RemoveGroupGrant(ctx, req.GroupId, req.GrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupGrantResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package management
+
+import (
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func listGroupsRequestToModel(req *mgmt_pb.ListGroupsRequest, resourceOwner string) (*query.GroupSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := group_grpc.GroupQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func listGroupMembersRequestToModel(req *mgmt_pb.ListGroupMembersRequest, resourceOwner string) (*query.GroupMemberSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupMemberGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupMemberResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupMemberSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery, ownerQuery},
+	}, nil
+}
+
+func listGroupGrantsRequestToModel(req *mgmt_pb.ListGroupGrantsRequest, resourceOwner string) (*query.GroupGrantSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupGrantGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupGrantResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupGrantSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery, ownerQuery},
+	}, nil
+}
+
+func addGroupRequestToDomain(req *mgmt_pb.AddGroupRequest) *domain.Group {
+	return &domain.Group{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func updateGroupRequestToDomain(req *mgmt_pb.UpdateGroupRequest) *domain.Group {
+	return &domain.Group{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func addGroupGrantRequestToDomain(req *mgmt_pb.AddGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.GroupId,
+		},
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func updateGroupGrantRequestToDomain(req *mgmt_pb.UpdateGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.GroupId,
+		},
+		GrantID:  req.GrantId,
+		RoleKeys: req.RoleKeys,
+	}
+}
@@ -135,6 +135,21 @@ func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (
 	return &mgmt_pb.RemoveOrgResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
 }
 
+func (s *Server) ListSubOrgs(ctx context.Context, req *mgmt_pb.ListSubOrgsRequest) (*mgmt_pb.ListSubOrgsResponse, error) {
+	queries, err := ListSubOrgsRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := s.query.SearchOrgDescendants(ctx, authz.GetCtxData(ctx).OrgID, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListSubOrgsResponse{
+		Result:  org_grpc.OrgViewsToPb(orgs.Orgs),
+		Details: object.ToListDetails(orgs.Count, orgs.Sequence, orgs.Timestamp),
+	}, nil
+}
+
 func (s *Server) GetDomainPolicy(ctx context.Context, req *mgmt_pb.GetDomainPolicyRequest) (*mgmt_pb.GetDomainPolicyResponse, error) {
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
 	if err != nil {
@@ -251,7 +266,10 @@ func (s *Server) ListOrgMemberRoles(ctx context.Context, _ *mgmt_pb.ListOrgMembe
 	if err != nil {
 		return nil, err
 	}
-	roles := s.query.GetOrgMemberRoles(authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	roles, err := s.query.GetOrgMemberRoles(ctx, authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	if err != nil {
+		return nil, err
+	}
 	return &mgmt_pb.ListOrgMemberRolesResponse{
 		Result: roles,
 	}, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	org_pb "github.com/zitadel/zitadel/pkg/grpc/org"
 )
 
 func ListOrgDomainsRequestToModel(req *mgmt_pb.ListOrgDomainsRequest) (*query.OrgDomainSearchQueries, error) {
@@ -31,6 +32,32 @@ func ListOrgDomainsRequestToModel(req *mgmt_pb.ListOrgDomainsRequest) (*query.Or
 	}, nil
 }
 
+func ListSubOrgsRequestToModel(req *mgmt_pb.ListSubOrgsRequest) (*query.OrgSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := org_grpc.OrgQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.OrgSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			SortingColumn: orgFieldNameToColumn(req.SortingColumn),
+			Asc:           asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func orgFieldNameToColumn(fieldName org_pb.OrgFieldName) query.Column {
+	switch fieldName {
+	case org_pb.OrgFieldName_ORG_FIELD_NAME_NAME:
+		return query.OrgColumnName
+	default:
+		return query.Column{}
+	}
+}
+
 func AddOrgDomainRequestToDomain(ctx context.Context, req *mgmt_pb.AddOrgDomainRequest) *domain.OrgDomain {
 	return &domain.OrgDomain{
 		ObjectRoot: models.ObjectRoot{
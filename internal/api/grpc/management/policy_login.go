@@ -128,6 +128,16 @@ func (s *Server) ListLoginPolicySecondFactors(ctx context.Context, req *mgmt_pb.
 	}, nil
 }
 
+func (s *Server) SetLoginPolicyRiskRules(ctx context.Context, req *mgmt_pb.SetLoginPolicyRiskRulesRequest) (*mgmt_pb.SetLoginPolicyRiskRulesResponse, error) {
+	objectDetails, err := s.command.SetLoginPolicyRiskRules(ctx, authz.GetCtxData(ctx).OrgID, setLoginPolicyRiskRulesToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetLoginPolicyRiskRulesResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) AddSecondFactorToLoginPolicy(ctx context.Context, req *mgmt_pb.AddSecondFactorToLoginPolicyRequest) (*mgmt_pb.AddSecondFactorToLoginPolicyResponse, error) {
 	_, objectDetails, err := s.command.AddSecondFactorToLoginPolicy(ctx, policy_grpc.SecondFactorTypeToDomain(req.Type), authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 )
@@ -30,6 +31,7 @@ func AddLoginPolicyToCommand(p *mgmt_pb.AddCustomLoginPolicyRequest) *command.Ad
 		IDPProviders:               addLoginPolicyIDPsToCommand(p.Idps),
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 	}
 }
 func addLoginPolicyIDPsToCommand(idps []*mgmt_pb.AddCustomLoginPolicyRequest_IDP) []*command.AddLoginPolicyIDP {
@@ -55,6 +57,7 @@ func updateLoginPolicyToCommand(p *mgmt_pb.UpdateCustomLoginPolicyRequest) *comm
 		AllowDomainDiscovery:       p.AllowDomainDiscovery,
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 		DefaultRedirectURI:         p.DefaultRedirectUri,
 		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
 		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
@@ -74,3 +77,15 @@ func ListLoginPolicyIDPsRequestToQuery(req *mgmt_pb.ListLoginPolicyIDPsRequest)
 		},
 	}
 }
+
+func setLoginPolicyRiskRulesToDomain(req *mgmt_pb.SetLoginPolicyRiskRulesRequest) *domain.RiskRules {
+	return &domain.RiskRules{
+		Enabled:               req.Enabled,
+		NewDevice:             req.NewDevice,
+		NewNetwork:            req.NewNetwork,
+		NewCountry:            req.NewCountry,
+		ImpossibleTravelSpeed: req.ImpossibleTravelSpeed,
+		MaxRecentFailures:     req.MaxRecentFailures,
+		RecentFailuresWindow:  req.RecentFailuresWindow.AsDuration(),
+	}
+}
@@ -307,3 +307,25 @@ func (s *Server) RemoveAppKey(ctx context.Context, req *mgmt_pb.RemoveAppKeyRequ
 		Details: object_grpc.DomainToChangeDetailsPb(details),
 	}, nil
 }
+
+func (s *Server) AddOIDCInitialAccessToken(ctx context.Context, req *mgmt_pb.AddOIDCInitialAccessTokenRequest) (*mgmt_pb.AddOIDCInitialAccessTokenResponse, error) {
+	token, err := s.command.AddOIDCInitialAccessToken(ctx, AddOIDCInitialAccessTokenRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOIDCInitialAccessTokenResponse{
+		TokenId: token.TokenID,
+		Token:   token.Token,
+		Details: object_grpc.AddToDetailsPb(token.Sequence, token.ChangeDate, token.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveOIDCInitialAccessToken(ctx context.Context, req *mgmt_pb.RemoveOIDCInitialAccessTokenRequest) (*mgmt_pb.RemoveOIDCInitialAccessTokenResponse, error) {
+	details, err := s.command.RemoveOIDCInitialAccessToken(ctx, req.ProjectId, req.TokenId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOIDCInitialAccessTokenResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
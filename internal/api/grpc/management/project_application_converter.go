@@ -132,6 +132,20 @@ func UpdateAPIAppConfigRequestToDomain(app *mgmt_pb.UpdateAPIAppConfigRequest) *
 	}
 }
 
+func AddOIDCInitialAccessTokenRequestToDomain(req *mgmt_pb.AddOIDCInitialAccessTokenRequest) *domain.OIDCInitialAccessToken {
+	expirationDate := time.Time{}
+	if req.ExpirationDate != nil {
+		expirationDate = req.ExpirationDate.AsTime()
+	}
+
+	return &domain.OIDCInitialAccessToken{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.ProjectId,
+		},
+		ExpirationDate: expirationDate,
+	}
+}
+
 func AddAPIClientKeyRequestToDomain(key *mgmt_pb.AddAppKeyRequest) *domain.ApplicationKey {
 	expirationDate := time.Time{}
 	if key.ExpirationDate != nil {
@@ -86,6 +86,36 @@ func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.Deactivat
 	}, nil
 }
 
+func (s *Server) SetUserGrantValidity(ctx context.Context, req *mgmt_pb.SetUserGrantValidityRequest) (*mgmt_pb.SetUserGrantValidityResponse, error) {
+	objectDetails, err := s.command.SetUserGrantValidity(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID, user.ValidityToDomain(req.ValidFrom), user.ValidityToDomain(req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserGrantValidityResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) ApproveUserGrant(ctx context.Context, req *mgmt_pb.ApproveUserGrantRequest) (*mgmt_pb.ApproveUserGrantResponse, error) {
+	objectDetails, err := s.command.ApproveUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ApproveUserGrantResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RejectUserGrant(ctx context.Context, req *mgmt_pb.RejectUserGrantRequest) (*mgmt_pb.RejectUserGrantResponse, error) {
+	objectDetails, err := s.command.RejectUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RejectUserGrantResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) ReactivateUserGrant(ctx context.Context, req *mgmt_pb.ReactivateUserGrantRequest) (*mgmt_pb.ReactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.ReactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
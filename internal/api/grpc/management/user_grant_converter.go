@@ -55,6 +55,8 @@ func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserG
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		ValidFrom:      user_grpc.ValidityToDomain(req.ValidFrom),
+		ValidUntil:     user_grpc.ValidityToDomain(req.ValidUntil),
 	}
 }
 
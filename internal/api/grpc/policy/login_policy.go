@@ -24,6 +24,7 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 		AllowDomainDiscovery:       policy.AllowDomainDiscovery,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		AllowMagicLink:             policy.AllowMagicLink,
 		DefaultRedirectUri:         policy.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(policy.PasswordCheckLifetime),
 		ExternalLoginCheckLifetime: durationpb.New(policy.ExternalLoginCheckLifetime),
@@ -33,6 +34,7 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 		SecondFactors:              ModelSecondFactorTypesToPb(policy.SecondFactors),
 		MultiFactors:               ModelMultiFactorTypesToPb(policy.MultiFactors),
 		Idps:                       idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
+		RiskRules:                  RiskRulesToPb(&policy.RiskRules),
 		Details: &object.ObjectDetails{
 			Sequence:      policy.Sequence,
 			CreationDate:  timestamppb.New(policy.CreationDate),
@@ -42,6 +44,18 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 	}
 }
 
+func RiskRulesToPb(rules *domain.RiskRules) *policy_pb.LoginRiskRules {
+	return &policy_pb.LoginRiskRules{
+		Enabled:               rules.Enabled,
+		NewDevice:             rules.NewDevice,
+		NewNetwork:            rules.NewNetwork,
+		NewCountry:            rules.NewCountry,
+		ImpossibleTravelSpeed: rules.ImpossibleTravelSpeed,
+		MaxRecentFailures:     rules.MaxRecentFailures,
+		RecentFailuresWindow:  durationpb.New(rules.RecentFailuresWindow),
+	}
+}
+
 func PasswordlessTypeToDomain(passwordlessType policy_pb.PasswordlessType) domain.PasswordlessType {
 	switch passwordlessType {
 	case policy_pb.PasswordlessType_PASSWORDLESS_TYPE_ALLOWED:
@@ -39,10 +39,11 @@ var (
 		runtime.WithMarshalerOption(mimeWildcard, jsonMarshaler),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
 		runtime.WithIncomingHeaderMatcher(headerMatcher),
-		runtime.WithOutgoingHeaderMatcher(runtime.DefaultHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(headerMatcher),
 		runtime.WithForwardResponseOption(responseForwarder),
 	}
 
+	// headerMatcher passes the custom headers of the requests and responses (e.g. x-zitadel-position) unchanged
 	headerMatcher = runtime.HeaderMatcherFunc(
 		func(header string) (string, bool) {
 			for _, customHeader := range customHeaders {
@@ -27,6 +27,12 @@ func (v *verifierMock) VerifyAccessToken(ctx context.Context, token, clientID, p
 func (v *verifierMock) SearchMyMemberships(ctx context.Context, orgID string) ([]*authz.Membership, error) {
 	return nil, nil
 }
+func (v *verifierMock) SearchCustomRoleMappings(ctx context.Context) ([]authz.RoleMapping, error) {
+	return nil, nil
+}
+func (v *verifierMock) SearchMyInheritedMemberships(ctx context.Context, orgID string) ([]*authz.Membership, error) {
+	return nil, nil
+}
 
 func (v *verifierMock) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (string, []string, error) {
 	return "", nil, nil
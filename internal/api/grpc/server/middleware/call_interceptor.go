@@ -2,10 +2,15 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/zitadel/logging"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/zitadel/zitadel/internal/api/call"
+	grpc_util "github.com/zitadel/zitadel/internal/api/grpc"
+	"github.com/zitadel/zitadel/internal/api/http"
 )
 
 func CallDurationHandler() grpc.UnaryServerInterceptor {
@@ -14,3 +19,21 @@ func CallDurationHandler() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// CallPositionHandler reads the position the client requires the projections to have processed
+// (the x-zitadel-position header returned by a previous change) to provide read-your-writes on replicas.
+// If the call pushes events, the reads of the call require their position
+// and the position is returned in the x-zitadel-position header.
+func CallPositionHandler() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// a missing or invalid header requires no position
+		requested, _ := strconv.ParseUint(grpc_util.GetHeader(ctx, http.ZitadelPosition), 10, 64)
+		ctx = call.WithPosition(ctx, requested)
+		resp, err := handler(ctx, req)
+		if position := call.PositionFromContext(ctx); position > requested {
+			headerErr := grpc.SetHeader(ctx, metadata.Pairs(http.ZitadelPosition, strconv.FormatUint(position, 10)))
+			logging.OnError(headerErr).Debug("unable to set position header")
+		}
+		return resp, err
+	}
+}
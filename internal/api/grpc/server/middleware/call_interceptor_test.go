@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/api/http"
+)
+
+type mockTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *mockTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestCallPositionHandler(t *testing.T) {
+	type res struct {
+		position uint64
+		header   []string
+	}
+	tests := []struct {
+		name   string
+		header string
+		pushed uint64
+		res    res
+	}{
+		{
+			name: "no position",
+			res:  res{},
+		},
+		{
+			name:   "invalid position",
+			header: "invalid",
+			res:    res{},
+		},
+		{
+			name:   "requested position",
+			header: "42",
+			res: res{
+				position: 42,
+			},
+		},
+		{
+			name:   "pushed events",
+			header: "42",
+			pushed: 50,
+			res: res{
+				position: 50,
+				header:   []string{"50"},
+			},
+		},
+		{
+			name:   "pushed events before requested position",
+			header: "42",
+			pushed: 40,
+			res: res{
+				position: 42,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := new(mockTransportStream)
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(http.ZitadelPosition, tt.header))
+			}
+			var position uint64
+			_, err := CallPositionHandler()(ctx, &mockReq{}, mockInfo("/test"), func(ctx context.Context, req interface{}) (interface{}, error) {
+				call.RaisePosition(ctx, tt.pushed)
+				position = call.PositionFromContext(ctx)
+				return req, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.position, position)
+			assert.Equal(t, tt.res.header, stream.header.Get(http.ZitadelPosition))
+		})
+	}
+}
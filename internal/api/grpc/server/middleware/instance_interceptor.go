@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -50,9 +51,9 @@ func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInf
 				if errs.As(err, &notFoundErr) {
 					notFoundErr.Message = translator.LocalizeFromCtx(ctx, notFoundErr.GetMessage(), nil)
 				}
-				return nil, status.Error(codes.NotFound, err.Error())
+				return nil, status.Error(instanceErrCode(err), err.Error())
 			}
-			return handler(authz.WithInstance(ctx, instance), req)
+			return handler(withInstance(ctx, instance), req)
 		}
 	}
 
@@ -66,10 +67,23 @@ func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInf
 		if errs.As(err, &notFoundErr) {
 			notFoundErr.Message = translator.LocalizeFromCtx(ctx, notFoundErr.GetMessage(), nil)
 		}
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, status.Error(instanceErrCode(err), err.Error())
 	}
 	span.End()
-	return handler(authz.WithInstance(ctx, instance), req)
+	return handler(withInstance(ctx, instance), req)
+}
+
+// withInstance sets the instance and selects the database shard the instance is stored in
+func withInstance(ctx context.Context, instance authz.Instance) context.Context {
+	return database.WithShard(authz.WithInstance(ctx, instance), instance.Shard())
+}
+
+// instanceErrCode returns unavailable while the instance is moved to another database shard
+func instanceErrCode(err error) codes.Code {
+	if errors.IsUnavailable(err) {
+		return codes.Unavailable
+	}
+	return codes.NotFound
 }
 
 func hostFromContext(ctx context.Context, headerName string) (string, error) {
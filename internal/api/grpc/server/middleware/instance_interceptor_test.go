@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 )
 
 func Test_hostNameFromContext(t *testing.T) {
@@ -108,7 +109,7 @@ func Test_setInstance(t *testing.T) {
 			args{
 				ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs("header", "host2")),
 				req:        &mockRequest{},
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 			},
 			res{
@@ -121,7 +122,7 @@ func Test_setInstance(t *testing.T) {
 			args{
 				ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs("header", "host")),
 				req:        &mockRequest{},
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 				handler: func(ctx context.Context, req interface{}) (interface{}, error) {
 					return req, nil
@@ -132,6 +133,22 @@ func Test_setInstance(t *testing.T) {
 				err:  false,
 			},
 		},
+		{
+			"valid host, shard of instance selected",
+			args{
+				ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs("header", "host")),
+				req:        &mockRequest{},
+				verifier:   &mockInstanceVerifier{host: "host", shard: "eu2"},
+				headerName: "header",
+				handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+					return database.ShardFromContext(ctx), nil
+				},
+			},
+			res{
+				want: "eu2",
+				err:  false,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -150,19 +167,22 @@ func Test_setInstance(t *testing.T) {
 type mockRequest struct{}
 
 type mockInstanceVerifier struct {
-	host string
+	host  string
+	shard string
 }
 
 func (m *mockInstanceVerifier) InstanceByHost(_ context.Context, host string) (authz.Instance, error) {
 	if host != m.host {
 		return nil, fmt.Errorf("invalid host")
 	}
-	return &mockInstance{}, nil
+	return &mockInstance{shard: m.shard}, nil
 }
 
 func (m *mockInstanceVerifier) InstanceByID(context.Context) (authz.Instance, error) { return nil, nil }
 
-type mockInstance struct{}
+type mockInstance struct {
+	shard string
+}
 
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
@@ -199,3 +219,7 @@ func (m *mockInstance) RequestedHost() string {
 func (m *mockInstance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
+
+func (m *mockInstance) Shard() string {
+	return m.shard
+}
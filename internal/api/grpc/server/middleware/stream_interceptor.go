@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/errors"
+)
+
+// StreamInterceptor runs the unary interceptor on the request of a server streaming call,
+// so that streams are checked the same way as unary calls.
+// The interceptor is executed as soon as the handler received the request.
+// Interceptors which handle the response are not supported.
+func StreamInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &interceptedStream{
+			ServerStream: stream,
+			interceptor:  interceptor,
+			info: &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: info.FullMethod,
+			},
+		})
+	}
+}
+
+// StreamErrorHandler maps the errors of server streaming calls the same way as [ErrorHandler]
+func StreamErrorHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return errors.CaosToGRPCError(stream.Context(), handler(srv, stream))
+	}
+}
+
+type interceptedStream struct {
+	grpc.ServerStream
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	ctx         context.Context
+	received    bool
+}
+
+func (s *interceptedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *interceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.received {
+		return nil
+	}
+	s.received = true
+	_, err := s.interceptor(s.ServerStream.Context(), m, s.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		s.ctx = ctx
+		return nil, nil
+	})
+	return err
+}
@@ -6,7 +6,10 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	grpc_util "github.com/zitadel/zitadel/internal/api/grpc"
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/command"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/query"
@@ -39,6 +42,7 @@ func (s *Server) ListSessions(ctx context.Context, req *session.ListSessionsRequ
 }
 
 func (s *Server) CreateSession(ctx context.Context, req *session.CreateSessionRequest) (*session.CreateSessionResponse, error) {
+	ctx = withCaptchaResponse(ctx)
 	checks, metadata, err := s.createSessionRequestToCommand(ctx, req)
 	if err != nil {
 		return nil, err
@@ -51,10 +55,12 @@ func (s *Server) CreateSession(ctx context.Context, req *session.CreateSessionRe
 		Details:      object.DomainToDetailsPb(set.ObjectDetails),
 		SessionId:    set.ID,
 		SessionToken: set.NewToken,
+		Challenges:   challengesToPb(set),
 	}, nil
 }
 
 func (s *Server) SetSession(ctx context.Context, req *session.SetSessionRequest) (*session.SetSessionResponse, error) {
+	ctx = withCaptchaResponse(ctx)
 	checks, err := s.setSessionRequestToCommand(ctx, req)
 	if err != nil {
 		return nil, err
@@ -70,6 +76,7 @@ func (s *Server) SetSession(ctx context.Context, req *session.SetSessionRequest)
 	return &session.SetSessionResponse{
 		Details:      object.DomainToDetailsPb(set.ObjectDetails),
 		SessionToken: set.NewToken,
+		Challenges:   challengesToPb(set),
 	}, nil
 }
 
@@ -105,12 +112,32 @@ func sessionToPb(s *query.Session) *session.Session {
 func factorsToPb(s *query.Session) *session.Factors {
 	user := userFactorToPb(s.UserFactor)
 	pw := passwordFactorToPb(s.PasswordFactor)
-	if user == nil && pw == nil {
+	magicLink := magicLinkFactorToPb(s.MagicLinkFactor)
+	if user == nil && pw == nil && magicLink == nil {
 		return nil
 	}
 	return &session.Factors{
-		User:     user,
-		Password: pw,
+		User:      user,
+		Password:  pw,
+		MagicLink: magicLink,
+	}
+}
+
+func magicLinkFactorToPb(factor query.SessionMagicLinkFactor) *session.MagicLinkFactor {
+	if factor.MagicLinkCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.MagicLinkFactor{
+		VerifiedAt: timestamppb.New(factor.MagicLinkCheckedAt),
+	}
+}
+
+func challengesToPb(set *command.SessionChanged) *session.Challenges {
+	if set.MagicLinkCode == "" {
+		return nil
+	}
+	return &session.Challenges{
+		MagicLinkCode: &set.MagicLinkCode,
 	}
 }
 
@@ -185,7 +212,7 @@ func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session
 	if err != nil {
 		return nil, nil, err
 	}
-	return checks, req.GetMetadata(), nil
+	return append(checks, challengesToCommand(req.GetChallenges())...), req.GetMetadata(), nil
 }
 
 func (s *Server) setSessionRequestToCommand(ctx context.Context, req *session.SetSessionRequest) ([]command.SessionCheck, error) {
@@ -193,7 +220,7 @@ func (s *Server) setSessionRequestToCommand(ctx context.Context, req *session.Se
 	if err != nil {
 		return nil, err
 	}
-	return checks, nil
+	return append(checks, challengesToCommand(req.GetChallenges())...), nil
 }
 
 func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([]command.SessionCheck, error) {
@@ -201,7 +228,7 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if err != nil {
 		return nil, err
 	}
-	sessionChecks := make([]command.SessionCheck, 0, 2)
+	sessionChecks := make([]command.SessionCheck, 0, 3)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
 		if err != nil {
@@ -212,9 +239,25 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if password := checks.GetPassword(); password != nil {
 		sessionChecks = append(sessionChecks, command.CheckPassword(password.GetPassword()))
 	}
+	if magicLink := checks.GetMagicLink(); magicLink != nil {
+		sessionChecks = append(sessionChecks, command.CheckMagicLink(magicLink.GetCode()))
+	}
 	return sessionChecks, nil
 }
 
+// withCaptchaResponse passes the solved captcha of the request header to the password check
+func withCaptchaResponse(ctx context.Context) context.Context {
+	return bruteforce.WithCaptchaResponse(ctx, grpc_util.GetHeader(ctx, http_util.ZitadelCaptchaResponse))
+}
+
+// challengesToCommand returns the challenges as [command.SessionCheck], so they are executed after the checks of the same request
+func challengesToCommand(challenges *session.RequestChallenges) []command.SessionCheck {
+	if magicLink := challenges.GetMagicLink(); magicLink != nil {
+		return []command.SessionCheck{command.RequestMagicLink(magicLink.GetUrlTemplate(), magicLink.GetReturnCode())}
+	}
+	return nil
+}
+
 func userCheck(user *session.CheckUser) (userSearch, error) {
 	if user == nil {
 		return nil, nil
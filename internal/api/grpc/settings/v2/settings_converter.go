@@ -30,6 +30,7 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		AllowDomainDiscovery:       current.AllowDomainDiscovery,
 		DisableLoginWithEmail:      current.DisableLoginWithEmail,
 		DisableLoginWithPhone:      current.DisableLoginWithPhone,
+		AllowMagicLink:             current.AllowMagicLink,
 		DefaultRedirectUri:         current.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(current.PasswordCheckLifetime),
 		ExternalLoginCheckLifetime: durationpb.New(current.ExternalLoginCheckLifetime),
@@ -92,6 +93,7 @@ func passwordSettingsToPb(current *query.PasswordComplexityPolicy) *settings.Pas
 		RequiresNumber:    current.HasNumber,
 		RequiresSymbol:    current.HasSymbol,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		CheckBreached:     current.CheckBreached,
 	}
 }
 
@@ -133,12 +135,14 @@ func legalAndSupportSettingsToPb(current *query.PrivacyPolicy) *settings.LegalAn
 		HelpLink:          current.HelpLink,
 		SupportEmail:      string(current.SupportEmail),
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		AllowSelfDelete:   current.AllowSelfDelete,
 	}
 }
 
 func lockoutSettingsToPb(current *query.LockoutPolicy) *settings.LockoutSettings {
 	return &settings.LockoutSettings{
 		MaxPasswordAttempts: current.MaxPasswordAttempts,
+		MaxMfaAttempts:      current.MaxMFAAttempts,
 		ResourceOwnerType:   isDefaultToResourceOwnerTypePb(current.IsDefault),
 	}
 }
@@ -31,6 +31,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      time.Hour,
 		ExternalLoginCheckLifetime: time.Minute,
@@ -58,6 +59,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
@@ -199,12 +201,13 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 
 func Test_passwordSettingsToPb(t *testing.T) {
 	arg := &query.PasswordComplexityPolicy{
-		MinLength:    12,
-		HasUppercase: true,
-		HasLowercase: true,
-		HasNumber:    true,
-		HasSymbol:    true,
-		IsDefault:    true,
+		MinLength:     12,
+		HasUppercase:  true,
+		HasLowercase:  true,
+		HasNumber:     true,
+		HasSymbol:     true,
+		IsDefault:     true,
+		CheckBreached: true,
 	}
 	want := &settings.PasswordComplexitySettings{
 		MinLength:         12,
@@ -213,6 +216,7 @@ func Test_passwordSettingsToPb(t *testing.T) {
 		RequiresNumber:    true,
 		RequiresSymbol:    true,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		CheckBreached:     true,
 	}
 
 	got := passwordSettingsToPb(arg)
@@ -298,11 +302,12 @@ func Test_domainSettingsToPb(t *testing.T) {
 
 func Test_legalSettingsToPb(t *testing.T) {
 	arg := &query.PrivacyPolicy{
-		TOSLink:      "http://example.com/tos",
-		PrivacyLink:  "http://example.com/pricacy",
-		HelpLink:     "http://example.com/help",
-		SupportEmail: "support@zitadel.com",
-		IsDefault:    true,
+		TOSLink:         "http://example.com/tos",
+		PrivacyLink:     "http://example.com/pricacy",
+		HelpLink:        "http://example.com/help",
+		SupportEmail:    "support@zitadel.com",
+		AllowSelfDelete: true,
+		IsDefault:       true,
 	}
 	want := &settings.LegalAndSupportSettings{
 		TosLink:           "http://example.com/tos",
@@ -310,6 +315,7 @@ func Test_legalSettingsToPb(t *testing.T) {
 		HelpLink:          "http://example.com/help",
 		SupportEmail:      "support@zitadel.com",
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		AllowSelfDelete:   true,
 	}
 	got := legalAndSupportSettingsToPb(arg)
 	grpc.AllFieldsSet(t, got.ProtoReflect(), ignoreTypes...)
@@ -321,10 +327,12 @@ func Test_legalSettingsToPb(t *testing.T) {
 func Test_lockoutSettingsToPb(t *testing.T) {
 	arg := &query.LockoutPolicy{
 		MaxPasswordAttempts: 22,
+		MaxMFAAttempts:      3,
 		IsDefault:           true,
 	}
 	want := &settings.LockoutSettings{
 		MaxPasswordAttempts: 22,
+		MaxMfaAttempts:      3,
 		ResourceOwnerType:   settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
 	}
 	got := lockoutSettingsToPb(arg)
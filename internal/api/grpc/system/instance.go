@@ -93,6 +93,13 @@ func (s *Server) RemoveInstance(ctx context.Context, req *system_pb.RemoveInstan
 	}, nil
 }
 
+func (s *Server) MoveInstance(ctx context.Context, req *system_pb.MoveInstanceRequest) (*system_pb.MoveInstanceResponse, error) {
+	if err := s.mover.Move(ctx, req.InstanceId, req.Shard); err != nil {
+		return nil, err
+	}
+	return &system_pb.MoveInstanceResponse{}, nil
+}
+
 func (s *Server) ListIAMMembers(ctx context.Context, req *system_pb.ListIAMMembersRequest) (*system_pb.ListIAMMembersResponse, error) {
 	queries, err := ListIAMMembersRequestToQuery(req)
 	if err != nil {
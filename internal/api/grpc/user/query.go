@@ -40,6 +40,8 @@ func UserQueryToQuery(query *user_pb.SearchQuery) (query.SearchQuery, error) {
 		return LoginNameQueryToQuery(q.LoginNameQuery)
 	case *user_pb.SearchQuery_ResourceOwner:
 		return ResourceOwnerQueryToQuery(q.ResourceOwner)
+	case *user_pb.SearchQuery_MetadataQuery:
+		return MetadataQueryToQuery(q.MetadataQuery)
 	default:
 		return nil, errors.ThrowInvalidArgument(nil, "GRPC-vR9nC", "List.Query.Invalid")
 	}
@@ -81,6 +83,19 @@ func LoginNameQueryToQuery(q *user_pb.LoginNameQuery) (query.SearchQuery, error)
 	return query.NewUserLoginNameExistsQuery(q.LoginName, object.TextMethodToQuery(q.Method))
 }
 
+func MetadataQueryToQuery(q *user_pb.MetadataQuery) (query.SearchQuery, error) {
+	switch q.Method {
+	case user_pb.MetadataQueryMethod_METADATA_QUERY_METHOD_KEY_EXISTS:
+		return query.NewUserMetadataKeyExistsQuery(q.Key)
+	case user_pb.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_EQUALS:
+		return query.NewUserMetadataValueSearchQuery(q.Key, q.Value, query.BytesEquals)
+	case user_pb.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_STARTS_WITH:
+		return query.NewUserMetadataValueSearchQuery(q.Key, q.Value, query.BytesStartsWith)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "GRPC-Md3qy", "List.Query.Invalid")
+	}
+}
+
 func ResourceOwnerQueryToQuery(q *user_pb.ResourceOwnerQuery) (query.SearchQuery, error) {
 	return query.NewUserResourceOwnerSearchQuery(q.OrgID, query.TextEquals)
 }
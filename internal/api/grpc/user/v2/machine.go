@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func (s *Server) AddMachineUser(ctx context.Context, req *user.AddMachineUserRequest) (*user.AddMachineUserResponse, error) {
+	machine := addMachineUserRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
+	details, err := s.command.AddMachine(ctx, machine)
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddMachineUserResponse{
+		UserId:  machine.AggregateID,
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func addMachineUserRequestToCommand(req *user.AddMachineUserRequest, orgID string) *command.Machine {
+	return &command.Machine{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.GetUserId(),
+			ResourceOwner: orgID,
+		},
+		Username:    req.GetUsername(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+}
+
+func (s *Server) UpdateMachineUser(ctx context.Context, req *user.UpdateMachineUserRequest) (*user.UpdateMachineUserResponse, error) {
+	details, err := s.command.ChangeMachineV2(ctx, req.GetUserId(), req.Name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateMachineUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
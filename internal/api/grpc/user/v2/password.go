@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func (s *Server) SetPassword(ctx context.Context, req *user.SetPasswordRequest) (_ *user.SetPasswordResponse, err error) {
+	var details *domain.ObjectDetails
+
+	switch v := req.GetVerification().(type) {
+	case *user.SetPasswordRequest_CurrentPassword:
+		details, err = s.command.ChangePasswordV2(ctx, req.GetUserId(), v.CurrentPassword, req.GetNewPassword().GetPassword())
+	case *user.SetPasswordRequest_VerificationCode:
+		details, err = s.command.SetPasswordWithVerifyCodeV2(ctx, req.GetUserId(), v.VerificationCode, req.GetNewPassword().GetPassword(), s.userCodeAlg)
+	case nil:
+		details, err = s.command.SetPasswordV2(ctx, req.GetUserId(), req.GetNewPassword().GetPassword(), req.GetNewPassword().GetChangeRequired())
+	default:
+		err = caos_errs.ThrowUnimplementedf(nil, "USERv2-Sp3ni", "verification oneOf %T in method SetPassword not implemented", v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetPasswordResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) PasswordReset(ctx context.Context, req *user.PasswordResetRequest) (*user.PasswordResetResponse, error) {
+	details, err := s.command.RequestPasswordReset(ctx, req.GetUserId(), notificationTypeToDomain(req.GetMedium()), s.userCodeAlg)
+	if err != nil {
+		return nil, err
+	}
+	return &user.PasswordResetResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func notificationTypeToDomain(notificationType user.NotificationType) domain.NotificationType {
+	switch notificationType {
+	case user.NotificationType_NOTIFICATION_TYPE_SMS:
+		return domain.NotificationTypeSms
+	case user.NotificationType_NOTIFICATION_TYPE_EMAIL:
+		return domain.NotificationTypeEmail
+	default:
+		return domain.NotificationTypeEmail
+	}
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func (s *Server) SetPhone(ctx context.Context, req *user.SetPhoneRequest) (*user.SetPhoneResponse, error) {
+	phone, err := s.command.ChangeUserPhone(ctx, req.GetUserId(), req.GetPhone(), req.GetIsVerified(), s.userCodeAlg)
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetPhoneResponse{
+		Details: &object_pb.Details{
+			Sequence:      phone.Sequence,
+			ChangeDate:    timestamppb.New(phone.ChangeDate),
+			ResourceOwner: phone.ResourceOwner,
+		},
+	}, nil
+}
+
+func (s *Server) VerifyPhone(ctx context.Context, req *user.VerifyPhoneRequest) (*user.VerifyPhoneResponse, error) {
+	details, err := s.command.VerifyUserPhone(ctx, req.GetUserId(), req.GetVerificationCode(), s.userCodeAlg)
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyPhoneResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResendPhoneCode(ctx context.Context, req *user.ResendPhoneCodeRequest) (*user.ResendPhoneCodeResponse, error) {
+	details, err := s.command.ResendUserPhoneCode(ctx, req.GetUserId(), s.userCodeAlg)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ResendPhoneCodeResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemovePhone(ctx context.Context, req *user.RemovePhoneRequest) (*user.RemovePhoneResponse, error) {
+	details, err := s.command.RemoveUserPhone(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemovePhoneResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
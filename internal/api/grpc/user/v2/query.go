@@ -0,0 +1,265 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func (s *Server) GetUserByID(ctx context.Context, req *user.GetUserByIDRequest) (*user.GetUserByIDResponse, error) {
+	resp, err := s.query.GetUserByID(ctx, true, req.GetUserId(), false)
+	if err != nil {
+		return nil, err
+	}
+	if authz.GetCtxData(ctx).UserID != req.GetUserId() {
+		if err = s.checkPermission(ctx, domain.PermissionUserRead, resp.ResourceOwner, req.GetUserId()); err != nil {
+			return nil, err
+		}
+	}
+	return &user.GetUserByIDResponse{
+		User: userToPb(resp, s.assetAPIPrefix(ctx)),
+	}, nil
+}
+
+func (s *Server) ListUsers(ctx context.Context, req *user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	queries, err := listUsersRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	err = queries.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUsers(ctx, queries, false)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListUsersResponse{
+		Details:       object.ToListDetails(res.SearchResponse),
+		SortingColumn: req.GetSortingColumn(),
+		Result:        usersToPb(res.Users, s.assetAPIPrefix(ctx)),
+	}, nil
+}
+
+func listUsersRequestToModel(req *user.ListUsersRequest) (*query.UserSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToQuery(req.GetQuery())
+	queries, err := userQueriesToQuery(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: userFieldNameToSortingColumn(req.GetSortingColumn()),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func userFieldNameToSortingColumn(field user.UserFieldName) query.Column {
+	switch field {
+	case user.UserFieldName_USER_FIELD_NAME_EMAIL:
+		return query.HumanEmailCol
+	case user.UserFieldName_USER_FIELD_NAME_FIRST_NAME:
+		return query.HumanFirstNameCol
+	case user.UserFieldName_USER_FIELD_NAME_LAST_NAME:
+		return query.HumanLastNameCol
+	case user.UserFieldName_USER_FIELD_NAME_DISPLAY_NAME:
+		return query.HumanDisplayNameCol
+	case user.UserFieldName_USER_FIELD_NAME_USER_NAME:
+		return query.UserUsernameCol
+	case user.UserFieldName_USER_FIELD_NAME_STATE:
+		return query.UserStateCol
+	case user.UserFieldName_USER_FIELD_NAME_TYPE:
+		return query.UserTypeCol
+	case user.UserFieldName_USER_FIELD_NAME_NICK_NAME:
+		return query.HumanNickNameCol
+	case user.UserFieldName_USER_FIELD_NAME_CREATION_DATE:
+		return query.UserCreationDateCol
+	default:
+		return query.UserIDCol
+	}
+}
+
+func userQueriesToQuery(queries []*user.SearchQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = userQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func userQueryToQuery(q *user.SearchQuery) (query.SearchQuery, error) {
+	switch q := q.GetQuery().(type) {
+	case *user.SearchQuery_UserNameQuery:
+		return query.NewUserUsernameSearchQuery(q.UserNameQuery.GetUserName(), object.TextMethodToQuery(q.UserNameQuery.GetMethod()))
+	case *user.SearchQuery_FirstNameQuery:
+		return query.NewUserFirstNameSearchQuery(q.FirstNameQuery.GetFirstName(), object.TextMethodToQuery(q.FirstNameQuery.GetMethod()))
+	case *user.SearchQuery_LastNameQuery:
+		return query.NewUserLastNameSearchQuery(q.LastNameQuery.GetLastName(), object.TextMethodToQuery(q.LastNameQuery.GetMethod()))
+	case *user.SearchQuery_DisplayNameQuery:
+		return query.NewUserDisplayNameSearchQuery(q.DisplayNameQuery.GetDisplayName(), object.TextMethodToQuery(q.DisplayNameQuery.GetMethod()))
+	case *user.SearchQuery_EmailQuery:
+		return query.NewUserEmailSearchQuery(q.EmailQuery.GetEmailAddress(), object.TextMethodToQuery(q.EmailQuery.GetMethod()))
+	case *user.SearchQuery_StateQuery:
+		return query.NewUserStateSearchQuery(int32(userStateToDomain(q.StateQuery.GetState())))
+	case *user.SearchQuery_TypeQuery:
+		return query.NewUserTypeSearchQuery(int32(userTypeToDomain(q.TypeQuery.GetType())))
+	case *user.SearchQuery_LoginNameQuery:
+		return query.NewUserLoginNameExistsQuery(q.LoginNameQuery.GetLoginName(), object.TextMethodToQuery(q.LoginNameQuery.GetMethod()))
+	case *user.SearchQuery_MetadataQuery:
+		return metadataQueryToQuery(q.MetadataQuery)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "USERv2-Qs9ma", "List.Query.Invalid")
+	}
+}
+
+func metadataQueryToQuery(q *user.MetadataQuery) (query.SearchQuery, error) {
+	switch q.GetMethod() {
+	case user.MetadataQueryMethod_METADATA_QUERY_METHOD_KEY_EXISTS:
+		return query.NewUserMetadataKeyExistsQuery(q.GetKey())
+	case user.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_EQUALS:
+		return query.NewUserMetadataValueSearchQuery(q.GetKey(), q.GetValue(), query.BytesEquals)
+	case user.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_STARTS_WITH:
+		return query.NewUserMetadataValueSearchQuery(q.GetKey(), q.GetValue(), query.BytesStartsWith)
+	default:
+		return nil, errors.ThrowInvalidArgument(nil, "USERv2-Md4la", "List.Query.Invalid")
+	}
+}
+
+func userStateToDomain(state user.UserState) domain.UserState {
+	switch state {
+	case user.UserState_USER_STATE_ACTIVE:
+		return domain.UserStateActive
+	case user.UserState_USER_STATE_INACTIVE:
+		return domain.UserStateInactive
+	case user.UserState_USER_STATE_DELETED:
+		return domain.UserStateDeleted
+	case user.UserState_USER_STATE_LOCKED:
+		return domain.UserStateLocked
+	case user.UserState_USER_STATE_INITIAL:
+		return domain.UserStateInitial
+	default:
+		return domain.UserStateUnspecified
+	}
+}
+
+func userTypeToDomain(userType user.Type) domain.UserType {
+	switch userType {
+	case user.Type_TYPE_HUMAN:
+		return domain.UserTypeHuman
+	case user.Type_TYPE_MACHINE:
+		return domain.UserTypeMachine
+	default:
+		return domain.UserTypeUnspecified
+	}
+}
+
+func usersToPb(users []*query.User, assetPrefix string) []*user.User {
+	u := make([]*user.User, len(users))
+	for i, user := range users {
+		u[i] = userToPb(user, assetPrefix)
+	}
+	return u
+}
+
+func userToPb(u *query.User, assetPrefix string) *user.User {
+	pb := &user.User{
+		Id:                 u.ID,
+		Details:            userDetailsToPb(u),
+		State:              userStateToPb(u.State),
+		Username:           u.Username,
+		LoginNames:         u.LoginNames,
+		PreferredLoginName: u.PreferredLoginName,
+	}
+	if u.Human != nil {
+		pb.Type = &user.User_Human{
+			Human: humanToPb(u.Human, assetPrefix, u.ResourceOwner),
+		}
+	}
+	if u.Machine != nil {
+		pb.Type = &user.User_Machine{
+			Machine: &user.MachineUser{
+				Name:        u.Machine.Name,
+				Description: u.Machine.Description,
+			},
+		}
+	}
+	return pb
+}
+
+func userDetailsToPb(u *query.User) *object_pb.Details {
+	details := &object_pb.Details{
+		Sequence:      u.Sequence,
+		ResourceOwner: u.ResourceOwner,
+	}
+	if !u.ChangeDate.IsZero() {
+		details.ChangeDate = timestamppb.New(u.ChangeDate)
+	}
+	return details
+}
+
+func humanToPb(human *query.Human, assetPrefix, owner string) *user.HumanUser {
+	return &user.HumanUser{
+		Profile: &user.HumanProfile{
+			FirstName:         human.FirstName,
+			LastName:          human.LastName,
+			NickName:          human.NickName,
+			DisplayName:       human.DisplayName,
+			PreferredLanguage: human.PreferredLanguage.String(),
+			Gender:            genderToPb(human.Gender),
+			AvatarUrl:         domain.AvatarURL(assetPrefix, owner, human.AvatarKey),
+		},
+		Email: &user.HumanEmail{
+			Email:      string(human.Email),
+			IsVerified: human.IsEmailVerified,
+		},
+		Phone: &user.HumanPhone{
+			Phone:      string(human.Phone),
+			IsVerified: human.IsPhoneVerified,
+		},
+	}
+}
+
+func userStateToPb(state domain.UserState) user.UserState {
+	switch state {
+	case domain.UserStateActive:
+		return user.UserState_USER_STATE_ACTIVE
+	case domain.UserStateInactive:
+		return user.UserState_USER_STATE_INACTIVE
+	case domain.UserStateDeleted:
+		return user.UserState_USER_STATE_DELETED
+	case domain.UserStateLocked:
+		return user.UserState_USER_STATE_LOCKED
+	case domain.UserStateInitial:
+		return user.UserState_USER_STATE_INITIAL
+	default:
+		return user.UserState_USER_STATE_UNSPECIFIED
+	}
+}
+
+func genderToPb(gender domain.Gender) user.Gender {
+	switch gender {
+	case domain.GenderFemale:
+		return user.Gender_GENDER_FEMALE
+	case domain.GenderMale:
+		return user.Gender_GENDER_MALE
+	case domain.GenderDiverse:
+		return user.Gender_GENDER_DIVERSE
+	default:
+		return user.Gender_GENDER_UNSPECIFIED
+	}
+}
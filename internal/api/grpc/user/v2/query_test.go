@@ -0,0 +1,118 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
+)
+
+func Test_metadataQueryToQuery(t *testing.T) {
+	type args struct {
+		q *user.MetadataQuery
+	}
+	type res struct {
+		want query.SearchQuery
+		err  func(error) bool
+	}
+	keyExists, err := query.NewUserMetadataKeyExistsQuery("key")
+	require.NoError(t, err)
+	valueEquals, err := query.NewUserMetadataValueSearchQuery("key", []byte("value"), query.BytesEquals)
+	require.NoError(t, err)
+	valueStartsWith, err := query.NewUserMetadataValueSearchQuery("key", []byte("val"), query.BytesStartsWith)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"key exists",
+			args{
+				q: &user.MetadataQuery{
+					Key:    "key",
+					Value:  []byte("ignored"),
+					Method: user.MetadataQueryMethod_METADATA_QUERY_METHOD_KEY_EXISTS,
+				},
+			},
+			res{
+				want: keyExists,
+			},
+		},
+		{
+			"value equals",
+			args{
+				q: &user.MetadataQuery{
+					Key:    "key",
+					Value:  []byte("value"),
+					Method: user.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_EQUALS,
+				},
+			},
+			res{
+				want: valueEquals,
+			},
+		},
+		{
+			"value starts with",
+			args{
+				q: &user.MetadataQuery{
+					Key:    "key",
+					Value:  []byte("val"),
+					Method: user.MetadataQueryMethod_METADATA_QUERY_METHOD_VALUE_STARTS_WITH,
+				},
+			},
+			res{
+				want: valueStartsWith,
+			},
+		},
+		{
+			"invalid method",
+			args{
+				q: &user.MetadataQuery{
+					Key:    "key",
+					Method: -1,
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, caos_errs.ThrowInvalidArgument(nil, "USERv2-Md4la", "List.Query.Invalid"))
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := metadataQueryToQuery(tt.args.q)
+			if tt.res.err == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.res.want, got)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func Test_userStateToDomain(t *testing.T) {
+	for state, want := range map[user.UserState]domain.UserState{
+		user.UserState_USER_STATE_UNSPECIFIED: domain.UserStateUnspecified,
+		user.UserState_USER_STATE_ACTIVE:      domain.UserStateActive,
+		user.UserState_USER_STATE_INACTIVE:    domain.UserStateInactive,
+		user.UserState_USER_STATE_DELETED:     domain.UserStateDeleted,
+		user.UserState_USER_STATE_LOCKED:      domain.UserStateLocked,
+		user.UserState_USER_STATE_INITIAL:     domain.UserStateInitial,
+	} {
+		t.Run(state.String(), func(t *testing.T) {
+			assert.Equal(t, want, userStateToDomain(state))
+			assert.Equal(t, state, userStateToPb(want))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/server"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
 )
@@ -22,6 +23,9 @@ type Server struct {
 	userCodeAlg crypto.EncryptionAlgorithm
 	idpAlg      crypto.EncryptionAlgorithm
 	idpCallback func(ctx context.Context) string
+
+	assetAPIPrefix  func(context.Context) string
+	checkPermission domain.PermissionCheck
 }
 
 type Config struct{}
@@ -32,6 +36,8 @@ func CreateServer(
 	userCodeAlg crypto.EncryptionAlgorithm,
 	idpAlg crypto.EncryptionAlgorithm,
 	idpCallback func(ctx context.Context) string,
+	assetAPIPrefix func(context.Context) string,
+	checkPermission domain.PermissionCheck,
 ) *Server {
 	return &Server{
 		command:     command,
@@ -39,6 +45,9 @@ func CreateServer(
 		userCodeAlg: userCodeAlg,
 		idpAlg:      idpAlg,
 		idpCallback: idpCallback,
+
+		assetAPIPrefix:  assetAPIPrefix,
+		checkPermission: checkPermission,
 	}
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2alpha"
 	user "github.com/zitadel/zitadel/pkg/grpc/user/v2alpha"
 )
@@ -94,6 +95,45 @@ func addUserRequestToAddHuman(req *user.AddHumanUserRequest) (*command.AddHuman,
 	}, nil
 }
 
+func (s *Server) UpdateHumanUser(ctx context.Context, req *user.UpdateHumanUserRequest) (_ *user.UpdateHumanUserResponse, err error) {
+	if req.Username == nil && req.GetProfile() == nil {
+		return nil, errors.ThrowPreconditionFailed(nil, "USERv2-Uh3nc", "Errors.User.NotChanged")
+	}
+	var details *domain.ObjectDetails
+	if req.Username != nil {
+		details, err = s.command.ChangeUsernameV2(ctx, req.GetUserId(), req.GetUsername())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.GetProfile() != nil {
+		profile, err := s.command.ChangeHumanProfileV2(ctx, setHumanProfileToDomain(req.GetUserId(), req.GetProfile()))
+		if err != nil {
+			return nil, err
+		}
+		details = &domain.ObjectDetails{
+			Sequence:      profile.Sequence,
+			EventDate:     profile.ChangeDate,
+			ResourceOwner: profile.ResourceOwner,
+		}
+	}
+	return &user.UpdateHumanUserResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func setHumanProfileToDomain(userID string, profile *user.SetHumanProfile) *domain.Profile {
+	return &domain.Profile{
+		ObjectRoot:        models.ObjectRoot{AggregateID: userID},
+		FirstName:         profile.GetFirstName(),
+		LastName:          profile.GetLastName(),
+		NickName:          profile.GetNickName(),
+		DisplayName:       profile.GetDisplayName(),
+		PreferredLanguage: language.Make(profile.GetPreferredLanguage()),
+		Gender:            genderToDomain(profile.GetGender()),
+	}
+}
+
 func genderToDomain(gender user.Gender) domain.Gender {
 	switch gender {
 	case user.Gender_GENDER_UNSPECIFIED:
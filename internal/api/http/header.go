@@ -37,7 +37,10 @@ const (
 	FeaturePolicy           = "feature-policy"
 	PermissionsPolicy       = "permissions-policy"
 
-	ZitadelOrgID = "x-zitadel-orgid"
+	ZitadelOrgID    = "x-zitadel-orgid"
+	ZitadelPosition = "x-zitadel-position"
+	// ZitadelCaptchaResponse is the response of the solved captcha, required by throttled password checks
+	ZitadelCaptchaResponse = "x-zitadel-captcha-response"
 )
 
 type key int
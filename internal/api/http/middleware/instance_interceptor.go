@@ -12,6 +12,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	caos_errors "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -58,6 +59,11 @@ func (a *instanceInterceptor) handleInstance(w http.ResponseWriter, r *http.Requ
 		if errors.As(err, &caosErr) {
 			caosErr.Message = a.translator.LocalizeFromRequest(r, caosErr.GetMessage(), nil)
 		}
+		// the instance is unavailable while it's moved to another database shard
+		if caos_errors.IsUnavailable(err) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
@@ -81,7 +87,8 @@ func setInstance(r *http.Request, verifier authz.InstanceVerifier, headerName st
 		return nil, err
 	}
 	span.End()
-	return authz.WithInstance(ctx, instance), nil
+	// the instance is served by the connection pool of its database shard
+	return database.WithShard(authz.WithInstance(ctx, instance), instance.Shard()), nil
 }
 
 func HostFromRequest(r *http.Request, headerName string) (string, error) {
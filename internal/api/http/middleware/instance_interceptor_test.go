@@ -12,6 +12,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 )
 
 func Test_instanceInterceptor_Handler(t *testing.T) {
@@ -49,7 +50,7 @@ func Test_instanceInterceptor_Handler(t *testing.T) {
 		{
 			"setInstance ok",
 			fields{
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 			},
 			args{
@@ -61,7 +62,7 @@ func Test_instanceInterceptor_Handler(t *testing.T) {
 			},
 			res{
 				statusCode: 200,
-				context:    authz.WithInstance(context.Background(), &mockInstance{}),
+				context:    database.WithShard(authz.WithInstance(context.Background(), &mockInstance{}), ""),
 			},
 		},
 	}
@@ -116,7 +117,7 @@ func Test_instanceInterceptor_HandlerFunc(t *testing.T) {
 		{
 			"setInstance ok",
 			fields{
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 			},
 			args{
@@ -128,7 +129,7 @@ func Test_instanceInterceptor_HandlerFunc(t *testing.T) {
 			},
 			res{
 				statusCode: 200,
-				context:    authz.WithInstance(context.Background(), &mockInstance{}),
+				context:    database.WithShard(authz.WithInstance(context.Background(), &mockInstance{}), ""),
 			},
 		},
 	}
@@ -186,7 +187,7 @@ func Test_setInstance(t *testing.T) {
 					r.Header.Set("header", "host2")
 					return r
 				}(),
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 			},
 			res{
@@ -202,11 +203,27 @@ func Test_setInstance(t *testing.T) {
 					r.Header.Set("header", "host")
 					return r
 				}(),
-				verifier:   &mockInstanceVerifier{"host"},
+				verifier:   &mockInstanceVerifier{host: "host"},
 				headerName: "header",
 			},
 			res{
-				want: authz.WithInstance(context.Background(), &mockInstance{}),
+				want: database.WithShard(authz.WithInstance(context.Background(), &mockInstance{}), ""),
+				err:  false,
+			},
+		},
+		{
+			"valid host, shard of instance selected",
+			args{
+				r: func() *http.Request {
+					r := httptest.NewRequest("", "/url", nil)
+					r.Header.Set("header", "host")
+					return r
+				}(),
+				verifier:   &mockInstanceVerifier{host: "host", shard: "eu2"},
+				headerName: "header",
+			},
+			res{
+				want: database.WithShard(authz.WithInstance(context.Background(), &mockInstance{shard: "eu2"}), "eu2"),
 				err:  false,
 			},
 		},
@@ -234,21 +251,24 @@ func (t *testHandler) ServeHTTP(_ http.ResponseWriter, r *http.Request) {
 }
 
 type mockInstanceVerifier struct {
-	host string
+	host  string
+	shard string
 }
 
 func (m *mockInstanceVerifier) InstanceByHost(_ context.Context, host string) (authz.Instance, error) {
 	if host != m.host {
 		return nil, fmt.Errorf("invalid host")
 	}
-	return &mockInstance{}, nil
+	return &mockInstance{shard: m.shard}, nil
 }
 
 func (m *mockInstanceVerifier) InstanceByID(context.Context) (authz.Instance, error) {
 	return nil, nil
 }
 
-type mockInstance struct{}
+type mockInstance struct {
+	shard string
+}
 
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
@@ -285,3 +305,7 @@ func (m *mockInstance) RequestedHost() string {
 func (m *mockInstance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
+
+func (m *mockInstance) Shard() string {
+	return m.shard
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dop251/goja"
 	"github.com/zitadel/logging"
@@ -32,6 +33,8 @@ const (
 	ScopeUserMetaData       = "urn:zitadel:iam:user:metadata"
 	ClaimUserMetaData       = ScopeUserMetaData
 	ScopeResourceOwner      = "urn:zitadel:iam:user:resourceowner"
+	ScopeUserGroups         = "urn:zitadel:iam:user:groups"
+	ClaimUserGroups         = ScopeUserGroups
 	ClaimResourceOwner      = ScopeResourceOwner + ":"
 	ClaimActionLogFormat    = "urn:zitadel:iam:action:%s:log"
 
@@ -285,6 +288,10 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo *oidc.UserInfo, us
 			if err := o.setUserInfoResourceOwner(ctx, userInfo, userID); err != nil {
 				return err
 			}
+		case ScopeUserGroups:
+			if err := o.setUserInfoGroups(ctx, userInfo, userID); err != nil {
+				return err
+			}
 		case ScopeProjectsRoles:
 			allRoles = true
 		default:
@@ -363,6 +370,17 @@ func (o *OPStorage) setUserInfoMetadata(ctx context.Context, userInfo *oidc.User
 	return nil
 }
 
+func (o *OPStorage) setUserInfoGroups(ctx context.Context, userInfo *oidc.UserInfo, userID string) error {
+	groups, err := o.query.UserGroupNames(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(groups) > 0 {
+		userInfo.AppendClaims(ClaimUserGroups, groups)
+	}
+	return nil
+}
+
 func (o *OPStorage) setUserInfoResourceOwner(ctx context.Context, userInfo *oidc.UserInfo, userID string) error {
 	resourceOwnerClaims, err := o.assertUserResourceOwner(ctx, userID)
 	if err != nil {
@@ -531,6 +549,14 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 			for claim, value := range resourceOwnerClaims {
 				claims = appendClaim(claims, claim, value)
 			}
+		case ScopeUserGroups:
+			groups, err := o.query.UserGroupNames(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if len(groups) > 0 {
+				claims = appendClaim(claims, ClaimUserGroups, groups)
+			}
 		case ScopeProjectsRoles:
 			allRoles = true
 		}
@@ -725,6 +751,12 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, nil, err
 	}
+	// the user inherits the grants of the groups it's a member of
+	groupGrants, err := o.query.UserGroupGrants(ctx, userID, roleAudience)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants.UserGrants = append(effectiveUserGrants(grants.UserGrants, time.Now()), groupGrants...)
 	roles := new(projectsRoles)
 	// if specific roles where requested, check if they are granted and append them in the roles list
 	if len(requestedRoles) > 0 {
@@ -744,6 +776,18 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	return grants, roles, nil
 }
 
+// effectiveUserGrants removes grants which are pending approval, expired
+// or outside of their validity at t
+func effectiveUserGrants(grants []*query.UserGrant, t time.Time) []*query.UserGrant {
+	effective := make([]*query.UserGrant, 0, len(grants))
+	for _, grant := range grants {
+		if grant.IsEffectiveAt(t) {
+			effective = append(effective, grant)
+		}
+	}
+	return effective
+}
+
 func (o *OPStorage) assertUserMetaData(ctx context.Context, userID string) (map[string]string, error) {
 	metaData, err := o.query.SearchUserMetadata(ctx, true, userID, &query.UserMetadataSearchQueries{}, false)
 	if err != nil {
@@ -118,6 +118,9 @@ func (c *Client) IsScopeAllowed(scope string) bool {
 	if scope == ScopeResourceOwner {
 		return true
 	}
+	if scope == ScopeUserGroups {
+		return true
+	}
 	if scope == ScopeProjectsRoles {
 		return true
 	}
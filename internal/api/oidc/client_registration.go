@@ -0,0 +1,459 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/rakyll/statik/fs"
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	z_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	// ClientRegistrationHandlerPrefix is the path of the dynamic client registration endpoint (RFC 7591)
+	ClientRegistrationHandlerPrefix = "/oauth/v2/register"
+
+	paramClientID = "client_id"
+
+	// maxClientMetadataSize limits the size of the request body of registrations and updates
+	maxClientMetadataSize = 64 << 10
+
+	// error codes of RFC 7591 section 3.2.2 and RFC 6750 section 3.1
+	errInvalidRedirectURI    = "invalid_redirect_uri"
+	errInvalidClientMetadata = "invalid_client_metadata"
+	errInvalidToken          = "invalid_token"
+	errServerError           = "server_error"
+
+	applicationTypeWeb       = "web"
+	applicationTypeNative    = "native"
+	applicationTypeUserAgent = "user_agent"
+)
+
+type clientRegistrationHandler struct {
+	commands         *command.Commands
+	queries          *query.Queries
+	appSecretHashAlg crypto.HashAlgorithm
+	externalSecure   bool
+	translator       *i18n.Translator
+}
+
+// clientMetadata are the client metadata (RFC 7591 section 2) supported by ZITADEL
+type clientMetadata struct {
+	RedirectURIs            []string            `json:"redirect_uris"`
+	PostLogoutRedirectURIs  []string            `json:"post_logout_redirect_uris,omitempty"`
+	ResponseTypes           []oidc.ResponseType `json:"response_types,omitempty"`
+	GrantTypes              []oidc.GrantType    `json:"grant_types,omitempty"`
+	ApplicationType         string              `json:"application_type,omitempty"`
+	TokenEndpointAuthMethod oidc.AuthMethod     `json:"token_endpoint_auth_method,omitempty"`
+	ClientName              string              `json:"client_name,omitempty"`
+}
+
+// clientInformation is the response of the registration (RFC 7591 section 3.2.1)
+// and the client configuration endpoint (RFC 7592 section 3)
+type clientInformation struct {
+	clientMetadata
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *int64 `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri,omitempty"`
+}
+
+type clientRegistrationError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// NewClientRegistrationHandler returns the handler for the dynamic client registration (RFC 7591)
+// and the client configuration endpoint (RFC 7592).
+// Clients are registered with an initial access token created on the project,
+// further requests must provide the returned registration access token.
+func NewClientRegistrationHandler(
+	commands *command.Commands,
+	queries *query.Queries,
+	appSecretHashAlg crypto.HashAlgorithm,
+	externalSecure bool,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	h := &clientRegistrationHandler{
+		commands:         commands,
+		queries:          queries,
+		appSecretHashAlg: appSecretHashAlg,
+		externalSecure:   externalSecure,
+		translator:       newZitadelTranslator(),
+	}
+
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor)
+	router.HandleFunc("/", h.register).Methods(http.MethodPost)
+	router.HandleFunc("/{"+paramClientID+"}", h.read).Methods(http.MethodGet)
+	router.HandleFunc("/{"+paramClientID+"}", h.update).Methods(http.MethodPut)
+	router.HandleFunc("/{"+paramClientID+"}", h.remove).Methods(http.MethodDelete)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the registration endpoint itself is called without trailing slash,
+		// which results in an empty path after the prefix was stripped
+		// and would be answered with a redirect by the router
+		if r.URL.Path == "" {
+			r.URL.Path = "/"
+		}
+		router.ServeHTTP(w, r)
+	})
+}
+
+func (h *clientRegistrationHandler) register(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token, ok := bearerToken(r)
+	if !ok {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "initial access token missing")
+		return
+	}
+	metadata, err := parseClientMetadata(w, r)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	oidcApp, err := metadata.toOIDCApp()
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	appSecretGenerator, err := h.queries.InitHashGenerator(ctx, domain.SecretGeneratorTypeAppSecret, h.appSecretHashAlg)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	app, registrationAccessToken, err := h.commands.RegisterOIDCClient(ctx, token, oidcApp, appSecretGenerator)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	info := h.clientInformation(ctx, app.AppName, app, registrationAccessToken)
+	info.ClientIDIssuedAt = app.CreationDate.Unix()
+	writeClientRegistrationResponse(w, http.StatusCreated, info)
+}
+
+func (h *clientRegistrationHandler) read(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID, appID, ok := h.verifyRegistrationAccessToken(w, r)
+	if !ok {
+		return
+	}
+	app, err := h.queries.AppByProjectAndAppID(ctx, true, projectID, appID, false)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	if app.OIDCConfig == nil {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "")
+		return
+	}
+	info := h.clientInformation(ctx, app.Name, oidcConfigToOIDCApp(app.OIDCConfig), "")
+	info.ClientIDIssuedAt = app.CreationDate.Unix()
+	writeClientRegistrationResponse(w, http.StatusOK, info)
+}
+
+func (h *clientRegistrationHandler) update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token, ok := bearerToken(r)
+	if !ok {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "registration access token missing")
+		return
+	}
+	if _, _, ok = h.verifyRegistrationAccessToken(w, r); !ok {
+		return
+	}
+	metadata, err := parseClientMetadata(w, r)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	oidcApp, err := metadata.toOIDCApp()
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	app, err := h.commands.UpdateRegisteredOIDCClient(ctx, token, oidcApp)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	writeClientRegistrationResponse(w, http.StatusOK, h.clientInformation(ctx, metadata.ClientName, app, ""))
+}
+
+func (h *clientRegistrationHandler) remove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token, ok := bearerToken(r)
+	if !ok {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "registration access token missing")
+		return
+	}
+	if _, _, ok = h.verifyRegistrationAccessToken(w, r); !ok {
+		return
+	}
+	if _, err := h.commands.RemoveRegisteredOIDCClient(ctx, token); err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verifyRegistrationAccessToken checks the registration access token of the request
+// and that it belongs to the client of the requested path.
+// In case of an error, the response is already written.
+func (h *clientRegistrationHandler) verifyRegistrationAccessToken(w http.ResponseWriter, r *http.Request) (projectID, appID string, ok bool) {
+	ctx := r.Context()
+	token, ok := bearerToken(r)
+	if !ok {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "registration access token missing")
+		return "", "", false
+	}
+	projectID, appID, err := h.commands.VerifyOIDCRegistrationAccessToken(ctx, token)
+	if err != nil {
+		h.writeClientRegistrationErr(w, r, err)
+		return "", "", false
+	}
+	app, err := h.queries.AppByOIDCClientID(ctx, mux.Vars(r)[paramClientID], false)
+	if err != nil || app.ProjectID != projectID || app.ID != appID {
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "")
+		return "", "", false
+	}
+	return projectID, appID, true
+}
+
+func (h *clientRegistrationHandler) clientInformation(ctx context.Context, name string, app *domain.OIDCApp, registrationAccessToken string) *clientInformation {
+	info := &clientInformation{
+		clientMetadata: clientMetadata{
+			RedirectURIs:            app.RedirectUris,
+			PostLogoutRedirectURIs:  app.PostLogoutRedirectUris,
+			ResponseTypes:           responseTypesToOIDC(app.ResponseTypes),
+			GrantTypes:              grantTypesToOIDC(app.GrantTypes),
+			ApplicationType:         applicationTypeToOIDC(app.ApplicationType),
+			TokenEndpointAuthMethod: authMethodToOIDC(app.AuthMethodType),
+			ClientName:              name,
+		},
+		ClientID:                app.ClientID,
+		ClientSecret:            app.ClientSecretString,
+		RegistrationAccessToken: registrationAccessToken,
+		RegistrationClientURI:   http_utils.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), h.externalSecure) + ClientRegistrationHandlerPrefix + "/" + app.ClientID,
+	}
+	if info.ClientSecret != "" {
+		// client secrets do not expire
+		var expiresAt int64
+		info.ClientSecretExpiresAt = &expiresAt
+	}
+	return info
+}
+
+func parseClientMetadata(w http.ResponseWriter, r *http.Request) (*clientMetadata, error) {
+	metadata := new(clientMetadata)
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxClientMetadataSize)).Decode(metadata); err != nil {
+		return nil, z_errs.ThrowInvalidArgument(err, "OIDC-Jq8vz", "Errors.OIDCClientRegistration.MetadataInvalid")
+	}
+	return metadata, nil
+}
+
+func (m *clientMetadata) toOIDCApp() (*domain.OIDCApp, error) {
+	if m.ClientName == "" {
+		return nil, z_errs.ThrowInvalidArgument(nil, "OIDC-Wq3nf", "Errors.OIDCClientRegistration.ClientNameMissing")
+	}
+	responseTypes, err := responseTypesToDomain(m.ResponseTypes)
+	if err != nil {
+		return nil, err
+	}
+	grantTypes, err := grantTypesToDomain(m.GrantTypes)
+	if err != nil {
+		return nil, err
+	}
+	applicationType, err := applicationTypeToDomain(m.ApplicationType)
+	if err != nil {
+		return nil, err
+	}
+	authMethod, err := authMethodToDomain(m.TokenEndpointAuthMethod)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OIDCApp{
+		AppName:                m.ClientName,
+		RedirectUris:           m.RedirectURIs,
+		PostLogoutRedirectUris: m.PostLogoutRedirectURIs,
+		ResponseTypes:          responseTypes,
+		GrantTypes:             grantTypes,
+		ApplicationType:        applicationType,
+		AuthMethodType:         authMethod,
+	}, nil
+}
+
+func oidcConfigToOIDCApp(config *query.OIDCApp) *domain.OIDCApp {
+	return &domain.OIDCApp{
+		ClientID:               config.ClientID,
+		RedirectUris:           config.RedirectURIs,
+		PostLogoutRedirectUris: config.PostLogoutRedirectURIs,
+		ResponseTypes:          config.ResponseTypes,
+		GrantTypes:             config.GrantTypes,
+		ApplicationType:        config.AppType,
+		AuthMethodType:         config.AuthMethodType,
+	}
+}
+
+func responseTypesToDomain(responseTypes []oidc.ResponseType) ([]domain.OIDCResponseType, error) {
+	if len(responseTypes) == 0 {
+		return []domain.OIDCResponseType{domain.OIDCResponseTypeCode}, nil
+	}
+	domainTypes := make([]domain.OIDCResponseType, len(responseTypes))
+	for i, responseType := range responseTypes {
+		switch responseType {
+		case oidc.ResponseTypeCode:
+			domainTypes[i] = domain.OIDCResponseTypeCode
+		case oidc.ResponseTypeIDToken:
+			domainTypes[i] = domain.OIDCResponseTypeIDTokenToken
+		case oidc.ResponseTypeIDTokenOnly:
+			domainTypes[i] = domain.OIDCResponseTypeIDToken
+		default:
+			return nil, z_errs.ThrowInvalidArgument(nil, "OIDC-Hs2nq", "Errors.OIDCClientRegistration.ResponseTypeUnsupported")
+		}
+	}
+	return domainTypes, nil
+}
+
+func grantTypesToDomain(grantTypes []oidc.GrantType) ([]domain.OIDCGrantType, error) {
+	if len(grantTypes) == 0 {
+		return []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode}, nil
+	}
+	domainTypes := make([]domain.OIDCGrantType, len(grantTypes))
+	for i, grantType := range grantTypes {
+		switch grantType {
+		case oidc.GrantTypeCode:
+			domainTypes[i] = domain.OIDCGrantTypeAuthorizationCode
+		case oidc.GrantTypeImplicit:
+			domainTypes[i] = domain.OIDCGrantTypeImplicit
+		case oidc.GrantTypeRefreshToken:
+			domainTypes[i] = domain.OIDCGrantTypeRefreshToken
+		case oidc.GrantTypeDeviceCode:
+			domainTypes[i] = domain.OIDCGrantTypeDeviceCode
+		default:
+			return nil, z_errs.ThrowInvalidArgument(nil, "OIDC-P2mfa", "Errors.OIDCClientRegistration.GrantTypeUnsupported")
+		}
+	}
+	return domainTypes, nil
+}
+
+func applicationTypeToDomain(applicationType string) (domain.OIDCApplicationType, error) {
+	switch applicationType {
+	case "", applicationTypeWeb:
+		return domain.OIDCApplicationTypeWeb, nil
+	case applicationTypeNative:
+		return domain.OIDCApplicationTypeNative, nil
+	case applicationTypeUserAgent:
+		return domain.OIDCApplicationTypeUserAgent, nil
+	default:
+		return 0, z_errs.ThrowInvalidArgument(nil, "OIDC-Rm2fs", "Errors.OIDCClientRegistration.ApplicationTypeUnsupported")
+	}
+}
+
+func applicationTypeToOIDC(applicationType domain.OIDCApplicationType) string {
+	switch applicationType {
+	case domain.OIDCApplicationTypeNative:
+		return applicationTypeNative
+	case domain.OIDCApplicationTypeUserAgent:
+		return applicationTypeUserAgent
+	default:
+		return applicationTypeWeb
+	}
+}
+
+func authMethodToDomain(authMethod oidc.AuthMethod) (domain.OIDCAuthMethodType, error) {
+	switch authMethod {
+	case "", oidc.AuthMethodBasic:
+		return domain.OIDCAuthMethodTypeBasic, nil
+	case oidc.AuthMethodPost:
+		return domain.OIDCAuthMethodTypePost, nil
+	case oidc.AuthMethodNone:
+		return domain.OIDCAuthMethodTypeNone, nil
+	case oidc.AuthMethodPrivateKeyJWT:
+		return domain.OIDCAuthMethodTypePrivateKeyJWT, nil
+	default:
+		return 0, z_errs.ThrowInvalidArgument(nil, "OIDC-Uf3nw", "Errors.OIDCClientRegistration.AuthMethodUnsupported")
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	auth := r.Header.Get(http_utils.Authorization)
+	if !strings.HasPrefix(auth, oidc.BearerToken+" ") {
+		return "", false
+	}
+	token := strings.TrimPrefix(auth, oidc.BearerToken+" ")
+	return token, token != ""
+}
+
+// writeClientRegistrationErr writes the error response for the error.
+// Only the localized message of the error is returned as description,
+// so that no internal details are exposed to the client.
+func (h *clientRegistrationHandler) writeClientRegistrationErr(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case z_errs.IsPermissionDenied(err),
+		z_errs.IsNotFound(err):
+		writeClientRegistrationError(w, http.StatusUnauthorized, errInvalidToken, "")
+	case z_errs.IsErrorInvalidArgument(err):
+		code := errInvalidClientMetadata
+		if zErr := new(z_errs.InvalidArgumentError); errors.As(err, &zErr) && zErr.GetMessage() == "Errors.OIDCClientRegistration.RedirectURINotAllowed" {
+			code = errInvalidRedirectURI
+		}
+		writeClientRegistrationError(w, http.StatusBadRequest, code, h.localizedMessage(r, err))
+	case z_errs.IsPreconditionFailed(err):
+		writeClientRegistrationError(w, http.StatusBadRequest, errInvalidClientMetadata, h.localizedMessage(r, err))
+	default:
+		logging.WithError(err).Error("oidc client registration failed")
+		writeClientRegistrationError(w, http.StatusInternalServerError, errServerError, "")
+	}
+}
+
+func (h *clientRegistrationHandler) localizedMessage(r *http.Request, err error) string {
+	caosErr := new(z_errs.CaosError)
+	if !errors.As(err, &caosErr) {
+		return ""
+	}
+	return h.translator.LocalizeFromRequest(r, caosErr.GetMessage(), nil)
+}
+
+func newZitadelTranslator() *i18n.Translator {
+	dir, err := fs.NewWithNamespace("zitadel")
+	logging.WithFields("namespace", "zitadel").OnError(err).Panic("unable to get namespace")
+
+	translator, err := i18n.NewTranslator(dir, language.English, "")
+	logging.OnError(err).Panic("unable to get translator")
+	return translator
+}
+
+func writeClientRegistrationError(w http.ResponseWriter, status int, code, description string) {
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", oidc.BearerToken+` error="`+errInvalidToken+`"`)
+	}
+	writeClientRegistrationResponse(w, status, &clientRegistrationError{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
+
+func writeClientRegistrationResponse(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(body)
+	logging.OnError(err).Error("unable to write oidc client registration response")
+}
@@ -147,6 +147,9 @@ func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.
 	return nil
 }
 
+// setUserinfo sets the attributes of the assertion,
+// the SAML library only supports this fixed set of attributes,
+// so further data like the groups of the user can't be asserted yet
 func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes []int) {
 	if len(attributes) == 0 {
 		userinfo.SetUsername(user.PreferredLoginName)
@@ -151,6 +151,7 @@ const (
 	authMethodOTP          authMethod = "OTP"
 	authMethodU2F          authMethod = "U2F"
 	authMethodPasswordless authMethod = "passwordless"
+	authMethodMagicLink    authMethod = "magicLink"
 )
 
 func (l *Login) runPostInternalAuthenticationActions(
@@ -17,6 +17,7 @@ import (
 	_ "github.com/zitadel/zitadel/internal/api/ui/login/statik"
 	auth_repository "github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -40,6 +41,8 @@ type Login struct {
 	samlAuthCallbackURL func(context.Context, string) string
 	idpConfigAlg        crypto.EncryptionAlgorithm
 	userCodeAlg         crypto.EncryptionAlgorithm
+	// captchaResponseField is the form field of the solved captcha, passed to the password check
+	captchaResponseField string
 }
 
 type Config struct {
@@ -75,16 +78,17 @@ func CreateLogin(config Config,
 	csrfCookieKey []byte,
 ) (*Login, error) {
 	login := &Login{
-		oidcAuthCallbackURL: oidcAuthCallbackURL,
-		samlAuthCallbackURL: samlAuthCallbackURL,
-		externalSecure:      externalSecure,
-		consolePath:         consolePath,
-		command:             command,
-		query:               query,
-		staticStorage:       staticStorage,
-		authRepo:            authRepo,
-		idpConfigAlg:        idpConfigAlg,
-		userCodeAlg:         userCodeAlg,
+		oidcAuthCallbackURL:  oidcAuthCallbackURL,
+		samlAuthCallbackURL:  samlAuthCallbackURL,
+		externalSecure:       externalSecure,
+		consolePath:          consolePath,
+		command:              command,
+		query:                query,
+		staticStorage:        staticStorage,
+		authRepo:             authRepo,
+		idpConfigAlg:         idpConfigAlg,
+		userCodeAlg:          userCodeAlg,
+		captchaResponseField: authRepo.LoginThrottle.Captcha.ResponseField,
 	}
 	statikFS, err := fs.NewWithNamespace("login")
 	if err != nil {
@@ -93,7 +97,7 @@ func CreateLogin(config Config,
 
 	csrfInterceptor := createCSRFInterceptor(config.CSRFCookieName, csrfCookieKey, externalSecure, login.csrfErrorHandler())
 	cacheInterceptor := createCacheInterceptor(config.Cache.MaxAge, config.Cache.SharedMaxAge, assetCache)
-	security := middleware.SecurityHeaders(csp(), login.cspErrorHandler)
+	security := middleware.SecurityHeaders(csp(authRepo.LoginThrottle.Captcha), login.cspErrorHandler)
 
 	login.router = CreateRouter(login, statikFS, middleware.TelemetryHandler(IgnoreInstanceEndpoints...), oidcInstanceHandler, samlInstanceHandler, csrfInterceptor, cacheInterceptor, security, userAgentCookie, issuerInterceptor, accessHandler)
 	login.renderer = CreateRenderer(HandlerPrefix, statikFS, staticStorage, config.LanguageCookieName)
@@ -101,11 +105,17 @@ func CreateLogin(config Config,
 	return login, nil
 }
 
-func csp() *middleware.CSP {
+func csp(captcha bruteforce.CaptchaConfig) *middleware.CSP {
 	csp := middleware.DefaultSCP
 	csp.ObjectSrc = middleware.CSPSourceOptsSelf()
 	csp.StyleSrc = csp.StyleSrc.AddNonce()
 	csp.ScriptSrc = csp.ScriptSrc.AddNonce()
+	if len(captcha.CSPHosts) > 0 {
+		csp.ScriptSrc = csp.ScriptSrc.AddHost(captcha.CSPHosts...)
+		csp.StyleSrc = csp.StyleSrc.AddHost(captcha.CSPHosts...)
+		csp.ConnectSrc = middleware.CSPSourceOptsSelf().AddHost(captcha.CSPHosts...)
+		csp.FrameSrc = middleware.CSPSourceOpts().AddHost(captcha.CSPHosts...)
+	}
 	return &csp
 }
 
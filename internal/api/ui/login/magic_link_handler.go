@@ -0,0 +1,91 @@
+package login
+
+import (
+	"net/http"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	tmplMagicLink     = "magiclink"
+	tmplMagicLinkSent = "magiclinksent"
+)
+
+type magicLinkFormData struct {
+	PasswordLogin bool `schema:"passwordlogin"`
+}
+
+type magicLinkData struct {
+	userData
+	PasswordLogin bool
+}
+
+func (l *Login) renderMagicLink(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := &magicLinkData{
+		userData:      l.getUserData(r, authReq, "MagicLink.Title", "MagicLink.Description", errID, errMessage),
+		PasswordLogin: authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowUsernamePassword,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplMagicLink], data, nil)
+}
+
+func (l *Login) renderMagicLinkSent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
+	data := l.getUserData(r, authReq, "MagicLinkSent.Title", "MagicLinkSent.Description", "", "")
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplMagicLinkSent], data, nil)
+}
+
+// handleMagicLinkSend sends the link to the verified email of the user of the auth request
+func (l *Login) handleMagicLinkSend(w http.ResponseWriter, r *http.Request) {
+	formData := new(magicLinkFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, formData)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if formData.PasswordLogin {
+		l.renderPassword(w, r, authReq, nil)
+		return
+	}
+	err = l.authRepo.SendMagicLink(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.ID, authReq.AgentID)
+	if err != nil {
+		l.renderMagicLink(w, r, authReq, err)
+		return
+	}
+	l.renderMagicLinkSent(w, r, authReq)
+}
+
+// handleMagicLink checks the code of the link sent by email.
+// The auth request is loaded by the user agent of the browser, so the link only succeeds in the browser it was requested from.
+func (l *Login) handleMagicLink(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq == nil {
+		l.renderLogin(w, r, nil, nil)
+		return
+	}
+	userID := r.FormValue(queryUserID)
+	orgID := r.FormValue(queryOrgID)
+	code := r.FormValue(queryCode)
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	err = l.authRepo.VerifyMagicLink(setContext(r.Context(), orgID), userID, orgID, authReq.ID, userAgentID, code, domain.BrowserInfoFromRequest(r))
+
+	metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodMagicLink, err)
+	if err == nil && actionErr == nil && len(metadata) > 0 {
+		_, err = l.command.BulkSetUserMetadata(r.Context(), authReq.UserID, authReq.UserOrgID, metadata...)
+	} else if actionErr != nil && err == nil {
+		err = actionErr
+	}
+
+	if err != nil {
+		l.renderMagicLink(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
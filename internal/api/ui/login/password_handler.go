@@ -3,6 +3,7 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
@@ -14,12 +15,26 @@ type passwordFormData struct {
 	Password string `schema:"password"`
 }
 
+type passwordCheckData struct {
+	userData
+	Captcha *bruteforce.CaptchaConfig
+}
+
 func (l *Login) renderPassword(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
 	var errID, errMessage string
 	if err != nil {
 		errID, errMessage = l.getErrorMessage(r, err)
 	}
-	data := l.getUserData(r, authReq, "Password.Title", "Password.Description", errID, errMessage)
+	data := passwordCheckData{
+		userData: l.getUserData(r, authReq, "Password.Title", "Password.Description", errID, errMessage),
+	}
+	if authReq != nil {
+		captcha, captchaErr := l.authRepo.LoginCaptcha(r.Context(), authReq.UserID, domain.BrowserInfoFromRequest(r))
+		if captchaErr != nil {
+			data.ErrID, data.ErrMessage = l.getErrorMessage(r, captchaErr)
+		}
+		data.Captcha = captcha
+	}
 	funcs := map[string]interface{}{
 		"showPasswordReset": func() bool {
 			if authReq.LoginPolicy != nil {
@@ -27,6 +42,9 @@ func (l *Login) renderPassword(w http.ResponseWriter, r *http.Request, authReq *
 			}
 			return true
 		},
+		"hasMagicLink": func() bool {
+			return authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowMagicLink
+		},
 	}
 	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplPassword], data, funcs)
 }
@@ -38,7 +56,11 @@ func (l *Login) handlePasswordCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authReq, err)
 		return
 	}
-	err = l.authRepo.VerifyPassword(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Password, authReq.AgentID, domain.BrowserInfoFromRequest(r))
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	if l.captchaResponseField != "" {
+		ctx = bruteforce.WithCaptchaResponse(ctx, r.FormValue(l.captchaResponseField))
+	}
+	err = l.authRepo.VerifyPassword(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, data.Password, authReq.AgentID, domain.BrowserInfoFromRequest(r))
 
 	metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodPassword, err)
 	if err == nil && actionErr == nil && len(metadata) > 0 {
@@ -54,6 +54,8 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplPasswordlessRegistration:     "passwordless_registration.html",
 		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
 		tmplPasswordlessPrompt:           "passwordless_prompt.html",
+		tmplMagicLink:                    "magic_link.html",
+		tmplMagicLinkSent:                "magic_link_sent.html",
 		tmplMFAVerify:                    "mfa_verify_otp.html",
 		tmplMFAPrompt:                    "mfa_prompt.html",
 		tmplMFAInitVerify:                "mfa_init_otp.html",
@@ -152,6 +154,12 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"passwordlessPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointPasswordlessPrompt)
 		},
+		"magicLinkSendUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointMagicLinkSend)
+		},
+		"hasMagicLink": func() bool {
+			return false
+		},
 		"passwordResetUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointPasswordReset, QueryAuthRequestID, id))
 		},
@@ -287,6 +295,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderPassword(w, r, authReq, nil)
 	case *domain.PasswordlessStep:
 		l.renderPasswordlessVerification(w, r, authReq, step.PasswordSet, nil)
+	case *domain.MagicLinkStep:
+		l.renderMagicLink(w, r, authReq, err)
 	case *domain.PasswordlessRegistrationPromptStep:
 		l.renderPasswordlessPrompt(w, r, authReq, nil)
 	case *domain.MFAVerificationStep:
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
@@ -20,6 +21,7 @@ type AuthRequestRepository interface {
 	SelectUser(ctx context.Context, id, userID, userAgentID string) error
 	SelectExternalIDP(ctx context.Context, authReqID, idpConfigID, userAgentID string) error
 	VerifyPassword(ctx context.Context, id, userID, resourceOwner, password, userAgentID string, info *domain.BrowserInfo) error
+	LoginCaptcha(ctx context.Context, userID string, info *domain.BrowserInfo) (*bruteforce.CaptchaConfig, error)
 
 	VerifyMFAOTP(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) error
 	BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (*domain.WebAuthNLogin, error)
@@ -30,6 +32,8 @@ type AuthRequestRepository interface {
 	VerifyPasswordlessInitCodeSetup(ctx context.Context, userID, resourceOwner, userAgentID, tokenName, codeID, verificationCode string, credentialData []byte) (err error)
 	BeginPasswordlessLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (*domain.WebAuthNLogin, error)
 	VerifyPasswordless(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string, credentialData []byte, info *domain.BrowserInfo) error
+	SendMagicLink(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) error
+	VerifyMagicLink(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, code string, info *domain.BrowserInfo) error
 
 	LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) error
 	AutoRegisterExternalUser(ctx context.Context, user *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) error
@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
 	cache "github.com/zitadel/zitadel/internal/auth_request/repository"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -20,6 +21,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/query"
 	user_repo "github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	user_model "github.com/zitadel/zitadel/internal/user/model"
 	user_view_model "github.com/zitadel/zitadel/internal/user/repository/view/model"
@@ -50,6 +52,9 @@ type AuthRequestRepo struct {
 	UserGrantProvider         userGrantProvider
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
+	RiskEvaluator             *risk.Evaluator
+	LoginAttemptsProvider     loginAttemptsProvider
+	LoginThrottle             bruteforce.Config
 
 	IdGenerator id.Generator
 }
@@ -90,6 +95,7 @@ type idpUserLinksProvider interface {
 
 type userEventProvider interface {
 	UserEventsByID(ctx context.Context, id string, sequence uint64) ([]*es_models.Event, error)
+	UserEventsByTypes(ctx context.Context, id string, creationDate time.Time, eventTypes ...es_models.EventType) ([]*es_models.Event, error)
 }
 
 type userCommandProvider interface {
@@ -104,6 +110,7 @@ type orgViewProvider interface {
 type userGrantProvider interface {
 	ProjectByClientID(context.Context, string, bool) (*query.Project, error)
 	UserGrantsByProjectAndUserID(context.Context, string, string) ([]*query.UserGrant, error)
+	UserGroupGrants(ctx context.Context, userID string, projectIDs []string) ([]*query.UserGrant, error)
 }
 
 type projectProvider interface {
@@ -255,6 +262,10 @@ func (repo *AuthRequestRepo) CheckExternalUserLogin(ctx context.Context, authReq
 	if err != nil {
 		return err
 	}
+	err = repo.assessLoginRisk(ctx, request, info)
+	if err != nil {
+		return err
+	}
 
 	err = repo.Command.UserIDPLoginChecked(ctx, request.UserOrgID, request.UserID, request.WithCurrentInfo(info))
 	if err != nil {
@@ -319,11 +330,18 @@ func (repo *AuthRequestRepo) VerifyPassword(ctx context.Context, authReqID, user
 	if err != nil {
 		return err
 	}
+	err = repo.assessLoginRisk(ctx, request, info)
+	if err != nil {
+		return err
+	}
 	err = repo.Command.HumanCheckPassword(ctx, resourceOwner, userID, password, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
 	if isIgnoreUserInvalidPasswordError(err, request) {
 		return errors.ThrowInvalidArgument(nil, "EVENT-Jsf32", "Errors.User.UsernameOrPassword.Invalid")
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
 func isIgnoreUserNotFoundError(err error, request *domain.AuthRequest) bool {
@@ -345,6 +363,7 @@ func lockoutPolicyToDomain(policy *query.LockoutPolicy) *domain.LockoutPolicy {
 		},
 		Default:             policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		MaxMFAAttempts:      policy.MaxMFAAttempts,
 		ShowLockOutFailures: policy.ShowFailures,
 	}
 }
@@ -356,7 +375,11 @@ func (repo *AuthRequestRepo) VerifyMFAOTP(ctx context.Context, authRequestID, us
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanCheckMFAOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckMFAOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
 }
 
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
@@ -377,7 +400,11 @@ func (repo *AuthRequestRepo) VerifyMFAU2F(ctx context.Context, userID, resourceO
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request)
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
 }
 
 func (repo *AuthRequestRepo) BeginPasswordlessSetup(ctx context.Context, userID, resourceOwner string, authenticatorPlatform domain.AuthenticatorAttachment) (login *domain.WebAuthNToken, err error) {
@@ -431,7 +458,49 @@ func (repo *AuthRequestRepo) VerifyPasswordless(ctx context.Context, userID, res
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request)
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	err = repo.assessLoginRisk(ctx, request, info)
+	if err != nil {
+		return err
+	}
+	err = repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
+	if err != nil {
+		return err
+	}
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
+func (repo *AuthRequestRepo) SendMagicLink(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.RequestMagicLink(ctx, userID, resourceOwner, request)
+}
+
+// VerifyMagicLink checks the code of the magic link.
+// The auth request can only be loaded by the browser it was created for, which binds the link to the same browser.
+func (repo *AuthRequestRepo) VerifyMagicLink(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, code string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	err = repo.assessLoginRisk(ctx, request, info)
+	if err != nil {
+		return err
+	}
+	err = repo.Command.HumanCheckMagicLink(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	if err != nil {
+		return err
+	}
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
 func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) (err error) {
@@ -843,6 +912,8 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MultiFactorCheckLifetime:   policy.MultiFactorCheckLifetime,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		AllowMagicLink:             policy.AllowMagicLink,
+		RiskRules:                  policy.RiskRules,
 	}
 }
 
@@ -1066,7 +1137,9 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 		return &domain.PasswordlessRegistrationPromptStep{}
 	}
 
-	if user.PasswordInitRequired {
+	// a verified magic link counts as first factor, so users without a password don't need to set one
+	magicLinkAllowed := request.LoginPolicy.AllowMagicLink && user.IsEmailVerified
+	if user.PasswordInitRequired && !magicLinkAllowed {
 		return &domain.InitPasswordStep{}
 	}
 
@@ -1078,12 +1151,22 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 	if step != nil {
 		return step
 	}
+	if !user.PasswordSet && magicLinkAllowed {
+		return &domain.MagicLinkStep{}
+	}
 	return &domain.PasswordStep{}
 }
 
 func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView) (domain.NextStep, bool, error) {
 	mfaLevel := request.MFALevel()
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy)
+	if request.RiskAssessment.StepUpRequired() {
+		// a second factor set up after a suspicious first factor check does not prove the identity of the user
+		if len(allowedProviders) == 0 {
+			return nil, false, errors.ThrowPermissionDenied(nil, "LOGIN-Rk4ms", "Errors.Login.Risk.SecondFactorMissing")
+		}
+		required = true
+	}
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
 	if promptRequired || !repo.mfaSkippedOrSetUp(user, request) {
 		types := user.MFATypesSetupPossible(mfaLevel, request.LoginPolicy)
@@ -1107,14 +1190,16 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 		}
 		fallthrough
 	case domain.MFALevelSecondFactor:
-		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) &&
+			verifiedAfterRiskAssessment(userSession.SecondFactorVerification, request) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.SecondFactorVerificationType)
 			request.AuthTime = userSession.SecondFactorVerification
 			return nil, true, nil
 		}
 		fallthrough
 	case domain.MFALevelMultiFactor:
-		if checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
+		if checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) &&
+			verifiedAfterRiskAssessment(userSession.MultiFactorVerification, request) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.MultiFactorVerificationType)
 			request.AuthTime = userSession.MultiFactorVerification
 			return nil, true, nil
@@ -1340,7 +1425,9 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 			user_repo.HumanPasswordlessTokenCheckSucceededType,
 			user_repo.HumanPasswordlessTokenCheckFailedType,
 			user_repo.HumanU2FTokenCheckSucceededType,
-			user_repo.HumanU2FTokenCheckFailedType:
+			user_repo.HumanU2FTokenCheckFailedType,
+			user_repo.HumanMagicLinkCheckSucceededType,
+			user_repo.HumanMagicLinkCheckFailedType:
 			eventData, err := user_view_model.UserSessionFromEvent(event)
 			if err != nil {
 				logging.WithFields("traceID", tracing.TraceIDFromCtx(ctx)).WithError(err).Debug("error getting event data")
@@ -1472,7 +1559,23 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 	if err != nil {
 		return false, err
 	}
-	return len(grants) == 0, nil
+	now := time.Now()
+	for _, grant := range grants {
+		if grant.IsEffectiveAt(now) {
+			return false, nil
+		}
+	}
+	// the roles inherited through groups are granted as well
+	groupGrants, err := userGrantProvider.UserGroupGrants(ctx, user.ID, []string{project.ID})
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range groupGrants {
+		if grant.IsEffectiveAt(now) {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (missingGrant bool, err error) {
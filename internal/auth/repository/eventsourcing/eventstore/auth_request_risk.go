@@ -0,0 +1,104 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	user_repo "github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// assessLoginRisk evaluates the first factor check of the user against the risk rules of the login policy
+// and records the decision on the request.
+// It must be called before the check is pushed, so the attempt itself is not part of the history.
+func (repo *AuthRequestRepo) assessLoginRisk(ctx context.Context, request *domain.AuthRequest, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	request.RiskAssessment = nil
+	if repo.RiskEvaluator == nil || request.LoginPolicy == nil || !request.LoginPolicy.RiskRules.Enabled {
+		return nil
+	}
+	attempt := &risk.Attempt{
+		UserAgentID: request.AgentID,
+		Time:        time.Now(),
+	}
+	var events []*es_models.Event
+	if since := repo.RiskEvaluator.HistorySince(&request.LoginPolicy.RiskRules, attempt.Time); !since.IsZero() {
+		events, err = repo.UserEventProvider.UserEventsByTypes(ctx, request.UserID, since, riskEventTypes...)
+		if err != nil {
+			return err
+		}
+	}
+	if info != nil {
+		attempt.IP = info.RemoteIP
+	}
+	request.RiskAssessment = repo.RiskEvaluator.Evaluate(&request.LoginPolicy.RiskRules, attempt, riskRecordsFromEvents(events))
+	logging.WithFields("authRequestID", request.ID, "userID", request.UserID, "level", request.RiskAssessment.Level, "signals", request.RiskAssessment.Signals).
+		Debug("login risk assessed")
+	return nil
+}
+
+// riskEventTypes are the first factor checks used for the risk evaluation
+var riskEventTypes = []es_models.EventType{
+	es_models.EventType(user_repo.HumanPasswordCheckSucceededType),
+	es_models.EventType(user_repo.UserIDPLoginCheckSucceededType),
+	es_models.EventType(user_repo.HumanPasswordlessTokenCheckSucceededType),
+	es_models.EventType(user_repo.HumanMagicLinkCheckSucceededType),
+	es_models.EventType(user_repo.HumanPasswordCheckFailedType),
+	es_models.EventType(user_repo.HumanPasswordlessTokenCheckFailedType),
+	es_models.EventType(user_repo.HumanMagicLinkCheckFailedType),
+}
+
+// riskRecordsFromEvents maps the first factor checks of the user to records of the risk evaluation
+func riskRecordsFromEvents(events []*es_models.Event) []*risk.Record {
+	records := make([]*risk.Record, 0)
+	for _, event := range events {
+		var succeeded bool
+		switch eventstore.EventType(event.Type) {
+		case user_repo.HumanPasswordCheckSucceededType,
+			user_repo.UserIDPLoginCheckSucceededType,
+			user_repo.HumanPasswordlessTokenCheckSucceededType,
+			user_repo.HumanMagicLinkCheckSucceededType:
+			succeeded = true
+		case user_repo.HumanPasswordCheckFailedType,
+			user_repo.HumanPasswordlessTokenCheckFailedType,
+			user_repo.HumanMagicLinkCheckFailedType:
+			succeeded = false
+		default:
+			continue
+		}
+		info := new(user_repo.AuthRequestInfo)
+		if len(event.Data) > 0 {
+			if err := json.Unmarshal(event.Data, info); err != nil {
+				logging.WithFields("sequence", event.Sequence).WithError(err).Warn("unable to unmarshal auth request info for risk evaluation")
+				continue
+			}
+		}
+		record := &risk.Record{
+			UserAgentID: info.UserAgentID,
+			Time:        event.CreationDate,
+			Succeeded:   succeeded,
+		}
+		if info.BrowserInfo != nil {
+			record.IP = info.BrowserInfo.RemoteIP
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// verifiedAfterRiskAssessment ensures a second factor is verified again, if the risk assessment requires a step-up
+func verifiedAfterRiskAssessment(verification time.Time, request *domain.AuthRequest) bool {
+	if !request.RiskAssessment.StepUpRequired() {
+		return true
+	}
+	return verification.After(request.RiskAssessment.EvaluatedAt)
+}
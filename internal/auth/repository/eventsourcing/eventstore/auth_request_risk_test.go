@@ -0,0 +1,54 @@
+package eventstore
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	user_repo "github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+)
+
+func Test_riskRecordsFromEvents(t *testing.T) {
+	events := []*es_models.Event{
+		{
+			Type:         es_models.EventType(user_repo.HumanPasswordCheckSucceededType),
+			CreationDate: testNow.Add(-time.Hour),
+			Data:         []byte(`{"id":"authRequest1","userAgentID":"agent1","userAgent":"browser","remoteIP":"192.0.2.1"}`),
+		},
+		{
+			Type:         es_models.EventType(user_repo.HumanMFAOTPCheckSucceededType),
+			CreationDate: testNow.Add(-time.Hour),
+			Data:         []byte(`{"userAgentID":"agent1"}`),
+		},
+		{
+			Type:         es_models.EventType(user_repo.HumanPasswordCheckFailedType),
+			CreationDate: testNow.Add(-time.Minute),
+			Data:         []byte(`{"userAgentID":"agent2"}`),
+		},
+		{
+			Type:         es_models.EventType(user_repo.UserIDPLoginCheckSucceededType),
+			CreationDate: testNow,
+			Data:         []byte(`invalid`),
+		},
+	}
+	assert.Equal(t,
+		[]*risk.Record{
+			{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("192.0.2.1"),
+				Time:        testNow.Add(-time.Hour),
+				Succeeded:   true,
+			},
+			{
+				UserAgentID: "agent2",
+				Time:        testNow.Add(-time.Minute),
+				Succeeded:   false,
+			},
+		},
+		riskRecordsFromEvents(events),
+	)
+}
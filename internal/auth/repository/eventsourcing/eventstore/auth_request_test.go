@@ -113,6 +113,10 @@ func (m *mockEventUser) UserEventsByID(ctx context.Context, id string, sequence
 	return events, nil
 }
 
+func (m *mockEventUser) UserEventsByTypes(ctx context.Context, id string, creationDate time.Time, eventTypes ...es_models.EventType) ([]*es_models.Event, error) {
+	return m.UserEventsByID(ctx, id, 0)
+}
+
 func (m *mockEventUser) BulkAddExternalIDPs(ctx context.Context, userID string, externalIDPs []*user_model.ExternalIDP) error {
 	return nil
 }
@@ -123,6 +127,10 @@ func (m *mockEventErrUser) UserEventsByID(ctx context.Context, id string, sequen
 	return nil, errors.ThrowInternal(nil, "id", "internal error")
 }
 
+func (m *mockEventErrUser) UserEventsByTypes(ctx context.Context, id string, creationDate time.Time, eventTypes ...es_models.EventType) ([]*es_models.Event, error) {
+	return nil, errors.ThrowInternal(nil, "id", "internal error")
+}
+
 func (m *mockEventErrUser) BulkAddExternalIDPs(ctx context.Context, userID string, externalIDPs []*user_model.ExternalIDP) error {
 	return errors.ThrowInternal(nil, "id", "internal error")
 }
@@ -203,8 +211,9 @@ func (m *mockViewErrOrg) OrgByPrimaryDomain(context.Context, string) (*query.Org
 }
 
 type mockUserGrants struct {
-	roleCheck  bool
-	userGrants int
+	roleCheck   bool
+	userGrants  []*query.UserGrant
+	groupGrants []*query.UserGrant
 }
 
 func (m *mockUserGrants) ProjectByClientID(ctx context.Context, s string, _ bool) (*query.Project, error) {
@@ -212,11 +221,11 @@ func (m *mockUserGrants) ProjectByClientID(ctx context.Context, s string, _ bool
 }
 
 func (m *mockUserGrants) UserGrantsByProjectAndUserID(ctx context.Context, s string, s2 string) ([]*query.UserGrant, error) {
-	var grants []*query.UserGrant
-	if m.userGrants > 0 {
-		grants = make([]*query.UserGrant, m.userGrants)
-	}
-	return grants, nil
+	return m.userGrants, nil
+}
+
+func (m *mockUserGrants) UserGroupGrants(context.Context, string, []string) ([]*query.UserGrant, error) {
+	return m.groupGrants, nil
 }
 
 type mockProject struct {
@@ -1192,8 +1201,130 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				userEventProvider: &mockEventUser{},
 				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
 				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 0,
+					roleCheck: true,
+				},
+				projectProvider: &mockProject{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grant requested, grant required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck: true,
+					userGrants: []*query.UserGrant{
+						{State: domain.UserGrantStateRequested},
+					},
+				},
+				projectProvider: &mockProject{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grant not yet valid, grant required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck: true,
+					userGrants: []*query.UserGrant{
+						{State: domain.UserGrantStateActive, ValidFrom: time.Now().Add(time.Hour)},
+					},
+				},
+				projectProvider: &mockProject{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grant expired, grant required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck: true,
+					userGrants: []*query.UserGrant{
+						{State: domain.UserGrantStateActive, ValidUntil: time.Now().Add(-time.Hour)},
+					},
 				},
 				projectProvider: &mockProject{},
 				lockoutPolicyProvider: &mockLockoutPolicy{
@@ -1231,8 +1362,53 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				userEventProvider: &mockEventUser{},
 				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
 				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 2,
+					roleCheck: true,
+					userGrants: []*query.UserGrant{
+						{State: domain.UserGrantStateActive},
+						{State: domain.UserGrantStateActive},
+					},
+				},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grant inherited by group, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck: true,
+					groupGrants: []*query.UserGrant{
+						{State: domain.UserGrantStateActive},
+					},
 				},
 				projectProvider:     &mockProject{},
 				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
@@ -1607,6 +1783,105 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"not set up, elevated risk, permission denied error",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+					RiskAssessment: &domain.RiskAssessment{
+						Level:       domain.RiskLevelElevated,
+						Signals:     []domain.RiskSignal{domain.RiskSignalNewDevice},
+						EvaluatedAt: testNow,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelNotSetUp,
+					},
+				},
+			},
+			nil,
+			false,
+			errors.IsPermissionDenied,
+		},
+		{
+			"no second factors allowed, elevated risk, permission denied error",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{},
+					RiskAssessment: &domain.RiskAssessment{
+						Level:       domain.RiskLevelElevated,
+						Signals:     []domain.RiskSignal{domain.RiskSignalRecentFailures},
+						EvaluatedAt: testNow,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelNotSetUp,
+					},
+				},
+			},
+			nil,
+			false,
+			errors.IsPermissionDenied,
+		},
+		{
+			"checked second factor before elevated risk, check and false",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					RiskAssessment: &domain.RiskAssessment{
+						Level:       domain.RiskLevelElevated,
+						Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+						EvaluatedAt: testNow.Add(-time.Minute),
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow.Add(-5 * time.Hour)},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeOTP},
+			},
+			false,
+			nil,
+		},
+		{
+			"checked second factor after elevated risk, true",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					RiskAssessment: &domain.RiskAssessment{
+						Level:       domain.RiskLevelElevated,
+						Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+						EvaluatedAt: testNow.Add(-time.Minute),
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow},
+			},
+			nil,
+			true,
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,56 @@
+package eventstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/bruteforce"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type loginAttemptsProvider interface {
+	LoginAttempts(ctx context.Context, shouldTriggerBulk bool, keyType domain.LoginBlockKeyType, key string, since time.Time) (*query.LoginAttempts, error)
+}
+
+// LoginCaptcha returns the configuration of the captcha,
+// if the user or the IP address need to solve one before the next attempt
+func (repo *AuthRequestRepo) LoginCaptcha(ctx context.Context, userID string, info *domain.BrowserInfo) (_ *bruteforce.CaptchaConfig, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if repo.LoginAttemptsProvider == nil || !repo.LoginThrottle.CaptchaEnabled() {
+		return nil, nil
+	}
+	attempts, err := repo.loginAttempts(ctx, userID, info)
+	if err != nil {
+		return nil, err
+	}
+	for _, attempt := range attempts {
+		if repo.LoginThrottle.CaptchaRequired(attempt.Failures) {
+			return &repo.LoginThrottle.Captcha, nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *AuthRequestRepo) loginAttempts(ctx context.Context, userID string, info *domain.BrowserInfo) ([]*query.LoginAttempts, error) {
+	since := repo.LoginThrottle.Since(time.Now())
+	attempts := make([]*query.LoginAttempts, 0, 2)
+	if userID != "" {
+		userAttempts, err := repo.LoginAttemptsProvider.LoginAttempts(ctx, true, domain.LoginBlockKeyTypeUser, userID, since)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, userAttempts)
+	}
+	if info != nil && info.RemoteIP != nil {
+		ipAttempts, err := repo.LoginAttemptsProvider.LoginAttempts(ctx, true, domain.LoginBlockKeyTypeIP, info.RemoteIP.String(), since)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, ipAttempts)
+	}
+	return attempts, nil
+}
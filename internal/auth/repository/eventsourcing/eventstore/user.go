@@ -2,6 +2,7 @@ package eventstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
@@ -43,6 +44,14 @@ func (repo *UserRepo) UserEventsByID(ctx context.Context, id string, sequence ui
 	return repo.getUserEvents(ctx, id, sequence)
 }
 
+func (repo *UserRepo) UserEventsByTypes(ctx context.Context, id string, creationDate time.Time, eventTypes ...models.EventType) ([]*models.Event, error) {
+	query, err := usr_view.UserEventsByTypesQuery(id, authz.GetInstance(ctx).InstanceID(), creationDate, eventTypes...)
+	if err != nil {
+		return nil, err
+	}
+	return repo.Eventstore.FilterEvents(ctx, query)
+}
+
 func (r *UserRepo) getUserEvents(ctx context.Context, userID string, sequence uint64) ([]*models.Event, error) {
 	query, err := usr_view.UserByIDQuery(userID, authz.GetInstance(ctx).InstanceID(), sequence)
 	if err != nil {
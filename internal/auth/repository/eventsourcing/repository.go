@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/eventstore"
-	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/spooler"
 	auth_view "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
 	"github.com/zitadel/zitadel/internal/auth_request/repository/cache"
 	"github.com/zitadel/zitadel/internal/command"
@@ -13,18 +12,15 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	eventstore2 "github.com/zitadel/zitadel/internal/eventstore"
 	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
-	es_spol "github.com/zitadel/zitadel/internal/eventstore/v1/spooler"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/query"
 )
 
 type Config struct {
 	SearchLimit uint64
-	Spooler     spooler.SpoolerConfig
 }
 
 type EsRepository struct {
-	spooler    *es_spol.Spooler
 	Eventstore v1.Eventstore
 	eventstore.UserRepo
 	eventstore.AuthRequestRepo
@@ -35,7 +31,7 @@ type EsRepository struct {
 }
 
 func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, command *command.Commands, queries *query.Queries, dbClient *database.DB, esV2 *eventstore2.Eventstore, oidcEncryption crypto.EncryptionAlgorithm, userEncryption crypto.EncryptionAlgorithm, allowOrderByCreationDate bool) (*EsRepository, error) {
-	es, err := v1.Start(dbClient, allowOrderByCreationDate)
+	es, err := v1.Start(dbClient, allowOrderByCreationDate, esV2.PersonalData())
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +44,10 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 
 	authReq := cache.Start(dbClient)
 
-	spool := spooler.StartSpooler(ctx, conf.Spooler, es, esV2, view, dbClient, queries)
+	riskEvaluator, err := systemDefaults.LoginRisk.NewEvaluator()
+	if err != nil {
+		return nil, err
+	}
 
 	userRepo := eventstore.UserRepo{
 		SearchLimit:    conf.SearchLimit,
@@ -63,7 +62,6 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 		view,
 	}
 	return &EsRepository{
-		spool,
 		es,
 		userRepo,
 		eventstore.AuthRequestRepo{
@@ -87,6 +85,9 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 			UserGrantProvider:         queryView,
 			ProjectProvider:           queryView,
 			ApplicationProvider:       queries,
+			RiskEvaluator:             riskEvaluator,
+			LoginAttemptsProvider:     queries,
+			LoginThrottle:             systemDefaults.LoginThrottle,
 			IdGenerator:               idGenerator,
 		},
 		eventstore.TokenRepo{
@@ -6,8 +6,8 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/errors"
-	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	usr_model "github.com/zitadel/zitadel/internal/user/model"
 	"github.com/zitadel/zitadel/internal/user/repository/view"
 	"github.com/zitadel/zitadel/internal/user/repository/view/model"
@@ -15,11 +15,15 @@ import (
 )
 
 const (
-	userTable = "auth.users2"
+	userTable = projection.AuthUserProjectionTable
 )
 
 func (v *View) UserByID(userID, instanceID string) (*model.UserView, error) {
-	return view.UserByID(v.Db, userTable, userID, instanceID)
+	db, err := v.db(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return view.UserByID(db, userTable, userID, instanceID)
 }
 
 func (v *View) UserByLoginName(ctx context.Context, loginName, instanceID string) (*model.UserView, error) {
@@ -92,7 +96,11 @@ func (v *View) userByID(ctx context.Context, instanceID string, queries ...query
 		return nil, err
 	}
 
-	user, err := view.UserByID(v.Db, userTable, queriedUser.ID, instanceID)
+	db, err := v.db(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := view.UserByID(db, userTable, queriedUser.ID, instanceID)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, err
 	}
@@ -135,85 +143,45 @@ func (v *View) userByID(ctx context.Context, instanceID string, queries ...query
 }
 
 func (v *View) UsersByOrgID(orgID, instanceID string) ([]*model.UserView, error) {
-	return view.UsersByOrgID(v.Db, userTable, orgID, instanceID)
-}
-
-func (v *View) UserIDsByDomain(domain, instanceID string) ([]string, error) {
-	return view.UserIDsByDomain(v.Db, userTable, domain, instanceID)
-}
-
-func (v *View) SearchUsers(request *usr_model.UserSearchRequest) ([]*model.UserView, uint64, error) {
-	return view.SearchUsers(v.Db, userTable, request)
-}
-
-func (v *View) GetGlobalUserByLoginName(email, instanceID string) (*model.UserView, error) {
-	return view.GetGlobalUserByLoginName(v.Db, userTable, email, instanceID)
-}
-
-func (v *View) UserMFAs(userID, instanceID string) ([]*usr_model.MultiFactor, error) {
-	return view.UserMFAs(v.Db, userTable, userID, instanceID)
-}
-
-func (v *View) PutUser(user *model.UserView, event *models.Event) error {
-	err := view.PutUser(v.Db, userTable, user)
+	db, err := v.db(instanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return v.ProcessedUserSequence(event)
+	return view.UsersByOrgID(db, userTable, orgID, instanceID)
 }
 
-func (v *View) PutUsers(users []*model.UserView, event *models.Event) error {
-	err := view.PutUsers(v.Db, userTable, users...)
+func (v *View) UserIDsByDomain(domain, instanceID string) ([]string, error) {
+	db, err := v.db(instanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return v.ProcessedUserSequence(event)
+	return view.UserIDsByDomain(db, userTable, domain, instanceID)
 }
 
-func (v *View) DeleteUser(userID, instanceID string, event *models.Event) error {
-	err := view.DeleteUser(v.Db, userTable, userID, instanceID)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+func (v *View) SearchUsers(request *usr_model.UserSearchRequest, instanceID string) ([]*model.UserView, uint64, error) {
+	db, err := v.db(instanceID)
+	if err != nil {
+		return nil, 0, err
 	}
-	return v.ProcessedUserSequence(event)
+	return view.SearchUsers(db, userTable, request)
 }
 
-func (v *View) DeleteInstanceUsers(event *models.Event) error {
-	err := view.DeleteInstanceUsers(v.Db, userTable, event.InstanceID)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+func (v *View) GetGlobalUserByLoginName(email, instanceID string) (*model.UserView, error) {
+	db, err := v.db(instanceID)
+	if err != nil {
+		return nil, err
 	}
-	return v.ProcessedUserSequence(event)
+	return view.GetGlobalUserByLoginName(db, userTable, email, instanceID)
 }
 
-func (v *View) UpdateOrgOwnerRemovedUsers(event *models.Event) error {
-	err := view.UpdateOrgOwnerRemovedUsers(v.Db, userTable, event.InstanceID, event.AggregateID)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+func (v *View) UserMFAs(userID, instanceID string) ([]*usr_model.MultiFactor, error) {
+	db, err := v.db(instanceID)
+	if err != nil {
+		return nil, err
 	}
-	return v.ProcessedUserSequence(event)
+	return view.UserMFAs(db, userTable, userID, instanceID)
 }
 
 func (v *View) GetLatestUserSequence(ctx context.Context, instanceID string) (*repository.CurrentSequence, error) {
 	return v.latestSequence(ctx, userTable, instanceID)
 }
-
-func (v *View) GetLatestUserSequences(ctx context.Context, instanceIDs []string) ([]*repository.CurrentSequence, error) {
-	return v.latestSequences(ctx, userTable, instanceIDs)
-}
-
-func (v *View) ProcessedUserSequence(event *models.Event) error {
-	return v.saveCurrentSequence(userTable, event)
-}
-
-func (v *View) UpdateUserSpoolerRunTimestamp(instanceIDs []string) error {
-	return v.updateSpoolerRunSequence(userTable, instanceIDs)
-}
-
-func (v *View) GetLatestUserFailedEvent(sequence uint64, instanceID string) (*repository.FailedEvent, error) {
-	return v.latestFailedEvent(userTable, instanceID, sequence)
-}
-
-func (v *View) ProcessedUserFailedEvent(failedEvent *repository.FailedEvent) error {
-	return v.saveFailedEvent(failedEvent)
-}
@@ -22,6 +22,38 @@ func (repo *UserMembershipRepo) SearchMyMemberships(ctx context.Context, orgID s
 	return userMembershipsToMemberships(memberships), nil
 }
 
+func (repo *UserMembershipRepo) SearchCustomRoleMappings(ctx context.Context) (_ []authz.RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return repo.Queries.CustomRoleMappings(ctx)
+}
+
+// SearchMyInheritedMemberships returns the organisation memberships of the authenticated user
+// on the parent organisations of the organisation, all parents are searched at once
+func (repo *UserMembershipRepo) SearchMyInheritedMemberships(ctx context.Context, orgID string) (_ []*authz.Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	ancestorIDs, err := repo.Queries.OrgAncestorIDs(ctx, orgID)
+	if err != nil || len(ancestorIDs) == 0 {
+		return nil, err
+	}
+	userIDQuery, err := query.NewMembershipUserIDQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	orgIDsQuery, err := query.NewMembershipOrgIDsQuery(ancestorIDs...)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repo.Queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{userIDQuery, orgIDsQuery},
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	return userMembershipsToMemberships(memberships.Memberships), nil
+}
+
 func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID string) (_ []*query.Membership, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
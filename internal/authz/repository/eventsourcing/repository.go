@@ -18,8 +18,8 @@ type EsRepository struct {
 	eventstore.TokenVerifierRepo
 }
 
-func Start(queries *query.Queries, dbClient *database.DB, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, externalSecure, allowOrderByCreationDate bool) (repository.Repository, error) {
-	es, err := v1.Start(dbClient, allowOrderByCreationDate)
+func Start(queries *query.Queries, dbClient *database.DB, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, externalSecure, allowOrderByCreationDate bool, personalData *crypto.PersonalDataEncryption) (repository.Repository, error) {
+	es, err := v1.Start(dbClient, allowOrderByCreationDate, personalData)
 	if err != nil {
 		return nil, err
 	}
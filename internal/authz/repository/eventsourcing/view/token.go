@@ -3,49 +3,24 @@ package view
 import (
 	"context"
 
-	"github.com/zitadel/zitadel/internal/errors"
-	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	usr_view "github.com/zitadel/zitadel/internal/user/repository/view"
 	usr_view_model "github.com/zitadel/zitadel/internal/user/repository/view/model"
 	"github.com/zitadel/zitadel/internal/view/repository"
 )
 
 const (
-	tokenTable = "auth.tokens"
+	tokenTable = projection.AuthTokenProjectionTable
 )
 
 func (v *View) TokenByIDs(tokenID, userID, instanceID string) (*usr_view_model.TokenView, error) {
-	return usr_view.TokenByIDs(v.Db, tokenTable, tokenID, userID, instanceID)
-}
-
-func (v *View) PutToken(token *usr_view_model.TokenView, event *models.Event) error {
-	err := usr_view.PutToken(v.Db, tokenTable, token)
+	db, err := v.db(instanceID)
 	if err != nil {
-		return err
-	}
-	return v.ProcessedTokenSequence(event)
-}
-
-func (v *View) DeleteToken(tokenID, instanceID string, event *models.Event) error {
-	err := usr_view.DeleteToken(v.Db, tokenTable, tokenID, instanceID)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+		return nil, err
 	}
-	return v.ProcessedTokenSequence(event)
-}
-
-func (v *View) DeleteSessionTokens(agentID, userID, instanceID string, event *models.Event) error {
-	err := usr_view.DeleteSessionTokens(v.Db, tokenTable, agentID, userID, instanceID)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
-	}
-	return v.ProcessedTokenSequence(event)
+	return usr_view.TokenByIDs(db, tokenTable, tokenID, userID, instanceID)
 }
 
 func (v *View) GetLatestTokenSequence(ctx context.Context, instanceID string) (*repository.CurrentSequence, error) {
 	return v.latestSequence(ctx, tokenTable, instanceID)
 }
-
-func (v *View) ProcessedTokenSequence(event *models.Event) error {
-	return v.saveCurrentSequence(tokenTable, event)
-}
@@ -12,19 +12,22 @@ import (
 )
 
 type View struct {
-	Db          *gorm.DB
+	Db *gorm.DB
+	// dbs are the view databases of the shards by name, see [View.db]
+	dbs         map[string]*gorm.DB
 	Query       *query.Queries
 	idGenerator id.Generator
 	client      *database.DB
 }
 
 func StartView(sqlClient *database.DB, idGenerator id.Generator, queries *query.Queries) (*View, error) {
-	gorm, err := gorm.Open("postgres", sqlClient)
+	dbs, err := openShards(sqlClient)
 	if err != nil {
 		return nil, err
 	}
 	return &View{
-		Db:          gorm,
+		Db:          dbs[""],
+		dbs:         dbs,
 		idGenerator: idGenerator,
 		Query:       queries,
 		client:      sqlClient,
@@ -38,3 +41,25 @@ func (v *View) Health() (err error) {
 func (v *View) TimeTravel(ctx context.Context, tableName string) string {
 	return tableName + v.client.Timetravel(call.Took(ctx))
 }
+
+// openShards opens the view databases of all shards by name
+func openShards(sqlClient *database.DB) (map[string]*gorm.DB, error) {
+	dbs := make(map[string]*gorm.DB)
+	for _, shard := range sqlClient.ShardNames() {
+		db, err := gorm.Open("postgres", sqlClient.Shard(database.WithShard(context.Background(), shard)).DB)
+		if err != nil {
+			return nil, err
+		}
+		dbs[shard] = db
+	}
+	return dbs, nil
+}
+
+// db returns the view database of the shard the instance is stored in
+func (v *View) db(instanceID string) (*gorm.DB, error) {
+	shard, err := v.client.ShardOf(context.Background(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return v.dbs[shard], nil
+}
@@ -8,4 +8,6 @@ import (
 
 type UserMembershipRepository interface {
 	SearchMyMemberships(ctx context.Context, orgID string) ([]*authz.Membership, error)
+	SearchCustomRoleMappings(ctx context.Context) ([]authz.RoleMapping, error)
+	SearchMyInheritedMemberships(ctx context.Context, orgID string) ([]*authz.Membership, error)
 }
@@ -0,0 +1,126 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+var bloomFilterMagic = [4]byte{'Z', 'B', 'F', '1'}
+
+type BloomFilterConfig struct {
+	// Path of the bloom filter file
+	Path string
+}
+
+// BloomFilter checks passwords against a local bloom filter of SHA-1 hashes of breached passwords.
+// The filter might report false positives, but never false negatives.
+//
+// The file consists of the magic bytes "ZBF1", the amount of bits (uint64) and hash functions (uint32)
+// in big endian, followed by the bits of the filter.
+// Building the filter from SHA-1 hashes allows to use published hash lists (e.g. https://haveibeenpwned.com/Passwords).
+type BloomFilter struct {
+	bits   []byte
+	size   uint64
+	hashes uint32
+}
+
+// NewBloomFilter creates an empty filter with size bits and the amount of hash functions.
+func NewBloomFilter(size uint64, hashes uint32) (*BloomFilter, error) {
+	if size == 0 || hashes == 0 {
+		return nil, errors.ThrowInvalidArgument(nil, "BREAC-Bf2sa", "size and hashes of the bloom filter must be greater than 0")
+	}
+	return &BloomFilter{
+		bits:   make([]byte, (size+7)/8),
+		size:   size,
+		hashes: hashes,
+	}, nil
+}
+
+// LoadBloomFilter reads the filter from the file at path
+func LoadBloomFilter(path string) (*BloomFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "BREAC-Bf3sa", "unable to open bloom filter file")
+	}
+	defer file.Close()
+	return ReadBloomFilter(bufio.NewReader(file))
+}
+
+// ReadBloomFilter reads a filter written by [BloomFilter.WriteTo]
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	var header struct {
+		Magic  [4]byte
+		Size   uint64
+		Hashes uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, errors.ThrowInternal(err, "BREAC-Bf4sa", "unable to read bloom filter header")
+	}
+	if header.Magic != bloomFilterMagic {
+		return nil, errors.ThrowInvalidArgument(nil, "BREAC-Bf5sa", "invalid bloom filter file")
+	}
+	filter, err := NewBloomFilter(header.Size, header.Hashes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, filter.bits); err != nil {
+		return nil, errors.ThrowInternal(err, "BREAC-Bf6sa", "unable to read bloom filter")
+	}
+	return filter, nil
+}
+
+// WriteTo writes the filter in the format read by [ReadBloomFilter]
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	header := struct {
+		Magic  [4]byte
+		Size   uint64
+		Hashes uint32
+	}{
+		Magic:  bloomFilterMagic,
+		Size:   f.size,
+		Hashes: f.hashes,
+	}
+	if err := binary.Write(w, binary.BigEndian, header); err != nil {
+		return 0, err
+	}
+	n, err := w.Write(f.bits)
+	return int64(binary.Size(header) + n), err
+}
+
+// Add adds the password to the filter
+func (f *BloomFilter) Add(password string) {
+	f.AddHash(hashPassword(password))
+}
+
+// AddHash adds the SHA-1 hash of a password to the filter
+func (f *BloomFilter) AddHash(hash [sha1.Size]byte) {
+	for _, position := range f.positions(hash) {
+		f.bits[position/8] |= 1 << (position % 8)
+	}
+}
+
+func (f *BloomFilter) IsBreached(_ context.Context, password string) (bool, error) {
+	for _, position := range f.positions(hashPassword(password)) {
+		if f.bits[position/8]&(1<<(position%8)) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// positions computes the bits of the hash using double hashing
+func (f *BloomFilter) positions(hash [sha1.Size]byte) []uint64 {
+	h1 := binary.BigEndian.Uint64(hash[0:8])
+	h2 := binary.BigEndian.Uint64(hash[8:16])
+	positions := make([]uint64, f.hashes)
+	for i := range positions {
+		positions[i] = (h1 + uint64(i)*h2) % f.size
+	}
+	return positions
+}
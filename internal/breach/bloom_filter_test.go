@@ -0,0 +1,62 @@
+package breach
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func TestBloomFilter_IsBreached(t *testing.T) {
+	filter, err := NewBloomFilter(1<<16, 7)
+	require.NoError(t, err)
+	filter.Add("password")
+	filter.AddHash(hashPassword("123456"))
+
+	var buf bytes.Buffer
+	_, err = filter.WriteTo(&buf)
+	require.NoError(t, err)
+	loaded, err := ReadBloomFilter(&buf)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		breached bool
+	}{
+		{
+			name:     "added password",
+			password: "password",
+			breached: true,
+		},
+		{
+			name:     "added hash",
+			password: "123456",
+			breached: true,
+		},
+		{
+			name:     "unknown password",
+			password: "Corr3ct-Horse-Battery-Stapl3",
+			breached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := loaded.IsBreached(context.Background(), tt.password)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.breached, breached)
+		})
+	}
+}
+
+func TestReadBloomFilter_invalid(t *testing.T) {
+	_, err := ReadBloomFilter(bytes.NewReader([]byte("ZBF0\x00\x00\x00\x00\x00\x00\x00\x08\x00\x00\x00\x01\x00")))
+	assert.True(t, errors.IsErrorInvalidArgument(err))
+
+	_, err = ReadBloomFilter(bytes.NewReader([]byte("ZBF1\x00\x00\x00\x00\x00\x00\x00\x10\x00\x00\x00\x01\x00")))
+	assert.True(t, errors.IsInternal(err))
+}
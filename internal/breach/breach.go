@@ -0,0 +1,49 @@
+package breach
+
+import (
+	"context"
+	"crypto/sha1"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+type CheckerType string
+
+const (
+	CheckerTypeNone        CheckerType = ""
+	CheckerTypeRangeAPI    CheckerType = "rangeapi"
+	CheckerTypeBloomFilter CheckerType = "bloomfilter"
+)
+
+// Checker checks if a password was found in known data breaches
+type Checker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+type Config struct {
+	// Type defines the checker used: rangeapi, bloomfilter or empty to disable the check
+	Type        CheckerType
+	RangeAPI    RangeAPIConfig
+	BloomFilter BloomFilterConfig
+}
+
+// NewChecker creates the configured checker.
+// It returns nil if no checker is configured.
+func (c *Config) NewChecker(client *http.Client) (Checker, error) {
+	switch CheckerType(strings.ToLower(string(c.Type))) {
+	case CheckerTypeNone:
+		return nil, nil
+	case CheckerTypeRangeAPI:
+		return NewRangeAPIChecker(&c.RangeAPI, client), nil
+	case CheckerTypeBloomFilter:
+		return LoadBloomFilter(c.BloomFilter.Path)
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "BREAC-Tc2sa", "unknown breached password checker type %s", c.Type)
+	}
+}
+
+func hashPassword(password string) [sha1.Size]byte {
+	return sha1.Sum([]byte(password))
+}
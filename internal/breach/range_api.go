@@ -0,0 +1,96 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	// rangePrefixLength is the amount of hex characters of the hash sent to the api,
+	// the remaining part of the hash never leaves ZITADEL (k-anonymity)
+	rangePrefixLength = 5
+)
+
+type RangeAPIConfig struct {
+	// Endpoint is the url of the range api, the first five characters of the hex encoded SHA-1 hash are appended
+	Endpoint string
+	// Timeout of a single request to the api
+	Timeout time.Duration
+	// MinOccurrences defines how often a password must have been found in breaches to be rejected
+	MinOccurrences uint64
+}
+
+// RangeAPIChecker checks passwords against a k-anonymity range api (e.g. https://haveibeenpwned.com/API/v3#PwnedPasswords).
+// Only the prefix of the SHA-1 hash of the password is sent to the api.
+type RangeAPIChecker struct {
+	endpoint       string
+	timeout        time.Duration
+	minOccurrences uint64
+	client         *http.Client
+}
+
+func NewRangeAPIChecker(config *RangeAPIConfig, client *http.Client) *RangeAPIChecker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	minOccurrences := config.MinOccurrences
+	if minOccurrences == 0 {
+		minOccurrences = 1
+	}
+	return &RangeAPIChecker{
+		endpoint:       config.Endpoint,
+		timeout:        config.Timeout,
+		minOccurrences: minOccurrences,
+		client:         client,
+	}
+}
+
+func (c *RangeAPIChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	hash := hashPassword(password)
+	encoded := strings.ToUpper(hex.EncodeToString(hash[:]))
+	prefix, suffix := encoded[:rangePrefixLength], encoded[rangePrefixLength:]
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+prefix, nil)
+	if err != nil {
+		return false, errors.ThrowInternal(err, "BREAC-Ra2ps", "unable to create range request")
+	}
+	// padding prevents the size of the response to reveal the prefix
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, errors.ThrowUnavailable(err, "BREAC-Ra3ps", "range api not reachable")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.ThrowUnavailablef(nil, "BREAC-Ra4ps", "range api responded with status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		hashSuffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found || !strings.EqualFold(hashSuffix, suffix) {
+			continue
+		}
+		occurrences, err := strconv.ParseUint(count, 10, 64)
+		if err != nil {
+			return false, errors.ThrowInternal(err, "BREAC-Ra5ps", "invalid range response")
+		}
+		return occurrences >= c.minOccurrences, nil
+	}
+	if err = scanner.Err(); err != nil {
+		return false, errors.ThrowInternal(err, "BREAC-Ra6ps", "unable to read range response")
+	}
+	return false, nil
+}
@@ -0,0 +1,122 @@
+package breach
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// rangeAPI is a local stand-in of a k-anonymity range api
+type rangeAPI struct {
+	occurrences map[string]uint64
+	prefixes    []string
+}
+
+func newRangeAPI(t *testing.T, occurrences map[string]uint64) *httptest.Server {
+	t.Helper()
+	api := &rangeAPI{occurrences: make(map[string]uint64, len(occurrences))}
+	for password, count := range occurrences {
+		hash := sha1.Sum([]byte(password))
+		api.occurrences[strings.ToUpper(hex.EncodeToString(hash[:]))] = count
+	}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (api *rangeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	prefix := strings.TrimPrefix(r.URL.Path, "/range/")
+	if len(prefix) != rangePrefixLength {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// padding entry
+	fmt.Fprintf(w, "%s:0\r\n", strings.Repeat("0", 35))
+	for hash, count := range api.occurrences {
+		if strings.HasPrefix(hash, prefix) {
+			fmt.Fprintf(w, "%s:%d\r\n", hash[rangePrefixLength:], count)
+		}
+	}
+}
+
+func TestRangeAPIChecker_IsBreached(t *testing.T) {
+	server := newRangeAPI(t, map[string]uint64{
+		"password": 10,
+		"rare":     1,
+	})
+	type args struct {
+		config   *RangeAPIConfig
+		password string
+	}
+	type res struct {
+		breached bool
+		err      func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "breached",
+			args: args{
+				config:   &RangeAPIConfig{Endpoint: server.URL + "/range/"},
+				password: "password",
+			},
+			res: res{
+				breached: true,
+			},
+		},
+		{
+			name: "not breached",
+			args: args{
+				config:   &RangeAPIConfig{Endpoint: server.URL + "/range/"},
+				password: "Corr3ct-Horse-Battery-Stapl3",
+			},
+			res: res{
+				breached: false,
+			},
+		},
+		{
+			name: "below min occurrences",
+			args: args{
+				config:   &RangeAPIConfig{Endpoint: server.URL + "/range/", MinOccurrences: 2},
+				password: "rare",
+			},
+			res: res{
+				breached: false,
+			},
+		},
+		{
+			name: "api error",
+			args: args{
+				config:   &RangeAPIConfig{Endpoint: server.URL + "/wrong/"},
+				password: "password",
+			},
+			res: res{
+				err: errors.IsUnavailable,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewRangeAPIChecker(tt.args.config, server.Client())
+			breached, err := checker.IsBreached(context.Background(), tt.args.password)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.breached, breached)
+		})
+	}
+}
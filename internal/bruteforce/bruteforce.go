@@ -0,0 +1,85 @@
+package bruteforce
+
+import (
+	"time"
+)
+
+const (
+	// MaxWindow is the longest period failed attempts are kept for,
+	// failed attempts which are older are removed from the login attempts projection
+	MaxWindow = 24 * time.Hour
+)
+
+// Config defines how failed login attempts of a single IP or user are throttled.
+// Once the Threshold is reached every further attempt is delayed,
+// starting with BaseDelay and doubling with every failure up to MaxDelay.
+type Config struct {
+	// Threshold of failed attempts during the Window, after which attempts are delayed (0 disables the delays)
+	Threshold uint64
+	// BaseDelay is the delay after the first failed attempt exceeding the Threshold
+	BaseDelay time.Duration
+	// MaxDelay limits the delay between two attempts
+	MaxDelay time.Duration
+	// Window in which failed attempts are counted, at most 24 hours
+	Window time.Duration
+	// CaptchaThreshold of failed attempts during the Window, after which a solved captcha is required (0 disables captchas)
+	CaptchaThreshold uint64
+	Captcha          CaptchaConfig
+}
+
+// Enabled reports if delays or captchas are configured
+func (c *Config) Enabled() bool {
+	return c.Threshold > 0 || c.CaptchaEnabled()
+}
+
+// CaptchaEnabled reports if captchas are configured
+func (c *Config) CaptchaEnabled() bool {
+	return c.CaptchaThreshold > 0 && c.Captcha.VerifyURL != ""
+}
+
+// Since returns the start of the window in which failed attempts are counted
+func (c *Config) Since(now time.Time) time.Time {
+	window := c.Window
+	if window <= 0 || window > MaxWindow {
+		window = MaxWindow
+	}
+	return now.Add(-window)
+}
+
+// Delay returns the time which has to pass after the last failed attempt
+// before the next attempt is allowed
+func (c *Config) Delay(failures uint64) time.Duration {
+	if c.Threshold == 0 || failures < c.Threshold || c.BaseDelay <= 0 {
+		return 0
+	}
+	delay := c.BaseDelay
+	for i := c.Threshold; i < failures; i++ {
+		delay *= 2
+		if c.MaxDelay > 0 && delay >= c.MaxDelay {
+			return c.MaxDelay
+		}
+		// prevents an overflow if no MaxDelay is configured
+		if delay >= MaxWindow {
+			return MaxWindow
+		}
+	}
+	if c.MaxDelay > 0 && delay > c.MaxDelay {
+		return c.MaxDelay
+	}
+	return delay
+}
+
+// BlockedUntil returns the point in time until further attempts are rejected,
+// the zero time is returned if the attempts are not delayed
+func (c *Config) BlockedUntil(failures uint64, lastFailure time.Time) time.Time {
+	delay := c.Delay(failures)
+	if delay == 0 {
+		return time.Time{}
+	}
+	return lastFailure.Add(delay)
+}
+
+// CaptchaRequired reports if the next attempt requires a solved captcha
+func (c *Config) CaptchaRequired(failures uint64) bool {
+	return c.CaptchaEnabled() && failures >= c.CaptchaThreshold
+}
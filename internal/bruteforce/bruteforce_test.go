@@ -0,0 +1,127 @@
+package bruteforce
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Delay(t *testing.T) {
+	config := &Config{
+		Threshold: 3,
+		BaseDelay: time.Second,
+		MaxDelay:  10 * time.Second,
+	}
+	tests := []struct {
+		name     string
+		config   *Config
+		failures uint64
+		want     time.Duration
+	}{
+		{
+			name:     "disabled",
+			config:   &Config{},
+			failures: 100,
+			want:     0,
+		},
+		{
+			name:     "below threshold",
+			config:   config,
+			failures: 2,
+			want:     0,
+		},
+		{
+			name:     "threshold reached",
+			config:   config,
+			failures: 3,
+			want:     time.Second,
+		},
+		{
+			name:     "doubled",
+			config:   config,
+			failures: 5,
+			want:     4 * time.Second,
+		},
+		{
+			name:     "max delay",
+			config:   config,
+			failures: 7,
+			want:     10 * time.Second,
+		},
+		{
+			name: "no max delay",
+			config: &Config{
+				Threshold: 1,
+				BaseDelay: time.Hour,
+			},
+			failures: 1000,
+			want:     MaxWindow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.Delay(tt.failures))
+		})
+	}
+}
+
+func TestConfig_BlockedUntil(t *testing.T) {
+	config := &Config{
+		Threshold: 2,
+		BaseDelay: time.Minute,
+	}
+	lastFailure := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, config.BlockedUntil(1, lastFailure).IsZero())
+	assert.Equal(t, lastFailure.Add(2*time.Minute), config.BlockedUntil(3, lastFailure))
+}
+
+func TestConfig_CaptchaRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		failures uint64
+		want     bool
+	}{
+		{
+			name: "no provider",
+			config: &Config{
+				CaptchaThreshold: 1,
+			},
+			failures: 5,
+			want:     false,
+		},
+		{
+			name: "below threshold",
+			config: &Config{
+				CaptchaThreshold: 5,
+				Captcha:          CaptchaConfig{VerifyURL: "https://captcha.test/siteverify"},
+			},
+			failures: 4,
+			want:     false,
+		},
+		{
+			name: "threshold reached",
+			config: &Config{
+				CaptchaThreshold: 5,
+				Captcha:          CaptchaConfig{VerifyURL: "https://captcha.test/siteverify"},
+			},
+			failures: 5,
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.CaptchaRequired(tt.failures))
+		})
+	}
+}
+
+func TestConfig_Since(t *testing.T) {
+	now := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.Add(-time.Hour), (&Config{Window: time.Hour}).Since(now))
+	assert.Equal(t, now.Add(-MaxWindow), (&Config{}).Since(now))
+	assert.Equal(t, now.Add(-MaxWindow), (&Config{Window: 48 * time.Hour}).Since(now))
+}
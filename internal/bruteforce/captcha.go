@@ -0,0 +1,94 @@
+package bruteforce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// CaptchaConfig configures a captcha provider with a siteverify api
+// (e.g. hCaptcha, reCAPTCHA or Cloudflare Turnstile)
+type CaptchaConfig struct {
+	// SiteKey is the public key rendered into the widget
+	SiteKey string
+	// Secret is sent to the VerifyURL to verify the response of the widget
+	Secret string
+	// VerifyURL of the siteverify api of the provider
+	VerifyURL string
+	// ScriptURL of the javascript rendering the widget
+	ScriptURL string
+	// WidgetClass is the css class of the element the widget is rendered into
+	WidgetClass string
+	// ResponseField is the name of the form field the widget writes its response to
+	ResponseField string
+	// Timeout of a single request to the VerifyURL
+	Timeout time.Duration
+	// CSPHosts are allowed to serve scripts, frames and requests of the widget on the login
+	CSPHosts []string
+}
+
+type captchaVerifyResponse struct {
+	Success bool `json:"success"`
+}
+
+// Verify checks the response of the captcha widget against the siteverify api of the provider
+func (c *CaptchaConfig) Verify(ctx context.Context, client *http.Client, response, remoteIP string) error {
+	if response == "" {
+		return errors.ThrowInvalidArgument(nil, "BRUTE-Cap1a", "Errors.Login.Captcha.Invalid")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	form := url.Values{
+		"secret":   {c.Secret},
+		"response": {response},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.VerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.ThrowInternal(err, "BRUTE-Cap2a", "unable to create captcha verify request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.ThrowUnavailable(err, "BRUTE-Cap3a", "captcha provider not reachable")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.ThrowUnavailablef(nil, "BRUTE-Cap4a", "captcha provider responded with status %d", resp.StatusCode)
+	}
+	verification := new(captchaVerifyResponse)
+	if err = json.NewDecoder(resp.Body).Decode(verification); err != nil {
+		return errors.ThrowInternal(err, "BRUTE-Cap5a", "unable to parse captcha verify response")
+	}
+	if !verification.Success {
+		return errors.ThrowInvalidArgument(nil, "BRUTE-Cap6a", "Errors.Login.Captcha.Invalid")
+	}
+	return nil
+}
+
+type captchaResponseKey struct{}
+
+// WithCaptchaResponse sets the response of the captcha widget solved by the user,
+// which is verified by the checks requiring a captcha
+func WithCaptchaResponse(ctx context.Context, response string) context.Context {
+	return context.WithValue(ctx, captchaResponseKey{}, response)
+}
+
+// CaptchaResponseFromCtx returns the response of the captcha widget set by WithCaptchaResponse
+func CaptchaResponseFromCtx(ctx context.Context) string {
+	response, _ := ctx.Value(captchaResponseKey{}).(string)
+	return response
+}
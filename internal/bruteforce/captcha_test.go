@@ -0,0 +1,93 @@
+package bruteforce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// newSiteverifyAPI is a local stand-in of a captcha provider accepting the response "solved"
+func newSiteverifyAPI(t *testing.T, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		success := r.PostForm.Get("secret") == "secret" &&
+			r.PostForm.Get("response") == "solved" &&
+			r.PostForm.Get("remoteip") == "1.2.3.4"
+		fmt.Fprintf(w, `{"success": %t}`, success)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCaptchaConfig_Verify(t *testing.T) {
+	type args struct {
+		status   int
+		response string
+	}
+	tests := []struct {
+		name string
+		args args
+		err  func(error) bool
+	}{
+		{
+			name: "missing response",
+			args: args{
+				status:   http.StatusOK,
+				response: "",
+			},
+			err: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "provider unavailable",
+			args: args{
+				status:   http.StatusInternalServerError,
+				response: "solved",
+			},
+			err: errors.IsUnavailable,
+		},
+		{
+			name: "wrong response",
+			args: args{
+				status:   http.StatusOK,
+				response: "guessed",
+			},
+			err: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "solved",
+			args: args{
+				status:   http.StatusOK,
+				response: "solved",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSiteverifyAPI(t, tt.args.status)
+			config := &CaptchaConfig{
+				Secret:    "secret",
+				VerifyURL: server.URL,
+			}
+			err := config.Verify(context.Background(), server.Client(), tt.args.response, "1.2.3.4")
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "unexpected error: %v", err)
+		})
+	}
+}
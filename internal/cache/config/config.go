@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/cache/bigcache"
 	"github.com/zitadel/zitadel/internal/cache/fastcache"
+	"github.com/zitadel/zitadel/internal/cache/redis"
 	"github.com/zitadel/zitadel/internal/errors"
 )
 
@@ -17,6 +18,29 @@ type CacheConfig struct {
 var caches = map[string]func() cache.Config{
 	"bigcache":  func() cache.Config { return &bigcache.Config{} },
 	"fastcache": func() cache.Config { return &fastcache.Config{} },
+	"redis":     func() cache.Config { return &redis.Config{} },
+}
+
+// Connectors configures the cache of the queries
+// the connector is selected by Type, an empty Type disables the cache
+// The entries are invalidated by the node reducing the events,
+// so only caches shared by all nodes are supported.
+type Connectors struct {
+	Type  string
+	Redis *redis.Config
+}
+
+func (c *Connectors) NewCache() (cache.Cache, error) {
+	if c == nil || c.Type == "" {
+		return nil, nil
+	}
+	if c.Type != "redis" {
+		return nil, errors.ThrowInvalidArgumentf(nil, "CONFI-Wq3ns", "cache %s is not shared between the nodes and not supported for queries", c.Type)
+	}
+	if c.Redis == nil {
+		return nil, errors.ThrowInternalf(nil, "CONFI-Cq2bd", "no config for cache %s", c.Type)
+	}
+	return c.Redis.NewCache()
 }
 
 func (c *CacheConfig) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+type Config struct {
+	// Addr is the host:port of the server speaking the Redis protocol (e.g. Redis, KeyDB, Dragonfly)
+	Addr     string
+	Username string
+	Password string
+	DB       int
+	// MaxConns limits the connections to the server
+	MaxConns int
+	// DialTimeout limits the time to connect and to execute a command
+	DialTimeout time.Duration
+	// CacheLifetime if set, entries expire after the lifetime
+	CacheLifetime time.Duration
+	TLS           TLS
+}
+
+type TLS struct {
+	// Enabled connects to the server over TLS
+	Enabled bool
+	// RootCert is the path to the CA certificate of the server, the CAs of the system are used if empty
+	RootCert string
+	// Cert is the path to the client certificate, if the server requires client authentication
+	Cert string
+	// Key is the path to the private key of the client certificate
+	Key string
+}
+
+func (c *Config) NewCache() (cache.Cache, error) {
+	return NewRedis(c)
+}
+
+func (c *Config) options() (*redis.Options, error) {
+	tlsConfig, err := c.TLS.config(c.Addr)
+	if err != nil {
+		return nil, err
+	}
+	return &redis.Options{
+		Addr:         c.Addr,
+		Username:     c.Username,
+		Password:     c.Password,
+		DB:           c.DB,
+		PoolSize:     c.MaxConns,
+		DialTimeout:  c.DialTimeout,
+		ReadTimeout:  c.DialTimeout,
+		WriteTimeout: c.DialTimeout,
+		TLSConfig:    tlsConfig,
+	}, nil
+}
+
+func (t *TLS) config(addr string) (*tls.Config, error) {
+	if !t.Enabled {
+		return nil, nil
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "REDIS-Tl1sa", "invalid address of the cache")
+	}
+	config := &tls.Config{
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+	}
+	if t.RootCert != "" {
+		rootCert, err := os.ReadFile(t.RootCert)
+		if err != nil {
+			return nil, errors.ThrowInvalidArgument(err, "REDIS-Tl2sb", "unable to read the root certificate of the cache")
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(rootCert) {
+			return nil, errors.ThrowInvalidArgument(nil, "REDIS-Tl3sc", "invalid root certificate of the cache")
+		}
+	}
+	if t.Cert != "" || t.Key != "" {
+		cert, err := tls.LoadX509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, errors.ThrowInvalidArgument(err, "REDIS-Tl4sd", "unable to load the client certificate of the cache")
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
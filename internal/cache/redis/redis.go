@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"reflect"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+// Redis is a cache shared by all ZITADEL nodes, stored on a server speaking the Redis protocol (RESP)
+type Redis struct {
+	client *redis.Client
+	config *Config
+}
+
+func NewRedis(config *Config) (*Redis, error) {
+	options, err := config.options()
+	if err != nil {
+		return nil, err
+	}
+	r := &Redis{
+		client: redis.NewClient(options),
+		config: config,
+	}
+	// verify the connection on startup
+	if err = r.client.Ping(context.Background()).Err(); err != nil {
+		r.client.Close()
+		return nil, errors.ThrowUnavailable(err, "REDIS-Jv2nq", "unable to connect to cache")
+	}
+	return r, nil
+}
+
+func (r *Redis) Set(key string, object interface{}) error {
+	if key == "" || reflect.ValueOf(object).IsNil() {
+		return errors.ThrowInvalidArgument(nil, "REDIS-Ls8dD", "key or value should not be empty")
+	}
+	var b bytes.Buffer
+	enc := gob.NewEncoder(&b)
+	if err := enc.Encode(object); err != nil {
+		return errors.ThrowInvalidArgument(err, "REDIS-Y0mZk", "unable to encode object")
+	}
+	if err := r.client.Set(context.Background(), key, b.Bytes(), r.config.CacheLifetime).Err(); err != nil {
+		return errors.ThrowInternal(err, "REDIS-Qw4vb", "unable to write to cache")
+	}
+	return nil
+}
+
+func (r *Redis) Get(key string, ptrToObject interface{}) error {
+	if key == "" || reflect.ValueOf(ptrToObject).IsNil() {
+		return errors.ThrowInvalidArgument(nil, "REDIS-p2Eoh", "key or value should not be empty")
+	}
+	data, err := r.client.Get(context.Background(), key).Bytes()
+	if err == redis.Nil || (err == nil && len(data) == 0) {
+		return errors.ThrowNotFound(nil, "REDIS-Hk3sU", "not in cache")
+	}
+	if err != nil {
+		return errors.ThrowInternal(err, "REDIS-bN5tc", "unable to read from cache")
+	}
+	dec := gob.NewDecoder(bytes.NewBuffer(data))
+	return dec.Decode(ptrToObject)
+}
+
+func (r *Redis) Delete(key string) error {
+	if key == "" {
+		return errors.ThrowInvalidArgument(nil, "REDIS-Ee0ws", "key should not be empty")
+	}
+	if err := r.client.Del(context.Background(), key).Err(); err != nil {
+		return errors.ThrowInternal(err, "REDIS-Vn7fx", "unable to delete from cache")
+	}
+	return nil
+}
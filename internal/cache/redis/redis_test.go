@@ -0,0 +1,214 @@
+package redis
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+type TestStruct struct {
+	Test string
+}
+
+func newTestRedis(t *testing.T, server *miniredis.Miniredis) *Redis {
+	t.Helper()
+	r, err := NewRedis(&Config{Addr: server.Addr(), DialTimeout: time.Second, CacheLifetime: time.Hour})
+	if err != nil {
+		t.Fatalf("unable to connect to miniredis: %v", err)
+	}
+	return r
+}
+
+func TestNewRedis(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	if _, err := NewRedis(&Config{Addr: server.Addr(), Password: "wrong", DialTimeout: time.Second}); !errors.IsUnavailable(err) {
+		t.Errorf("expected unavailable error with wrong password, got: %v", err)
+	}
+	if _, err := NewRedis(&Config{Addr: server.Addr(), Password: "secret", DialTimeout: time.Second}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewRedis(&Config{Addr: server.Addr(), Password: "secret", DialTimeout: time.Second, TLS: TLS{Enabled: true, RootCert: "/does/not/exist"}}); !errors.IsErrorInvalidArgument(err) {
+		t.Errorf("expected invalid argument with missing root certificate, got: %v", err)
+	}
+}
+
+func TestSetGet(t *testing.T) {
+	type args struct {
+		key   string
+		value *TestStruct
+	}
+	type res struct {
+		result  *TestStruct
+		errFunc func(err error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "set cache no err",
+			args: args{
+				key:   "KEY",
+				value: &TestStruct{Test: "Test"},
+			},
+			res: res{
+				result: &TestStruct{Test: "Test"},
+			},
+		},
+		{
+			name: "key empty",
+			args: args{
+				key:   "",
+				value: &TestStruct{Test: "Test"},
+			},
+			res: res{
+				errFunc: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "set cache nil value",
+			args: args{
+				key: "KEY",
+			},
+			res: res{
+				errFunc: errors.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRedis(t, miniredis.RunT(t))
+			err := r.Set(tt.args.key, tt.args.value)
+			if tt.res.errFunc == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.res.errFunc != nil {
+				if !tt.res.errFunc(err) {
+					t.Errorf("got wrong err: %v", err)
+				}
+				return
+			}
+			got := new(TestStruct)
+			if err = r.Get(tt.args.key, got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.res.result) {
+				t.Errorf("got: %v, want: %v", got, tt.res.result)
+			}
+		})
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	r := newTestRedis(t, miniredis.RunT(t))
+	err := r.Get("KEY", new(TestStruct))
+	if !errors.IsNotFound(err) {
+		t.Errorf("expected not found, got: %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	r := newTestRedis(t, miniredis.RunT(t))
+	if err := r.Set("KEY", &TestStruct{Test: "Test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Delete("KEY"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Get("KEY", new(TestStruct)); !errors.IsNotFound(err) {
+		t.Errorf("expected not found, got: %v", err)
+	}
+	if err := r.Delete(""); !errors.IsErrorInvalidArgument(err) {
+		t.Errorf("expected invalid argument, got: %v", err)
+	}
+}
+
+func TestLifetime(t *testing.T) {
+	server := miniredis.RunT(t)
+	r := newTestRedis(t, server)
+	if err := r.Set("KEY", &TestStruct{Test: "Test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ttl := server.TTL("KEY"); ttl != time.Hour {
+		t.Errorf("expected lifetime of an hour, got %s", ttl)
+	}
+	server.FastForward(time.Hour)
+	if err := r.Get("KEY", new(TestStruct)); !errors.IsNotFound(err) {
+		t.Errorf("expected not found after the lifetime, got: %v", err)
+	}
+}
+
+func TestTLS(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("unable to load certificate: %v", err)
+	}
+	server, err := miniredis.RunTLS(&tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatalf("unable to start miniredis: %v", err)
+	}
+	t.Cleanup(server.Close)
+	rootCert := filepath.Join(t.TempDir(), "ca.crt")
+	if err = os.WriteFile(rootCert, certPEM, 0600); err != nil {
+		t.Fatalf("unable to write root certificate: %v", err)
+	}
+
+	if _, err = NewRedis(&Config{Addr: server.Addr(), DialTimeout: time.Second}); !errors.IsUnavailable(err) {
+		t.Errorf("expected unavailable error without tls, got: %v", err)
+	}
+	r, err := NewRedis(&Config{Addr: server.Addr(), DialTimeout: time.Second, TLS: TLS{Enabled: true, RootCert: rootCert}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = r.Set("KEY", &TestStruct{Test: "Test"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// newTestCertificate creates a self-signed certificate for 127.0.0.1
+func newTestCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
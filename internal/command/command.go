@@ -7,6 +7,8 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	api_http "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/breach"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -15,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/repository/action"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	instance_repo "github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
@@ -33,6 +36,8 @@ type Commands struct {
 
 	checkPermission domain.PermissionCheck
 	newCode         cryptoCodeFunc
+	// newMagicLinkCode falls back to the system defaults for instances without their own generator config
+	newMagicLinkCode cryptoCodeFunc
 
 	eventstore     *eventstore.Eventstore
 	static         static.Storage
@@ -47,6 +52,7 @@ type Commands struct {
 	smsEncryption               crypto.EncryptionAlgorithm
 	userEncryption              crypto.EncryptionAlgorithm
 	userPasswordAlg             crypto.HashAlgorithm
+	breachedPasswords           breach.Checker
 	machineKeySize              int
 	applicationKeySize          int
 	domainVerificationAlg       crypto.EncryptionAlgorithm
@@ -54,6 +60,8 @@ type Commands struct {
 	domainVerificationValidator func(domain, token, verifier string, checkType api_http.CheckType) error
 	sessionTokenCreator         func(sessionID string) (id string, token string, err error)
 	sessionTokenVerifier        func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+	loginThrottle               bruteforce.Config
+	loginAttempts               domain.LoginAttemptsProvider
 
 	multifactors         domain.MultifactorConfigs
 	webauthnConfig       *webauthn_helper.Config
@@ -79,6 +87,7 @@ func StartCommands(
 	httpClient *http.Client,
 	permissionCheck domain.PermissionCheck,
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error),
+	loginAttempts domain.LoginAttemptsProvider,
 ) (repo *Commands, err error) {
 	if externalDomain == "" {
 		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Df21s", "no external domain specified")
@@ -110,8 +119,11 @@ func StartCommands(
 		httpClient:            httpClient,
 		checkPermission:       permissionCheck,
 		newCode:               newCryptoCodeWithExpiry,
+		newMagicLinkCode:      newCryptoCodeWithDefault(defaults.SecretGenerators.MagicLinkCode),
 		sessionTokenCreator:   sessionTokenCreator(idGenerator, sessionAlg),
 		sessionTokenVerifier:  sessionTokenVerifier,
+		loginThrottle:         defaults.LoginThrottle,
+		loginAttempts:         loginAttempts,
 	}
 
 	instance_repo.RegisterEventMappers(repo.eventstore)
@@ -124,8 +136,13 @@ func StartCommands(
 	quota.RegisterEventMappers(repo.eventstore)
 	session.RegisterEventMappers(repo.eventstore)
 	idpintent.RegisterEventMappers(repo.eventstore)
+	group.RegisterEventMappers(repo.eventstore)
 
 	repo.userPasswordAlg = crypto.NewBCrypt(defaults.SecretGenerators.PasswordSaltCost)
+	repo.breachedPasswords, err = defaults.BreachedPasswords.NewChecker(httpClient)
+	if err != nil {
+		return nil, err
+	}
 	repo.machineKeySize = int(defaults.SecretGenerators.MachineKeySize)
 	repo.applicationKeySize = int(defaults.SecretGenerators.ApplicationKeySize)
 
@@ -155,6 +172,29 @@ func (c *Commands) pushAppendAndReduce(ctx context.Context, object AppendReducer
 	return AppendAndReduce(object, events...)
 }
 
+// maxSequenceConflictRetries is the amount of retries of a command
+// if its aggregate was changed concurrently
+const maxSequenceConflictRetries = 3
+
+// pushWithSequenceCheck pushes the commands returned by prepare only if the aggregate wasn't changed in between.
+// prepare must query and reduce the write model again and set it on the aggregate of the commands
+// using [eventstore.Aggregate.ExpectWriteModel], so the push fails if the events of the write model changed.
+// If the aggregate was changed concurrently prepare is called again.
+// It's used by the commands changing the project aggregate (project, roles, applications, members and grants),
+// the other commands push without sequence check.
+func (c *Commands) pushWithSequenceCheck(ctx context.Context, prepare func(ctx context.Context) ([]eventstore.Command, error)) (events []eventstore.Event, err error) {
+	for retry := 0; ; retry++ {
+		cmds, err := prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		events, err = c.eventstore.Push(ctx, cmds...)
+		if !eventstore.IsSequenceConflict(err) || retry >= maxSequenceConflictRetries {
+			return events, err
+		}
+	}
+}
+
 func AppendAndReduce(object AppendReducer, events ...eventstore.Event) error {
 	object.AppendEvents(events...)
 	return object.Reduce()
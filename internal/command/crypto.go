@@ -34,6 +34,26 @@ func newCryptoCodeWithExpiry(ctx context.Context, filter preparation.FilterToQue
 	}, nil
 }
 
+// newCryptoCodeWithDefault returns a [cryptoCodeFunc], which uses the defaultConfig
+// if the instance has no configuration for the generator type
+func newCryptoCodeWithDefault(defaultConfig *crypto.GeneratorConfig) cryptoCodeFunc {
+	return func(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.Crypto) (*CryptoCodeWithExpiry, error) {
+		gen, config, err := secretGeneratorWithDefault(ctx, filter, typ, alg, defaultConfig)
+		if err != nil {
+			return nil, err
+		}
+		crypted, plain, err := crypto.NewCode(gen)
+		if err != nil {
+			return nil, err
+		}
+		return &CryptoCodeWithExpiry{
+			Crypted: crypted,
+			Plain:   plain,
+			Expiry:  config.Expiry,
+		}, nil
+	}
+}
+
 func verifyCryptoCode(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.Crypto, creation time.Time, expiry time.Duration, crypted *crypto.CryptoValue, plain string) error {
 	gen, _, err := secretGenerator(ctx, filter, typ, alg)
 	if err != nil {
@@ -51,7 +71,11 @@ func newCryptoCodeWithPlain(ctx context.Context, filter preparation.FilterToQuer
 }
 
 func secretGenerator(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.Crypto) (crypto.Generator, *crypto.GeneratorConfig, error) {
-	config, err := secretGeneratorConfig(ctx, filter, typ)
+	return secretGeneratorWithDefault(ctx, filter, typ, alg, nil)
+}
+
+func secretGeneratorWithDefault(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.Crypto, defaultConfig *crypto.GeneratorConfig) (crypto.Generator, *crypto.GeneratorConfig, error) {
+	config, err := secretGeneratorConfigWithDefault(ctx, filter, typ, defaultConfig)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -66,6 +90,10 @@ func secretGenerator(ctx context.Context, filter preparation.FilterToQueryReduce
 }
 
 func secretGeneratorConfig(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType) (*crypto.GeneratorConfig, error) {
+	return secretGeneratorConfigWithDefault(ctx, filter, typ, nil)
+}
+
+func secretGeneratorConfigWithDefault(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, defaultConfig *crypto.GeneratorConfig) (*crypto.GeneratorConfig, error) {
 	wm := NewInstanceSecretGeneratorConfigWriteModel(ctx, typ)
 	events, err := filter(ctx, wm.Query())
 	if err != nil {
@@ -75,6 +103,9 @@ func secretGeneratorConfig(ctx context.Context, filter preparation.FilterToQuery
 	if err := wm.Reduce(); err != nil {
 		return nil, err
 	}
+	if wm.State != domain.SecretGeneratorStateActive && defaultConfig != nil {
+		return defaultConfig, nil
+	}
 	return &crypto.GeneratorConfig{
 		Length:              wm.Length,
 		Expiry:              wm.Expiry,
@@ -101,6 +101,51 @@ func Test_newCryptoCode(t *testing.T) {
 	}
 }
 
+func Test_newCryptoCodeWithDefault(t *testing.T) {
+	defaultConfig := &crypto.GeneratorConfig{
+		Length:              32,
+		Expiry:              10 * time.Minute,
+		IncludeLowerLetters: true,
+		IncludeDigits:       true,
+	}
+	tests := []struct {
+		name       string
+		eventstore *eventstore.Eventstore
+		wantExpiry time.Duration
+		wantErr    error
+	}{
+		{
+			name:       "filter config error",
+			eventstore: eventstoreExpect(t, expectFilterError(io.ErrClosedPipe)),
+			wantErr:    io.ErrClosedPipe,
+		},
+		{
+			name:       "default config",
+			eventstore: eventstoreExpect(t, expectFilter()),
+			wantExpiry: defaultConfig.Expiry,
+		},
+		{
+			name: "instance config",
+			eventstore: eventstoreExpect(t, expectFilter(
+				eventFromEventPusher(testSecretGeneratorAddedEvent(domain.SecretGeneratorTypeMagicLinkCode)),
+			)),
+			wantExpiry: testGeneratorConfig.Expiry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newCryptoCodeWithDefault(defaultConfig)(context.Background(), tt.eventstore.Filter, domain.SecretGeneratorTypeMagicLinkCode, crypto.CreateMockHashAlg(gomock.NewController(t)))
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.NotNil(t, got)
+				assert.NotNil(t, got.Crypted)
+				assert.NotEmpty(t, got.Plain)
+				assert.Equal(t, tt.wantExpiry, got.Expiry)
+			}
+		})
+	}
+}
+
 func Test_verifyCryptoCode(t *testing.T) {
 	es := eventstoreExpect(t, expectFilter(
 		eventFromEventPusher(testSecretGeneratorAddedEvent(domain.SecretGeneratorTypeVerifyEmailCode)),
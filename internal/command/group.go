@@ -0,0 +1,169 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+func (c *Commands) AddGroup(ctx context.Context, addGroup *domain.Group, resourceOwner string) (_ *domain.Group, err error) {
+	if !addGroup.IsValid() || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr2nf", "Errors.Group.Invalid")
+	}
+	addGroup.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	addedGroup := NewGroupWriteModel(addGroup.AggregateID, resourceOwner)
+	groupAgg := GroupAggregateFromWriteModel(&addedGroup.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGroupAddedEvent(
+		ctx,
+		groupAgg,
+		strings.TrimSpace(addGroup.Name),
+		addGroup.Description,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroup(addedGroup), nil
+}
+
+func (c *Commands) ChangeGroup(ctx context.Context, changeGroup *domain.Group, resourceOwner string) (*domain.Group, error) {
+	if !changeGroup.IsValid() || changeGroup.AggregateID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr3mg", "Errors.Group.Invalid")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, changeGroup.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	oldName := existingGroup.Name
+	changedEvent, err := group.NewGroupChangedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		oldName,
+		existingGroup.changes(strings.TrimSpace(changeGroup.Name), changeGroup.Description),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroup(existingGroup), nil
+}
+
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr4nh", "Errors.IDMissing")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGroupRemovedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		existingGroup.Name,
+		existingGroup.memberAndGrantUniqueConstraints(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) AddGroupMember(ctx context.Context, groupID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr5oi", "Errors.IDMissing")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingGroup.hasMember(userID) {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Gr6pj", "Errors.Group.Member.AlreadyExists")
+	}
+	err = c.checkUserExists(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewMemberAddedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		userID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) RemoveGroupMember(ctx context.Context, groupID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gr7qk", "Errors.IDMissing")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroup.hasMember(userID) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gr8rl", "Errors.Group.Member.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewMemberRemovedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		userID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) existingGroupWriteModel(ctx context.Context, groupID, resourceOwner string) (writeModel *GroupWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewGroupWriteModel(groupID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gr9sm", "Errors.Group.NotFound")
+	}
+	return writeModel, nil
+}
+
+func groupWriteModelToGroup(writeModel *GroupWriteModel) *domain.Group {
+	return &domain.Group{
+		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
+		Name:        writeModel.Name,
+		Description: writeModel.Description,
+	}
+}
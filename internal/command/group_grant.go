@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/group"
+)
+
+// AddGroupGrant grants roles of a project (grant) to the group, all members of the group inherit them
+func (c *Commands) AddGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (_ *domain.GroupGrant, err error) {
+	if !grant.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg2nf", "Errors.Group.Grant.Invalid")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, grant.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	err = c.checkGroupGrantPreCondition(ctx, grant, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	grant.GrantID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantAddedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		grant.GrantID,
+		grant.ProjectID,
+		grant.ProjectGrantID,
+		grant.RoleKeys,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroupGrant(existingGroup, grant.GrantID), nil
+}
+
+func (c *Commands) ChangeGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (_ *domain.GroupGrant, err error) {
+	if grant.GrantID == "" || !grant.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg3og", "Errors.Group.Grant.Invalid")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, grant.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existingGrant, ok := existingGroup.Grants[grant.GrantID]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg4ph", "Errors.Group.Grant.NotFound")
+	}
+	grant.ProjectID = existingGrant.ProjectID
+	grant.ProjectGrantID = existingGrant.ProjectGrantID
+	err = c.checkGroupGrantPreCondition(ctx, grant, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantChangedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		grant.GrantID,
+		grant.RoleKeys,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroupGrant(existingGroup, grant.GrantID), nil
+}
+
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, grantID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if groupID == "" || grantID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gg5qi", "Errors.IDMissing")
+	}
+	existingGroup, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existingGrant, ok := existingGroup.Grants[grantID]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gg6rj", "Errors.Group.Grant.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, group.NewGrantRemovedEvent(
+		ctx,
+		GroupAggregateFromWriteModel(&existingGroup.WriteModel),
+		grantID,
+		existingGrant.ProjectID,
+		existingGrant.ProjectGrantID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingGroup, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGroup.WriteModel), nil
+}
+
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) error {
+	preConditions := NewUserGrantPreConditionReadModel("", grant.ProjectID, grant.ProjectGrantID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, preConditions)
+	if err != nil {
+		return err
+	}
+	if grant.ProjectGrantID == "" && !preConditions.ProjectExists {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Gg7sk", "Errors.Project.NotFound")
+	}
+	if grant.ProjectGrantID != "" && !preConditions.ProjectGrantExists {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Gg8tl", "Errors.Project.Grant.NotFound")
+	}
+	if grant.HasInvalidRoles(preConditions.ExistingRoleKeys) {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Gg9um", "Errors.Project.Role.NotFound")
+	}
+	return nil
+}
+
+func groupWriteModelToGroupGrant(writeModel *GroupWriteModel, grantID string) *domain.GroupGrant {
+	grant := &domain.GroupGrant{
+		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
+		GrantID:    grantID,
+	}
+	if existing, ok := writeModel.Grants[grantID]; ok {
+		grant.ProjectID = existing.ProjectID
+		grant.ProjectGrantID = existing.ProjectGrantID
+		grant.RoleKeys = existing.RoleKeys
+	}
+	return grant
+}
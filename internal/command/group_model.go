@@ -0,0 +1,135 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	State       domain.GroupState
+	MemberIDs   []string
+	Grants      map[string]*GroupGrantWriteModel
+}
+
+type GroupGrantWriteModel struct {
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func NewGroupWriteModel(groupID, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   groupID,
+			ResourceOwner: resourceOwner,
+		},
+		Grants: make(map[string]*GroupGrantWriteModel),
+	}
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.GroupAddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.State = domain.GroupStateActive
+		case *group.GroupChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+		case *group.GroupRemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.MemberIDs = nil
+			wm.Grants = make(map[string]*GroupGrantWriteModel)
+		case *group.MemberAddedEvent:
+			wm.MemberIDs = append(wm.MemberIDs, e.UserID)
+		case *group.MemberRemovedEvent:
+			wm.MemberIDs = removeString(wm.MemberIDs, e.UserID)
+		case *group.GrantAddedEvent:
+			wm.Grants[e.GrantID] = &GroupGrantWriteModel{
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+			}
+		case *group.GrantChangedEvent:
+			if grant, ok := wm.Grants[e.GrantID]; ok {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *group.GrantRemovedEvent:
+			delete(wm.Grants, e.GrantID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			group.GroupAddedType,
+			group.GroupChangedType,
+			group.GroupRemovedType,
+			group.MemberAddedType,
+			group.MemberRemovedType,
+			group.GrantAddedType,
+			group.GrantChangedType,
+			group.GrantRemovedType).
+		Builder()
+}
+
+func (wm *GroupWriteModel) changes(name, description string) []group.GroupChanges {
+	changes := make([]group.GroupChanges, 0, 2)
+	if wm.Name != name {
+		changes = append(changes, group.ChangeName(name))
+	}
+	if wm.Description != description {
+		changes = append(changes, group.ChangeDescription(description))
+	}
+	return changes
+}
+
+func (wm *GroupWriteModel) hasMember(userID string) bool {
+	for _, memberID := range wm.MemberIDs {
+		if memberID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// memberAndGrantUniqueConstraints returns the unique constraints to release if the group is removed
+func (wm *GroupWriteModel) memberAndGrantUniqueConstraints() []*eventstore.EventUniqueConstraint {
+	constraints := make([]*eventstore.EventUniqueConstraint, 0, len(wm.MemberIDs)+len(wm.Grants))
+	for _, memberID := range wm.MemberIDs {
+		constraints = append(constraints, group.NewRemoveGroupMemberUniqueConstraint(wm.AggregateID, memberID))
+	}
+	for _, grant := range wm.Grants {
+		constraints = append(constraints, group.NewRemoveGroupGrantUniqueConstraint(wm.AggregateID, grant.ProjectID, grant.ProjectGrantID))
+	}
+	return constraints
+}
+
+func GroupAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, group.AggregateType, group.AggregateVersion)
+}
+
+func removeString(list []string, s string) []string {
+	for i, item := range list {
+		if item == s {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
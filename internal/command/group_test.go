@@ -0,0 +1,605 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestCommandSide_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		group         *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.Group
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid group, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				group:         &domain.Group{Name: " "},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add group, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(group.NewGroupAddedEvent(
+								context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"group",
+								"description",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupNameUniqueConstraint("group", "org1")),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "group1"),
+			},
+			args: args{
+				ctx: context.Background(),
+				group: &domain.Group{
+					Name:        "group",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					Name:        "group",
+					Description: "description",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.AddGroup(tt.args.ctx, tt.args.group, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		group         *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.Group
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "group not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				group: &domain.Group{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1"},
+					Name:       "group",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"description",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				group: &domain.Group{
+					ObjectRoot:  models.ObjectRoot{AggregateID: "group1"},
+					Name:        "group",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change name, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"description",
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(newGroupChangedEvent(
+								context.Background(),
+								"group1", "org1", "group",
+								group.ChangeName("group-new"),
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewRemoveGroupNameUniqueConstraint("group", "org1")),
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupNameUniqueConstraint("group-new", "org1")),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				group: &domain.Group{
+					ObjectRoot:  models.ObjectRoot{AggregateID: "group1"},
+					Name:        "group-new",
+					Description: "description",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					Name:        "group-new",
+					Description: "description",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeGroup(tt.args.ctx, tt.args.group, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroup(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing group id, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "remove group with member, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+						eventFromEventPusher(group.NewMemberAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"user1",
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(group.NewGroupRemovedEvent(
+								context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"group",
+								nil,
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewRemoveGroupNameUniqueConstraint("group", "org1")),
+						uniqueConstraintsFromEventConstraint(group.NewRemoveGroupMemberUniqueConstraint("group1", "user1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveGroup(tt.args.ctx, tt.args.groupID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "member already exists, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+						eventFromEventPusher(group.NewMemberAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"user1",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "user not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add member, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(group.NewMemberAddedEvent(
+								context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"user1",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewAddGroupMemberUniqueConstraint("group1", "user1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.AddGroupMember(tt.args.ctx, tt.args.groupID, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "member not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "remove member, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group",
+							"",
+						)),
+						eventFromEventPusher(group.NewMemberAddedEvent(
+							context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"user1",
+						)),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(group.NewMemberRemovedEvent(
+								context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"user1",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(group.NewRemoveGroupMemberUniqueConstraint("group1", "user1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveGroupMember(tt.args.ctx, tt.args.groupID, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newGroupChangedEvent(ctx context.Context, groupID, resourceOwner, oldName string, changes ...group.GroupChanges) *group.GroupChangedEvent {
+	event, _ := group.NewGroupChangedEvent(ctx,
+		&group.NewAggregate(groupID, resourceOwner).Aggregate,
+		oldName,
+		changes,
+	)
+	return event
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// LDAPSyncRun is the result of a single synchronisation of the users of an LDAP provider with its directory
+type LDAPSyncRun struct {
+	Created     int
+	Updated     int
+	Deactivated int
+	Errors      []string
+}
+
+// ReportLDAPSyncRun pushes the result of a directory synchronisation
+// to the instance or organisation the LDAP provider belongs to
+func (c *Commands) ReportLDAPSyncRun(ctx context.Context, idpID string, run *LDAPSyncRun) (*domain.ObjectDetails, error) {
+	if idpID == "" || run == nil {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Ls2ma", "Errors.IDMissing")
+	}
+	writeModel := NewIDPTypeWriteModel(idpID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.IDPStateActive {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Ls3nf", "Errors.IDPConfig.NotExisting")
+	}
+	if writeModel.Type != domain.IDPTypeLDAP {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-Ls4ty", "Errors.IDPConfig.NotLDAP")
+	}
+
+	var event eventstore.Command
+	if writeModel.ResourceOwner == writeModel.InstanceID {
+		event = instance.NewLDAPIDPSyncedEvent(ctx, &instance.NewAggregate(writeModel.InstanceID).Aggregate,
+			idpID, run.Created, run.Updated, run.Deactivated, run.Errors)
+	} else {
+		event = org.NewLDAPIDPSyncedEvent(ctx, &org.NewAggregate(writeModel.ResourceOwner).Aggregate,
+			idpID, run.Created, run.Updated, run.Deactivated, run.Errors)
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
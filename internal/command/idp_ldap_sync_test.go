@@ -0,0 +1,224 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errors "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ReportLDAPSyncRun(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		idpID string
+		run   *LDAPSyncRun
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				run: &LDAPSyncRun{},
+			},
+			res: res{
+				err: caos_errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				idpID: "idp1",
+				run:   &LDAPSyncRun{},
+			},
+			res: res{
+				err: caos_errors.IsNotFound,
+			},
+		},
+		{
+			name: "idp not ldap, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"name",
+								"clientID",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("clientSecret"),
+								},
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				idpID: "idp1",
+				run:   &LDAPSyncRun{},
+			},
+			res: res{
+				err: caos_errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "org idp, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLDAPIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"name",
+								[]string{"server"},
+								false,
+								"baseDN",
+								"dn",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+								"user",
+								[]string{"object"},
+								[]string{"filter"},
+								time.Second*30,
+								idp.LDAPAttributes{},
+								idp.Options{},
+							),
+						),
+					),
+					expectPush(
+						eventPusherToEvents(
+							org.NewLDAPIDPSyncedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								1,
+								2,
+								3,
+								[]string{"error"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				idpID: "idp1",
+				run: &LDAPSyncRun{
+					Created:     1,
+					Updated:     2,
+					Deactivated: 3,
+					Errors:      []string{"error"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+		{
+			name: "instance idp, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID("instance1",
+							instance.NewLDAPIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"idp1",
+								"name",
+								[]string{"server"},
+								false,
+								"baseDN",
+								"dn",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+								"user",
+								[]string{"object"},
+								[]string{"filter"},
+								time.Second*30,
+								idp.LDAPAttributes{},
+								idp.Options{},
+							),
+						),
+					),
+					expectPush(
+						eventPusherToEvents(
+							instance.NewLDAPIDPSyncedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"idp1",
+								1,
+								0,
+								0,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				idpID: "idp1",
+				run: &LDAPSyncRun{
+					Created: 1,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := c.ReportLDAPSyncRun(tt.args.ctx, tt.args.idpID, tt.args.run)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
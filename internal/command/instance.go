@@ -47,18 +47,22 @@ type InstanceSetup struct {
 		PhoneVerificationCode    *crypto.GeneratorConfig
 		PasswordVerificationCode *crypto.GeneratorConfig
 		PasswordlessInitCode     *crypto.GeneratorConfig
+		MagicLinkCode            *crypto.GeneratorConfig
 		DomainVerification       *crypto.GeneratorConfig
 	}
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength     uint64
+		HasLowercase  bool
+		HasUppercase  bool
+		HasNumber     bool
+		HasSymbol     bool
+		CheckBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
 		MaxAgeDays     uint64
+		HistoryCount   uint64
+		MinAgeDays     uint64
 	}
 	DomainPolicy struct {
 		UserLoginMustBeDomain                  bool
@@ -75,6 +79,7 @@ type InstanceSetup struct {
 		AllowDomainDiscovery       bool
 		DisableLoginWithEmail      bool
 		DisableLoginWithPhone      bool
+		AllowMagicLink             bool
 		PasswordlessType           domain.PasswordlessType
 		DefaultRedirectURI         string
 		PasswordCheckLifetime      time.Duration
@@ -87,10 +92,11 @@ type InstanceSetup struct {
 		PasswordChange bool
 	}
 	PrivacyPolicy struct {
-		TOSLink      string
-		PrivacyLink  string
-		HelpLink     string
-		SupportEmail domain.EmailAddress
+		TOSLink         string
+		PrivacyLink     string
+		HelpLink        string
+		SupportEmail    domain.EmailAddress
+		AllowSelfDelete bool
 	}
 	LabelPolicy struct {
 		PrimaryColor        string
@@ -107,6 +113,7 @@ type InstanceSetup struct {
 	}
 	LockoutPolicy struct {
 		MaxAttempts              uint64
+		MaxMFAAttempts           uint64
 		ShouldShowLockoutFailure bool
 	}
 	EmailTemplate     []byte
@@ -199,6 +206,7 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeVerifyPhoneCode, setup.SecretGenerators.PhoneVerificationCode),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypePasswordResetCode, setup.SecretGenerators.PasswordVerificationCode),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypePasswordlessInitCode, setup.SecretGenerators.PasswordlessInitCode),
+		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeMagicLinkCode, setup.SecretGenerators.MagicLinkCode),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeVerifyDomain, setup.SecretGenerators.DomainVerification),
 
 		prepareAddDefaultPasswordComplexityPolicy(
@@ -208,11 +216,14 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.CheckBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
 			setup.PasswordAgePolicy.ExpireWarnDays,
 			setup.PasswordAgePolicy.MaxAgeDays,
+			setup.PasswordAgePolicy.HistoryCount,
+			setup.PasswordAgePolicy.MinAgeDays,
 		),
 		prepareAddDefaultDomainPolicy(
 			instanceAgg,
@@ -231,6 +242,7 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 			setup.LoginPolicy.AllowDomainDiscovery,
 			setup.LoginPolicy.DisableLoginWithEmail,
 			setup.LoginPolicy.DisableLoginWithPhone,
+			setup.LoginPolicy.AllowMagicLink,
 			setup.LoginPolicy.PasswordlessType,
 			setup.LoginPolicy.DefaultRedirectURI,
 			setup.LoginPolicy.PasswordCheckLifetime,
@@ -243,9 +255,9 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
 		prepareAddMultiFactorToDefaultLoginPolicy(instanceAgg, domain.MultiFactorTypeU2FWithPIN),
 
-		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail),
+		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail, setup.PrivacyPolicy.AllowSelfDelete),
 		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange),
-		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxAttempts, setup.LockoutPolicy.MaxMFAAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
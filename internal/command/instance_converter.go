@@ -33,6 +33,7 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		HidePasswordReset:          wm.HidePasswordReset,
 		IgnoreUnknownUsernames:     wm.IgnoreUnknownUsernames,
 		AllowDomainDiscovery:       wm.AllowDomainDiscovery,
+		AllowMagicLink:             wm.AllowMagicLink,
 		ForceMFA:                   wm.ForceMFA,
 		PasswordlessType:           wm.PasswordlessType,
 		DefaultRedirectURI:         wm.DefaultRedirectURI,
@@ -41,6 +42,7 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		RiskRules:                  wm.RiskRules,
 	}
 }
 
@@ -99,17 +101,20 @@ func writeModelToPasswordAgePolicy(wm *PasswordAgePolicyWriteModel) *domain.Pass
 		ObjectRoot:     writeModelToObjectRoot(wm.WriteModel),
 		MaxAgeDays:     wm.MaxAgeDays,
 		ExpireWarnDays: wm.ExpireWarnDays,
+		HistoryCount:   wm.HistoryCount,
+		MinAgeDays:     wm.MinAgeDays,
 	}
 }
 
 func writeModelToPasswordComplexityPolicy(wm *PasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:    writeModelToObjectRoot(wm.WriteModel),
+		MinLength:     wm.MinLength,
+		HasLowercase:  wm.HasLowercase,
+		HasUppercase:  wm.HasUppercase,
+		HasNumber:     wm.HasNumber,
+		HasSymbol:     wm.HasSymbol,
+		CheckBreached: wm.CheckBreached,
 	}
 }
 
@@ -117,6 +122,7 @@ func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolic
 	return &domain.LockoutPolicy{
 		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
 		MaxPasswordAttempts: wm.MaxPasswordAttempts,
+		MaxMFAAttempts:      wm.MaxMFAAttempts,
 		ShowLockOutFailures: wm.ShowLockOutFailures,
 	}
 }
@@ -128,6 +134,7 @@ func writeModelToPrivacyPolicy(wm *PrivacyPolicyWriteModel) *domain.PrivacyPolic
 		PrivacyLink: wm.PrivacyLink,
 		HelpLink:    wm.HelpLink,
 		SupportEmail: wm.SupportEmail,
+		AllowSelfDelete: wm.AllowSelfDelete,
 	}
 }
 
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+// AddCustomRole defines a new member role of the instance,
+// which grants the provided subset of the permissions of the configured roles
+func (c *Commands) AddCustomRole(ctx context.Context, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	role.Role = strings.TrimSpace(role.Role)
+	if err := c.validateCustomRole(role); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, role.Role)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() {
+		return nil, errors.ThrowAlreadyExists(nil, "COMMAND-Hw3nf", "Errors.CustomRole.AlreadyExists")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewCustomRoleAddedEvent(ctx, instanceAgg, role.Role, role.DisplayName, role.Permissions))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeCustomRole replaces the display name and the permissions of an existing custom role.
+// Members with the role are granted the new permissions immediately.
+func (c *Commands) ChangeCustomRole(ctx context.Context, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	if err := c.validateCustomRole(role); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, role.Role)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Ud2nw", "Errors.CustomRole.NotFound")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&writeModel.WriteModel)
+	changedEvent, hasChanged, err := writeModel.NewChangedEvent(ctx, instanceAgg, role.DisplayName, role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-Ao2mf", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveCustomRole removes the custom role.
+// Existing memberships keep the role, but it no longer grants any permission.
+func (c *Commands) RemoveCustomRole(ctx context.Context, role string) (*domain.ObjectDetails, error) {
+	if role == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Qm2f0", "Errors.CustomRole.Invalid")
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Fn3sq", "Errors.CustomRole.NotFound")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewCustomRoleRemovedEvent(ctx, instanceAgg, role))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) validateCustomRole(role *domain.CustomRole) error {
+	if !role.HasValidPrefix() {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Zk2nf", "Errors.CustomRole.Invalid")
+	}
+	if role.Role == domain.RoleSelfManagementGlobal || isConfiguredRole(c.zitadelRoles, role.Role) {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Ps9dq", "Errors.CustomRole.NameReserved")
+	}
+	if len(role.Permissions) == 0 {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Vn2ka", "Errors.CustomRole.PermissionInvalid")
+	}
+	// only permissions of the same level are allowed, e.g. an ORG_ role must not grant iam.write
+	for _, permission := range role.Permissions {
+		if !isConfiguredPermission(c.zitadelRoles, role.Prefix(), permission) {
+			return errors.ThrowInvalidArgument(nil, "COMMAND-Lw3fx", "Errors.CustomRole.PermissionInvalid")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) getCustomRoleWriteModel(ctx context.Context, role string) (*InstanceCustomRoleWriteModel, error) {
+	writeModel := NewInstanceCustomRoleWriteModel(ctx, role)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// memberRoleMappings returns the roles which can be assigned to members.
+// The custom roles of the instance are only searched if some of the provided roles are not part of the configuration.
+func (c *Commands) memberRoleMappings(ctx context.Context, filter preparation.FilterToQueryReducer, roles []string) ([]authz.RoleMapping, error) {
+	if !hasUnconfiguredRoles(c.zitadelRoles, roles) {
+		return c.zitadelRoles, nil
+	}
+	writeModel := NewInstanceCustomRolesWriteModel(ctx)
+	events, err := filter(ctx, writeModel.Query())
+	if err != nil {
+		return nil, err
+	}
+	writeModel.AppendEvents(events...)
+	if err = writeModel.Reduce(); err != nil {
+		return nil, err
+	}
+	mappings := make([]authz.RoleMapping, 0, len(c.zitadelRoles)+len(writeModel.Roles))
+	mappings = append(mappings, c.zitadelRoles...)
+	return append(mappings, writeModel.roleMappings()...), nil
+}
+
+func hasUnconfiguredRoles(configuredRoles []authz.RoleMapping, roles []string) bool {
+	for _, role := range roles {
+		if !isConfiguredRole(configuredRoles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+func isConfiguredRole(configuredRoles []authz.RoleMapping, role string) bool {
+	for _, configured := range configuredRoles {
+		if configured.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// isConfiguredPermission checks if the permission is granted by a configured role of the level of the prefix
+func isConfiguredPermission(configuredRoles []authz.RoleMapping, prefix, permission string) bool {
+	for _, configured := range configuredRoles {
+		if !strings.HasPrefix(configured.Role, prefix+"_") {
+			continue
+		}
+		for _, configuredPermission := range configured.Permissions {
+			if configuredPermission == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
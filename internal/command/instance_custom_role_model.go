@@ -0,0 +1,163 @@
+package command
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceCustomRoleWriteModel struct {
+	eventstore.WriteModel
+
+	Role        string
+	DisplayName string
+	Permissions []string
+	State       domain.CustomRoleState
+}
+
+func NewInstanceCustomRoleWriteModel(ctx context.Context, role string) *InstanceCustomRoleWriteModel {
+	return &InstanceCustomRoleWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Role: role,
+	}
+}
+
+func (wm *InstanceCustomRoleWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.CustomRoleAddedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *instance.CustomRoleChangedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *instance.CustomRoleRemovedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *InstanceCustomRoleWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.CustomRoleAddedEvent:
+			wm.DisplayName = e.DisplayName
+			wm.Permissions = e.Permissions
+			wm.State = domain.CustomRoleStateActive
+		case *instance.CustomRoleChangedEvent:
+			if e.DisplayName != nil {
+				wm.DisplayName = *e.DisplayName
+			}
+			if e.Permissions != nil {
+				wm.Permissions = e.Permissions
+			}
+		case *instance.CustomRoleRemovedEvent:
+			wm.DisplayName = ""
+			wm.Permissions = nil
+			wm.State = domain.CustomRoleStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomRoleWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.CustomRoleAddedEventType,
+			instance.CustomRoleChangedEventType,
+			instance.CustomRoleRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceCustomRoleWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	displayName string,
+	permissions []string,
+) (*instance.CustomRoleChangedEvent, bool, error) {
+	changes := make([]instance.CustomRoleChanges, 0, 2)
+	if wm.DisplayName != displayName {
+		changes = append(changes, instance.ChangeCustomRoleDisplayName(displayName))
+	}
+	if !reflect.DeepEqual(wm.Permissions, permissions) {
+		changes = append(changes, instance.ChangeCustomRolePermissions(permissions))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewCustomRoleChangedEvent(ctx, aggregate, wm.Role, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+// InstanceCustomRolesWriteModel contains all active custom roles of the instance
+type InstanceCustomRolesWriteModel struct {
+	eventstore.WriteModel
+
+	Roles map[string][]string
+}
+
+func NewInstanceCustomRolesWriteModel(ctx context.Context) *InstanceCustomRolesWriteModel {
+	return &InstanceCustomRolesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Roles: make(map[string][]string),
+	}
+}
+
+func (wm *InstanceCustomRolesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.CustomRoleAddedEvent:
+			wm.Roles[e.Role] = e.Permissions
+		case *instance.CustomRoleChangedEvent:
+			if e.Permissions != nil {
+				wm.Roles[e.Role] = e.Permissions
+			}
+		case *instance.CustomRoleRemovedEvent:
+			delete(wm.Roles, e.Role)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomRolesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.CustomRoleAddedEventType,
+			instance.CustomRoleChangedEventType,
+			instance.CustomRoleRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceCustomRolesWriteModel) roleMappings() []authz.RoleMapping {
+	mappings := make([]authz.RoleMapping, 0, len(wm.Roles))
+	for role, permissions := range wm.Roles {
+		mappings = append(mappings, authz.RoleMapping{Role: role, Permissions: permissions})
+	}
+	return mappings
+}
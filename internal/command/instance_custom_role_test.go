@@ -0,0 +1,544 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+var testCustomRoleZitadelRoles = []authz.RoleMapping{
+	{
+		Role:        "ORG_OWNER",
+		Permissions: []string{"org.read", "org.write", "user.read", "user.write"},
+	},
+	{
+		Role:        "IAM_OWNER",
+		Permissions: []string{"iam.read", "iam.write"},
+	},
+}
+
+func TestCommandSide_AddCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid prefix, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "configured role, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_OWNER",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown permission, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read", "user.delete"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "permission of other level, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read", "iam.write"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role already exists, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewCustomRoleAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"ORG_HELPDESK",
+									"Helpdesk",
+									[]string{"user.read", "user.write"},
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("INSTANCE", instance.NewAddCustomRoleUniqueConstraint("ORG_HELPDESK")),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        " ORG_HELPDESK ",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: testCustomRoleZitadelRoles,
+			}
+			got, err := r.AddCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "role removed, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewCustomRoleRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change permissions, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								newCustomRoleChangedEvent(context.Background(),
+									"ORG_HELPDESK",
+									[]string{"user.read", "user.write"},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.CustomRole{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: testCustomRoleZitadelRoles,
+			}
+			got, err := r.ChangeCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty role, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: "ORG_HELPDESK",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewCustomRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewCustomRoleRemovedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"ORG_HELPDESK",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("INSTANCE", instance.NewRemoveCustomRoleUniqueConstraint("ORG_HELPDESK")),
+					),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: "ORG_HELPDESK",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveCustomRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_memberRoleMappings(t *testing.T) {
+	type args struct {
+		filter preparation.FilterToQueryReducer
+		roles  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []authz.RoleMapping
+		wantErr bool
+	}{
+		{
+			name: "configured roles only, no filter",
+			args: args{
+				filter: func(_ context.Context, _ *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+					t.Fatal("filter must not be called")
+					return nil, nil
+				},
+				roles: []string{"ORG_OWNER"},
+			},
+			want: testCustomRoleZitadelRoles,
+		},
+		{
+			name: "custom role",
+			args: args{
+				filter: NewMultiFilter().Append(
+					func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+						return []eventstore.Event{
+							instance.NewCustomRoleAddedEvent(ctx,
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+							instance.NewCustomRoleAddedEvent(ctx,
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_REMOVED",
+								"",
+								[]string{"org.read"},
+							),
+							instance.NewCustomRoleRemovedEvent(ctx,
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_REMOVED",
+							),
+						}, nil
+					}).Filter(),
+				roles: []string{"ORG_OWNER", "ORG_HELPDESK"},
+			},
+			want: append(testCustomRoleZitadelRoles[:len(testCustomRoleZitadelRoles):len(testCustomRoleZitadelRoles)],
+				authz.RoleMapping{
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				zitadelRoles: testCustomRoleZitadelRoles,
+			}
+			got, err := c.memberRoleMappings(authz.WithInstanceID(context.Background(), "INSTANCE"), tt.args.filter, tt.args.roles)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("memberRoleMappings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newCustomRoleChangedEvent(ctx context.Context, role string, permissions []string) *instance.CustomRoleChangedEvent {
+	event, _ := instance.NewCustomRoleChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		role,
+		[]instance.CustomRoleChanges{
+			instance.ChangeCustomRolePermissions(permissions),
+		},
+	)
+	return event
+}
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"context"
+	"net"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+// ClearLoginBlock resets the failed login attempts of an IP address or a user,
+// so the next login attempt is neither delayed nor requires a captcha
+func (c *Commands) ClearLoginBlock(ctx context.Context, keyType domain.LoginBlockKeyType, key string) (*domain.ObjectDetails, error) {
+	if !keyType.Valid() || key == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Blk1a", "Errors.Instance.LoginBlock.Invalid")
+	}
+	if keyType == domain.LoginBlockKeyTypeIP {
+		ip := net.ParseIP(key)
+		if ip == nil {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Blk2b", "Errors.Instance.LoginBlock.Invalid")
+		}
+		key = ip.String()
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewLoginBlockClearedEvent(ctx, &instanceAgg.Aggregate, keyType, key))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
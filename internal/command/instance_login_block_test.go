@@ -0,0 +1,142 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestCommandSide_ClearLoginBlock(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		keyType domain.LoginBlockKeyType
+		key     string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid key type, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				keyType: domain.LoginBlockKeyTypeUnspecified,
+				key:     "user1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid ip, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				keyType: domain.LoginBlockKeyTypeIP,
+				key:     "not an ip",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "clear ip, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewLoginBlockClearedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									domain.LoginBlockKeyTypeIP,
+									"2001:db8::1",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				keyType: domain.LoginBlockKeyTypeIP,
+				key:     "2001:0db8:0000::0001",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "clear user, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewLoginBlockClearedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									domain.LoginBlockKeyTypeUser,
+									"user1",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				keyType: domain.LoginBlockKeyTypeUser,
+				key:     "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ClearLoginBlock(tt.args.ctx, tt.args.keyType, tt.args.key)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -18,10 +18,14 @@ func (c *Commands) AddInstanceMemberCommand(a *instance.Aggregate, userID string
 		if userID == "" {
 			return nil, errors.ThrowInvalidArgument(nil, "INSTA-SDSfs", "Errors.Invalid.Argument")
 		}
-		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
-			return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
-		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+				validRoles, err := c.memberRoleMappings(ctx, filter, roles)
+				if err != nil {
+					return nil, err
+				}
+				if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, validRoles)) > 0 {
+					return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, errors.ThrowPreconditionFailed(err, "INSTA-GSXOn", "Errors.User.NotFound")
 				}
@@ -92,7 +96,11 @@ func (c *Commands) ChangeInstanceMember(ctx context.Context, member *domain.Memb
 	if !member.IsIAMValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-LiaZi", "Errors.IAM.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.IAMRolePrefix, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-3m9fs", "Errors.IAM.MemberInvalid")
 	}
 
@@ -60,7 +60,7 @@ func (wm *InstanceWriteModel) Reduce() error {
 			wm.DefaultLanguage = e.Language
 		}
 	}
-	return nil
+	return wm.WriteModel.Reduce()
 }
 
 func (wm *InstanceWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -81,6 +81,16 @@ func (wm *InstanceWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// SnapshotType implements [eventstore.SnapshotWriteModel]
+func (wm *InstanceWriteModel) SnapshotType() string {
+	return "instance"
+}
+
+// SnapshotVersion implements [eventstore.SnapshotWriteModel]
+func (wm *InstanceWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func InstanceAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, instance.AggregateType, instance.AggregateVersion)
 }
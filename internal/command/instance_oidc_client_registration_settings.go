@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func (c *Commands) SetOIDCClientRegistrationSettings(ctx context.Context, settings *domain.OIDCClientRegistrationSettings) (*domain.ObjectDetails, error) {
+	for _, grantType := range settings.AllowedGrantTypes {
+		if grantType < domain.OIDCGrantTypeAuthorizationCode || grantType > domain.OIDCGrantTypeDeviceCode {
+			return nil, errors.ThrowInvalidArgument(nil, "INST-Wq2lp", "Errors.Invalid.Argument")
+		}
+	}
+	for i, prefix := range settings.AllowedRedirectURIPrefixes {
+		if settings.AllowedRedirectURIPrefixes[i] = strings.TrimSpace(prefix); settings.AllowedRedirectURIPrefixes[i] == "" {
+			return nil, errors.ThrowInvalidArgument(nil, "INST-3nfSq", "Errors.Invalid.Argument")
+		}
+		// the redirect uris are matched against scheme, host and path of the prefix
+		if uri, err := url.Parse(settings.AllowedRedirectURIPrefixes[i]); err != nil || uri.Scheme == "" {
+			return nil, errors.ThrowInvalidArgument(err, "INST-p4Rwe", "Errors.Invalid.Argument")
+		}
+	}
+	writeModel, err := c.getOIDCClientRegistrationSettingsWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.hasChanged(settings.AllowedGrantTypes, settings.AllowedRedirectURIPrefixes) {
+		return nil, errors.ThrowPreconditionFailed(nil, "INST-8fGq2", "Errors.NoChangesFound")
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewOIDCClientRegistrationSettingsSetEvent(
+		ctx,
+		&instanceAgg.Aggregate,
+		settings.AllowedGrantTypes,
+		settings.AllowedRedirectURIPrefixes,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getOIDCClientRegistrationSettingsWriteModel(ctx context.Context) (*InstanceOIDCClientRegistrationSettingsWriteModel, error) {
+	writeModel := NewInstanceOIDCClientRegistrationSettingsWriteModel(ctx)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceOIDCClientRegistrationSettingsWriteModel struct {
+	eventstore.WriteModel
+
+	AllowedGrantTypes          []domain.OIDCGrantType
+	AllowedRedirectURIPrefixes []string
+}
+
+func NewInstanceOIDCClientRegistrationSettingsWriteModel(ctx context.Context) *InstanceOIDCClientRegistrationSettingsWriteModel {
+	return &InstanceOIDCClientRegistrationSettingsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+	}
+}
+
+func (wm *InstanceOIDCClientRegistrationSettingsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*instance.OIDCClientRegistrationSettingsSetEvent); ok {
+			wm.AllowedGrantTypes = e.AllowedGrantTypes
+			wm.AllowedRedirectURIPrefixes = e.AllowedRedirectURIPrefixes
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceOIDCClientRegistrationSettingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(instance.OIDCClientRegistrationSettingsSetEventType).
+		Builder()
+}
+
+func (wm *InstanceOIDCClientRegistrationSettingsWriteModel) hasChanged(allowedGrantTypes []domain.OIDCGrantType, allowedRedirectURIPrefixes []string) bool {
+	if len(wm.AllowedGrantTypes) != len(allowedGrantTypes) || len(wm.AllowedRedirectURIPrefixes) != len(allowedRedirectURIPrefixes) {
+		return true
+	}
+	if !domain.ContainsOIDCGrantTypes(allowedGrantTypes, wm.AllowedGrantTypes) {
+		return true
+	}
+	for i, prefix := range allowedRedirectURIPrefixes {
+		if wm.AllowedRedirectURIPrefixes[i] != prefix {
+			return true
+		}
+	}
+	return false
+}
+
+func (wm *InstanceOIDCClientRegistrationSettingsWriteModel) settings() *domain.OIDCClientRegistrationSettings {
+	return &domain.OIDCClientRegistrationSettings{
+		ObjectRoot:                 writeModelToObjectRoot(wm.WriteModel),
+		AllowedGrantTypes:          wm.AllowedGrantTypes,
+		AllowedRedirectURIPrefixes: wm.AllowedRedirectURIPrefixes,
+	}
+}
@@ -194,6 +194,33 @@ func (c *Commands) RemoveMultiFactorFromDefaultLoginPolicy(ctx context.Context,
 	return writeModelToObjectDetails(&multiFactorModel.WriteModel), nil
 }
 
+func (c *Commands) SetDefaultLoginPolicyRiskRules(ctx context.Context, rules *domain.RiskRules) (*domain.ObjectDetails, error) {
+	if !rules.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Rsk2f", "Errors.IAM.LoginPolicy.RiskRules.Invalid")
+	}
+	existingPolicy := NewInstanceLoginPolicyWriteModel(ctx)
+	err := c.defaultLoginPolicyWriteModelByID(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if !existingPolicy.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "INSTANCE-Rsk3g", "Errors.IAM.LoginPolicy.NotFound")
+	}
+	if existingPolicy.RiskRules == *rules {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Rsk4h", "Errors.IAM.LoginPolicy.NotChanged")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewLoginPolicyRiskRulesSetEvent(ctx, instanceAgg, rules))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
 func (c *Commands) defaultLoginPolicyWriteModelByID(ctx context.Context, writeModel *InstanceLoginPolicyWriteModel) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -239,6 +266,7 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.AllowMagicLink,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
@@ -265,6 +293,7 @@ func prepareAddDefaultLoginPolicy(
 	allowDomainDiscovery bool,
 	disableLoginWithEmail bool,
 	disableLoginWithPhone bool,
+	allowMagicLink bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime time.Duration,
@@ -298,6 +327,7 @@ func prepareAddDefaultLoginPolicy(
 					allowDomainDiscovery,
 					disableLoginWithEmail,
 					disableLoginWithPhone,
+					allowMagicLink,
 					passwordlessType,
 					defaultRedirectURI,
 					passwordCheckLifetime,
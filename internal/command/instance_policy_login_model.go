@@ -34,6 +34,8 @@ func (wm *InstanceLoginPolicyWriteModel) AppendEvents(events ...eventstore.Event
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyAddedEvent)
 		case *instance.LoginPolicyChangedEvent:
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyChangedEvent)
+		case *instance.LoginPolicyRiskRulesSetEvent:
+			wm.LoginPolicyWriteModel.AppendEvents(&e.RiskRulesSetEvent)
 		}
 	}
 }
@@ -54,7 +56,8 @@ func (wm *InstanceLoginPolicyWriteModel) Query() *eventstore.SearchQueryBuilder
 		AggregateIDs(wm.LoginPolicyWriteModel.AggregateID).
 		EventTypes(
 			instance.LoginPolicyAddedEventType,
-			instance.LoginPolicyChangedEventType).
+			instance.LoginPolicyChangedEventType,
+			instance.LoginPolicyRiskRulesSetEventType).
 		Builder()
 }
 
@@ -70,6 +73,7 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	allowDomainDiscovery,
 	disableLoginWithEmail,
 	disableLoginWithPhone bool,
+	allowMagicLink bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -128,6 +132,9 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.AllowMagicLink != allowMagicLink {
+		changes = append(changes, policy.ChangeAllowMagicLink(allowMagicLink))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
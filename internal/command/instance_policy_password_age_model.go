@@ -56,7 +56,9 @@ func (wm *InstancePasswordAgePolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	expireWarnDays,
-	maxAgeDays uint64) (*instance.PasswordAgePolicyChangedEvent, bool) {
+	maxAgeDays,
+	historyCount,
+	minAgeDays uint64) (*instance.PasswordAgePolicyChangedEvent, bool) {
 	changes := make([]policy.PasswordAgePolicyChanges, 0)
 	if wm.ExpireWarnDays != expireWarnDays {
 		changes = append(changes, policy.ChangeExpireWarnDays(expireWarnDays))
@@ -64,6 +66,12 @@ func (wm *InstancePasswordAgePolicyWriteModel) NewChangedEvent(
 	if wm.MaxAgeDays != maxAgeDays {
 		changes = append(changes, policy.ChangeMaxAgeDays(maxAgeDays))
 	}
+	if wm.HistoryCount != historyCount {
+		changes = append(changes, policy.ChangeHistoryCount(historyCount))
+	}
+	if wm.MinAgeDays != minAgeDays {
+		changes = append(changes, policy.ChangeMinAgeDays(minAgeDays))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
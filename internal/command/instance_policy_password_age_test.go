@@ -24,6 +24,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 		ctx            context.Context
 		maxAgeDays     uint64
 		expireWarnDays uint64
+		historyCount   uint64
+		minAgeDays     uint64
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -46,6 +48,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -74,6 +78,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 									&instance.NewAggregate("INSTANCE").Aggregate,
 									365,
 									10,
+									5,
+									1,
 								),
 							),
 						},
@@ -84,6 +90,8 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 				ctx:            authz.WithInstanceID(context.Background(), "INSTANCE"),
 				expireWarnDays: 365,
 				maxAgeDays:     10,
+				historyCount:   5,
+				minAgeDays:     1,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -97,7 +105,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays)
+			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays, tt.args.historyCount, tt.args.minAgeDays)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -159,6 +167,8 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -186,6 +196,8 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -23,6 +23,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 	type args struct {
 		ctx                 context.Context
 		maxPasswordAttempts uint64
+		maxMFAAttempts      uint64
 		showLockOutFailures bool
 	}
 	type res struct {
@@ -45,6 +46,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -73,6 +75,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 								instance.NewLockoutPolicyAddedEvent(context.Background(),
 									&instance.NewAggregate("INSTANCE").Aggregate,
 									10,
+									3,
 									true,
 								),
 							),
@@ -83,6 +86,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 			args: args{
 				ctx:                 authz.WithInstanceID(context.Background(), "INSTANCE"),
 				maxPasswordAttempts: 10,
+				maxMFAAttempts:      3,
 				showLockOutFailures: true,
 			},
 			res: res{
@@ -97,7 +101,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.showLockOutFailures)
+			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.maxMFAAttempts, tt.args.showLockOutFailures)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -158,6 +162,7 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -185,6 +190,7 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -192,7 +198,7 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
-								newDefaultLockoutPolicyChangedEvent(context.Background(), 20, false),
+								newDefaultLockoutPolicyChangedEvent(context.Background(), 20, 3, false),
 							),
 						},
 					),
@@ -202,6 +208,7 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 				ctx: context.Background(),
 				policy: &domain.LockoutPolicy{
 					MaxPasswordAttempts: 20,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: false,
 				},
 			},
@@ -212,6 +219,7 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 						ResourceOwner: "INSTANCE",
 					},
 					MaxPasswordAttempts: 20,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: false,
 				},
 			},
@@ -236,11 +244,12 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 	}
 }
 
-func newDefaultLockoutPolicyChangedEvent(ctx context.Context, maxAttempts uint64, showLockoutFailure bool) *instance.LockoutPolicyChangedEvent {
+func newDefaultLockoutPolicyChangedEvent(ctx context.Context, maxAttempts, maxMFAAttempts uint64, showLockoutFailure bool) *instance.LockoutPolicyChangedEvent {
 	event, _ := instance.NewLockoutPolicyChangedEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
 		[]policy.LockoutPolicyChanges{
 			policy.ChangeMaxAttempts(maxAttempts),
+			policy.ChangeMaxMFAAttempts(maxMFAAttempts),
 			policy.ChangeShowLockOutFailures(showLockoutFailure),
 		},
 	)
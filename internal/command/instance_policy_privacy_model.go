@@ -59,6 +59,7 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDelete bool,
 ) (*instance.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -74,6 +75,9 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.SupportEmail != supportEmail {
 		changes = append(changes, policy.ChangeSupportEmail(supportEmail))
 	}
+	if wm.AllowSelfDelete != allowSelfDelete {
+		changes = append(changes, policy.ChangeAllowSelfDelete(allowSelfDelete))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -22,11 +22,12 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx          context.Context
-		tosLink      string
-		privacyLink  string
-		helpLink     string
-		supportEmail domain.EmailAddress
+		ctx             context.Context
+		tosLink         string
+		privacyLink     string
+		helpLink        string
+		supportEmail    domain.EmailAddress
+		allowSelfDelete bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -51,6 +52,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -83,6 +85,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 									"PrivacyLink",
 									"HelpLink",
 									"support@example.com",
+									true,
 								),
 							),
 						},
@@ -90,11 +93,12 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 				),
 			},
 			args: args{
-				ctx:          authz.WithInstanceID(context.Background(), "INSTANCE"),
-				tosLink:      "TOSLink",
-				privacyLink:  "PrivacyLink",
-				helpLink:     "HelpLink",
-				supportEmail: "support@example.com",
+				ctx:             authz.WithInstanceID(context.Background(), "INSTANCE"),
+				tosLink:         "TOSLink",
+				privacyLink:     "PrivacyLink",
+				helpLink:        "HelpLink",
+				supportEmail:    "support@example.com",
+				allowSelfDelete: true,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -136,6 +140,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 									"",
 									"",
 									"",
+									false,
 								),
 							),
 						},
@@ -161,7 +166,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail)
+			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail, tt.args.allowSelfDelete)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -227,6 +232,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -278,6 +284,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -289,6 +296,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 									"PrivacyLinkChanged",
 									"HelpLinkChanged",
 									"support2@example.com",
+									true,
 								),
 							),
 						},
@@ -298,10 +306,11 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				policy: &domain.PrivacyPolicy{
-					TOSLink:      "TOSLinkChanged",
-					PrivacyLink:  "PrivacyLinkChanged",
-					HelpLink:     "HelpLinkChanged",
-					SupportEmail: "support2@example.com",
+					TOSLink:         "TOSLinkChanged",
+					PrivacyLink:     "PrivacyLinkChanged",
+					HelpLink:        "HelpLinkChanged",
+					SupportEmail:    "support2@example.com",
+					AllowSelfDelete: true,
 				},
 			},
 			res: res{
@@ -310,10 +319,11 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 						AggregateID:   "INSTANCE",
 						ResourceOwner: "INSTANCE",
 					},
-					TOSLink:      "TOSLinkChanged",
-					PrivacyLink:  "PrivacyLinkChanged",
-					HelpLink:     "HelpLinkChanged",
-					SupportEmail: "support2@example.com",
+					TOSLink:         "TOSLinkChanged",
+					PrivacyLink:     "PrivacyLinkChanged",
+					HelpLink:        "HelpLinkChanged",
+					SupportEmail:    "support2@example.com",
+					AllowSelfDelete: true,
 				},
 			},
 		},
@@ -337,7 +347,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 	}
 }
 
-func newDefaultPrivacyPolicyChangedEvent(ctx context.Context, tosLink, privacyLink, helpLink, supportEmail string) *instance.PrivacyPolicyChangedEvent {
+func newDefaultPrivacyPolicyChangedEvent(ctx context.Context, tosLink, privacyLink, helpLink, supportEmail string, allowSelfDelete bool) *instance.PrivacyPolicyChangedEvent {
 	event, _ := instance.NewPrivacyPolicyChangedEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
 		[]policy.PrivacyPolicyChanges{
@@ -345,6 +355,7 @@ func newDefaultPrivacyPolicyChangedEvent(ctx context.Context, tosLink, privacyLi
 			policy.ChangePrivacyLink(privacyLink),
 			policy.ChangeHelpLink(helpLink),
 			policy.ChangeSupportEmail(domain.EmailAddress(supportEmail)),
+			policy.ChangeAllowSelfDelete(allowSelfDelete),
 		},
 	)
 	return event
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/bruteforce"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// checkLoginThrottle rejects the check if the user or the IP address
+// have to wait for their delay after the last failed attempt.
+// If requireCaptcha is set and the user or the IP address reached the captcha threshold,
+// the captcha response of the context (see [bruteforce.WithCaptchaResponse]) must be valid.
+func (c *Commands) checkLoginThrottle(ctx context.Context, userID, remoteIP string, requireCaptcha bool) (err error) {
+	if c.loginAttempts == nil || !c.loginThrottle.Enabled() {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	now := time.Now()
+	since := c.loginThrottle.Since(now)
+	var captchaRequired bool
+	for _, attempt := range []struct {
+		keyType domain.LoginBlockKeyType
+		key     string
+	}{
+		{domain.LoginBlockKeyTypeUser, userID},
+		{domain.LoginBlockKeyTypeIP, remoteIP},
+	} {
+		if attempt.key == "" {
+			continue
+		}
+		failures, lastFailure, err := c.loginAttempts(ctx, attempt.keyType, attempt.key, since)
+		if err != nil {
+			return err
+		}
+		if now.Before(c.loginThrottle.BlockedUntil(failures, lastFailure)) {
+			return caos_errs.ThrowResourceExhausted(nil, "COMMAND-Thr1a", "Errors.Login.TooManyAttempts")
+		}
+		captchaRequired = captchaRequired || c.loginThrottle.CaptchaRequired(failures)
+	}
+	if !requireCaptcha || !captchaRequired {
+		return nil
+	}
+	return c.loginThrottle.Captcha.Verify(ctx, c.httpClient, bruteforce.CaptchaResponseFromCtx(ctx), remoteIP)
+}
+
+func authRequestRemoteIP(authRequest *domain.AuthRequest) string {
+	if authRequest == nil || authRequest.BrowserInfo == nil || authRequest.BrowserInfo.RemoteIP == nil {
+		return ""
+	}
+	return authRequest.BrowserInfo.RemoteIP.String()
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/bruteforce"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+func TestCommands_checkLoginThrottle(t *testing.T) {
+	siteverify := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"success": %t}`, r.FormValue("response") == "solved")
+	}))
+	defer siteverify.Close()
+	throttle := bruteforce.Config{
+		Threshold:        5,
+		BaseDelay:        time.Minute,
+		MaxDelay:         time.Hour,
+		Window:           time.Hour,
+		CaptchaThreshold: 3,
+		Captcha: bruteforce.CaptchaConfig{
+			Secret:    "secret",
+			VerifyURL: siteverify.URL,
+		},
+	}
+	failures := func(user, ip uint64) domain.LoginAttemptsProvider {
+		return func(_ context.Context, keyType domain.LoginBlockKeyType, _ string, _ time.Time) (uint64, time.Time, error) {
+			if keyType == domain.LoginBlockKeyTypeUser {
+				return user, time.Now(), nil
+			}
+			return ip, time.Now(), nil
+		}
+	}
+	type args struct {
+		ctx            context.Context
+		remoteIP       string
+		requireCaptcha bool
+	}
+	tests := []struct {
+		name          string
+		throttle      bruteforce.Config
+		loginAttempts domain.LoginAttemptsProvider
+		args          args
+		err           func(error) bool
+	}{
+		{
+			name:          "not configured",
+			loginAttempts: failures(10, 10),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4", requireCaptcha: true},
+		},
+		{
+			name:     "no provider",
+			throttle: throttle,
+			args:     args{ctx: context.Background(), remoteIP: "1.2.3.4", requireCaptcha: true},
+		},
+		{
+			name:          "below thresholds",
+			throttle:      throttle,
+			loginAttempts: failures(2, 2),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4", requireCaptcha: true},
+		},
+		{
+			name:          "user delayed",
+			throttle:      throttle,
+			loginAttempts: failures(5, 0),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4"},
+			err:           caos_errs.IsResourceExhausted,
+		},
+		{
+			name:          "ip delayed",
+			throttle:      throttle,
+			loginAttempts: failures(0, 5),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4"},
+			err:           caos_errs.IsResourceExhausted,
+		},
+		{
+			name:          "ip unknown",
+			throttle:      throttle,
+			loginAttempts: failures(0, 5),
+			args:          args{ctx: context.Background()},
+		},
+		{
+			name:          "captcha not required by check",
+			throttle:      throttle,
+			loginAttempts: failures(3, 0),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4"},
+		},
+		{
+			name:          "captcha missing",
+			throttle:      throttle,
+			loginAttempts: failures(3, 0),
+			args:          args{ctx: context.Background(), remoteIP: "1.2.3.4", requireCaptcha: true},
+			err:           caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			name:          "captcha invalid",
+			throttle:      throttle,
+			loginAttempts: failures(0, 3),
+			args:          args{ctx: bruteforce.WithCaptchaResponse(context.Background(), "wrong"), remoteIP: "1.2.3.4", requireCaptcha: true},
+			err:           caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			name:          "captcha solved",
+			throttle:      throttle,
+			loginAttempts: failures(3, 3),
+			args:          args{ctx: bruteforce.WithCaptchaResponse(context.Background(), "solved"), remoteIP: "1.2.3.4", requireCaptcha: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				loginThrottle: tt.throttle,
+				loginAttempts: tt.loginAttempts,
+			}
+			err := c.checkLoginThrottle(tt.args.ctx, "userID", tt.args.remoteIP, tt.args.requireCaptcha)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "unexpected error: %v", err)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	"github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	action_repo "github.com/zitadel/zitadel/internal/repository/action"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	iam_repo "github.com/zitadel/zitadel/internal/repository/instance"
 	key_repo "github.com/zitadel/zitadel/internal/repository/keypair"
@@ -43,6 +44,7 @@ func eventstoreExpect(t *testing.T, expects ...expect) *eventstore.Eventstore {
 	action_repo.RegisterEventMappers(es)
 	session.RegisterEventMappers(es)
 	idpintent.RegisterEventMappers(es)
+	group.RegisterEventMappers(es)
 	return es
 }
 
@@ -187,6 +189,19 @@ func eventFromEventPusherWithInstanceID(instanceID string, event eventstore.Comm
 	}
 }
 
+func eventFromEventPusherWithSequence(sequence uint64, event eventstore.Command) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.Sequence = sequence
+	return e
+}
+
+func eventFromEventPusherWithExpectedSequence(sequence uint64, eventTypes []repository.EventType, event eventstore.Command) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.ExpectedAggregateSequence = &sequence
+	e.ExpectedEventTypes = eventTypes
+	return e
+}
+
 func eventFromEventPusherWithCreationDateNow(event eventstore.Command) *repository.Event {
 	e := eventFromEventPusher(event)
 	e.CreationDate = time.Now()
@@ -260,6 +275,10 @@ func (m *mockInstance) SecurityPolicyAllowedOrigins() []string {
 	return nil
 }
 
+func (m *mockInstance) Shard() string {
+	return ""
+}
+
 func newMockPermissionCheckAllowed() domain.PermissionCheck {
 	return func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 		return nil
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// maxOrgHierarchyDepth bounds the number of ancestors an organisation can have
+const maxOrgHierarchyDepth = 32
+
+// SetOrgParent places the organisation below the parent organisation,
+// an empty parentOrgID moves it back to the top level of the instance
+func (c *Commands) SetOrgParent(ctx context.Context, orgID, parentOrgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "ORG-Hq2sd", "Errors.Org.Invalid")
+	}
+	if orgID == parentOrgID {
+		return nil, errors.ThrowPreconditionFailed(nil, "ORG-Hq3fe", "Errors.Org.HierarchyCycle")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, errors.ThrowNotFound(nil, "ORG-Hq4gh", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentOrgID == parentOrgID {
+		return nil, errors.ThrowPreconditionFailed(nil, "ORG-Hq5jk", "Errors.Org.NotChanged")
+	}
+	if parentOrgID != "" {
+		if err = c.checkOrgParent(ctx, orgID, parentOrgID); err != nil {
+			return nil, err
+		}
+	}
+	orgAgg := OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentChangedEvent(ctx, orgAgg, parentOrgID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(orgWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// checkOrgParent ensures the parent exists and the organisation is not one of its ancestors
+func (c *Commands) checkOrgParent(ctx context.Context, orgID, parentOrgID string) error {
+	ancestorID := parentOrgID
+	for depth := 0; ancestorID != ""; depth++ {
+		if depth >= maxOrgHierarchyDepth {
+			return errors.ThrowPreconditionFailed(nil, "ORG-Hq6lm", "Errors.Org.HierarchyTooDeep")
+		}
+		ancestor, err := c.getOrgWriteModelByID(ctx, ancestorID)
+		if err != nil {
+			return err
+		}
+		if !isOrgStateExists(ancestor.State) {
+			if ancestorID == parentOrgID {
+				return errors.ThrowPreconditionFailed(nil, "ORG-Hq7np", "Errors.Org.ParentNotFound")
+			}
+			return nil
+		}
+		if ancestor.ParentOrgID == orgID {
+			return errors.ThrowPreconditionFailed(nil, "ORG-Hq8qr", "Errors.Org.HierarchyCycle")
+		}
+		ancestorID = ancestor.ParentOrgID
+	}
+	return nil
+}
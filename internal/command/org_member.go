@@ -20,11 +20,14 @@ func (c *Commands) AddOrgMemberCommand(a *org.Aggregate, userID string, roles ..
 		if len(roles) == 0 {
 			return nil, errors.ThrowInvalidArgument(nil, "V2-PfYhb", "Errors.Invalid.Argument")
 		}
-
-		if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
-			return nil, errors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
-		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+				validRoles, err := c.memberRoleMappings(ctx, filter, roles)
+				if err != nil {
+					return nil, err
+				}
+				if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, validRoles)) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, validRoles)) > 0 {
+					return nil, errors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, errors.ThrowPreconditionFailed(err, "ORG-GoXOn", "Errors.User.NotFound")
 				}
@@ -95,10 +98,14 @@ func (c *Commands) addOrgMember(ctx context.Context, orgAgg *eventstore.Aggregat
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-W8m4l", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(member.Roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, validRoles)) > 0 && len(domain.CheckForInvalidRoles(member.Roles, domain.RoleSelfManagementGlobal, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 	}
-	err := c.eventstore.FilterToQueryReducer(ctx, addedMember)
+	err = c.eventstore.FilterToQueryReducer(ctx, addedMember)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +121,11 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *domain.Member) (
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-LiaZi", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "IAM-m9fG8", "Errors.Org.MemberInvalid")
 	}
 
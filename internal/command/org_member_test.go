@@ -58,14 +58,18 @@ func TestAddMember(t *testing.T) {
 			},
 		},
 		{
-			name: "TODO: invalid roles",
+			name: "invalid roles",
 			args: args{
 				a:      agg,
 				userID: "123",
 				roles:  []string{"ORG_OWNER"},
+				filter: NewMultiFilter().Append(
+					func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+						return nil, nil
+					}).Filter(),
 			},
 			want: Want{
-				ValidationErr: errors.ThrowInvalidArgument(nil, "Org-4N8es", ""),
+				CreateErr: errors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid"),
 			},
 		},
 		{
@@ -328,6 +332,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -578,6 +583,7 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -12,6 +12,7 @@ type OrgWriteModel struct {
 	Name          string
 	State         domain.OrgState
 	PrimaryDomain string
+	ParentOrgID   string
 }
 
 func NewOrgWriteModel(orgID string) *OrgWriteModel {
@@ -39,6 +40,8 @@ func (wm *OrgWriteModel) Reduce() error {
 			wm.Name = e.Name
 		case *org.DomainPrimarySetEvent:
 			wm.PrimaryDomain = e.Domain
+		case *org.OrgParentChangedEvent:
+			wm.ParentOrgID = e.ParentOrgID
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -56,10 +59,21 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDeactivatedEventType,
 			org.OrgReactivatedEventType,
 			org.OrgRemovedEventType,
-			org.OrgDomainPrimarySetEventType).
+			org.OrgDomainPrimarySetEventType,
+			org.OrgParentChangedEventType).
 		Builder()
 }
 
+// SnapshotType implements [eventstore.SnapshotWriteModel]
+func (wm *OrgWriteModel) SnapshotType() string {
+	return "org"
+}
+
+// SnapshotVersion implements [eventstore.SnapshotWriteModel]
+func (wm *OrgWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func OrgAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, org.AggregateType, org.AggregateVersion)
 }
@@ -62,6 +62,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -92,6 +93,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 								org.NewLockoutPolicyAddedEvent(context.Background(),
 									&org.NewAggregate("org1").Aggregate,
 									10,
+									3,
 									true,
 								),
 							),
@@ -104,6 +106,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 				orgID: "org1",
 				policy: &domain.LockoutPolicy{
 					MaxPasswordAttempts: 10,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: true,
 				},
 			},
@@ -114,6 +117,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 						ResourceOwner: "org1",
 					},
 					MaxPasswordAttempts: 10,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: true,
 				},
 			},
@@ -205,6 +209,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -233,6 +238,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -240,7 +246,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
-								newPasswordLockoutPolicyChangedEvent(context.Background(), "org1", 5, false),
+								newPasswordLockoutPolicyChangedEvent(context.Background(), "org1", 5, 3, false),
 							),
 						},
 					),
@@ -251,6 +257,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 				orgID: "org1",
 				policy: &domain.LockoutPolicy{
 					MaxPasswordAttempts: 5,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: false,
 				},
 			},
@@ -261,6 +268,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 						ResourceOwner: "org1",
 					},
 					MaxPasswordAttempts: 5,
+					MaxMFAAttempts:      3,
 					ShowLockOutFailures: false,
 				},
 			},
@@ -343,6 +351,7 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
 								true,
 							),
 						),
@@ -384,11 +393,12 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 	}
 }
 
-func newPasswordLockoutPolicyChangedEvent(ctx context.Context, orgID string, maxAttempts uint64, showLockoutFailure bool) *org.LockoutPolicyChangedEvent {
+func newPasswordLockoutPolicyChangedEvent(ctx context.Context, orgID string, maxAttempts, maxMFAAttempts uint64, showLockoutFailure bool) *org.LockoutPolicyChangedEvent {
 	event, _ := org.NewLockoutPolicyChangedEvent(ctx,
 		&org.NewAggregate(orgID).Aggregate,
 		[]policy.LockoutPolicyChanges{
 			policy.ChangeMaxAttempts(maxAttempts),
+			policy.ChangeMaxMFAAttempts(maxMFAAttempts),
 			policy.ChangeShowLockOutFailures(showLockoutFailure),
 		},
 	)
@@ -35,6 +35,7 @@ type AddLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 }
 
 type AddLoginPolicyIDP struct {
@@ -59,6 +60,7 @@ type ChangeLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 }
 
 func (c *Commands) AddLoginPolicy(ctx context.Context, resourceOwner string, policy *AddLoginPolicy) (*domain.ObjectDetails, error) {
@@ -131,6 +133,35 @@ func (c *Commands) RemoveLoginPolicy(ctx context.Context, orgID string) (*domain
 	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
 }
 
+func (c *Commands) SetLoginPolicyRiskRules(ctx context.Context, orgID string, rules *domain.RiskRules) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Rsk2f", "Errors.ResourceOwnerMissing")
+	}
+	if !rules.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Rsk3g", "Errors.Org.LoginPolicy.RiskRules.Invalid")
+	}
+	existingPolicy, err := c.orgLoginPolicyWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingPolicy.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "Org-Rsk4h", "Errors.Org.LoginPolicy.NotFound")
+	}
+	if existingPolicy.RiskRules == *rules {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Rsk5j", "Errors.Org.LoginPolicy.NotChanged")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewLoginPolicyRiskRulesSetEvent(ctx, orgAgg, rules))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
+
 func (c *Commands) AddIDPToLoginPolicy(ctx context.Context, resourceOwner string, idpProvider *domain.IDPProvider) (*domain.IDPProvider, error) {
 	if resourceOwner == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-M0fs9", "Errors.ResourceOwnerMissing")
@@ -430,6 +461,7 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.AllowMagicLink,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
@@ -475,6 +507,7 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.AllowMagicLink,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
@@ -33,6 +33,8 @@ func (wm *OrgLoginPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyAddedEvent)
 		case *org.LoginPolicyChangedEvent:
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyChangedEvent)
+		case *org.LoginPolicyRiskRulesSetEvent:
+			wm.LoginPolicyWriteModel.AppendEvents(&e.RiskRulesSetEvent)
 		case *org.LoginPolicyRemovedEvent:
 			wm.LoginPolicyWriteModel.AppendEvents(&e.LoginPolicyRemovedEvent)
 		}
@@ -56,6 +58,7 @@ func (wm *OrgLoginPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.LoginPolicyAddedEventType,
 			org.LoginPolicyChangedEventType,
+			org.LoginPolicyRiskRulesSetEventType,
 			org.LoginPolicyRemovedEventType).
 		Builder()
 }
@@ -72,6 +75,7 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	allowDomainDiscovery,
 	disableLoginWithEmail,
 	disableLoginWithPhone bool,
+	allowMagicLink bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -130,6 +134,9 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.AllowMagicLink != allowMagicLink {
+		changes = append(changes, policy.ChangeAllowMagicLink(allowMagicLink))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -58,7 +58,9 @@ func (wm *OrgPasswordAgePolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	expireWarnDays,
-	maxAgeDays uint64) (*org.PasswordAgePolicyChangedEvent, bool) {
+	maxAgeDays,
+	historyCount,
+	minAgeDays uint64) (*org.PasswordAgePolicyChangedEvent, bool) {
 	changes := make([]policy.PasswordAgePolicyChanges, 0)
 	if wm.ExpireWarnDays != expireWarnDays {
 		changes = append(changes, policy.ChangeExpireWarnDays(expireWarnDays))
@@ -66,6 +68,12 @@ func (wm *OrgPasswordAgePolicyWriteModel) NewChangedEvent(
 	if wm.MaxAgeDays != maxAgeDays {
 		changes = append(changes, policy.ChangeMaxAgeDays(maxAgeDays))
 	}
+	if wm.HistoryCount != historyCount {
+		changes = append(changes, policy.ChangeHistoryCount(historyCount))
+	}
+	if wm.MinAgeDays != minAgeDays {
+		changes = append(changes, policy.ChangeMinAgeDays(minAgeDays))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
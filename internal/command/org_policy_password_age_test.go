@@ -63,6 +63,8 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								365,
 								10,
+								0,
+								0,
 							),
 						),
 					),
@@ -93,6 +95,8 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 									&org.NewAggregate("org1").Aggregate,
 									10,
 									365,
+									0,
+									0,
 								),
 							),
 						},
@@ -206,6 +210,8 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -234,6 +240,8 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -344,6 +352,8 @@ func TestCommandSide_RemovePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
+								0,
 							),
 						),
 					),
@@ -60,6 +60,7 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDelete bool,
 ) (*org.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -75,6 +76,9 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.SupportEmail != supportEmail {
 		changes = append(changes, policy.ChangeSupportEmail(supportEmail))
 	}
+	if wm.AllowSelfDelete != allowSelfDelete {
+		changes = append(changes, policy.ChangeAllowSelfDelete(allowSelfDelete))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
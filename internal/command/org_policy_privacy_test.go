@@ -67,6 +67,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -101,6 +102,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 									"PrivacyLink",
 									"HelpLink",
 									"support@example.com",
+									false,
 								),
 							),
 						},
@@ -166,6 +168,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 									"",
 									"",
 									"",
+									false,
 								),
 							),
 						},
@@ -289,6 +292,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -337,13 +341,14 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
-								newPrivacyPolicyChangedEvent(context.Background(), "org1", "TOSLinkChange", "PrivacyLinkChange", "HelpLinkChange", "support2@example.com"),
+								newPrivacyPolicyChangedEvent(context.Background(), "org1", "TOSLinkChange", "PrivacyLinkChange", "HelpLinkChange", "support2@example.com", policy.ChangeAllowSelfDelete(true)),
 							),
 						},
 					),
@@ -353,10 +358,11 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 				ctx:   context.Background(),
 				orgID: "org1",
 				policy: &domain.PrivacyPolicy{
-					TOSLink:      "TOSLinkChange",
-					PrivacyLink:  "PrivacyLinkChange",
-					HelpLink:     "HelpLinkChange",
-					SupportEmail: "support2@example.com",
+					TOSLink:         "TOSLinkChange",
+					PrivacyLink:     "PrivacyLinkChange",
+					HelpLink:        "HelpLinkChange",
+					SupportEmail:    "support2@example.com",
+					AllowSelfDelete: true,
 				},
 			},
 			res: res{
@@ -365,10 +371,11 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 						AggregateID:   "org1",
 						ResourceOwner: "org1",
 					},
-					TOSLink:      "TOSLinkChange",
-					PrivacyLink:  "PrivacyLinkChange",
-					HelpLink:     "HelpLinkChange",
-					SupportEmail: "support2@example.com",
+					TOSLink:         "TOSLinkChange",
+					PrivacyLink:     "PrivacyLinkChange",
+					HelpLink:        "HelpLinkChange",
+					SupportEmail:    "support2@example.com",
+					AllowSelfDelete: true,
 				},
 			},
 		},
@@ -385,6 +392,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -501,6 +509,7 @@ func TestCommandSide_RemovePrivacyPolicy(t *testing.T) {
 								"PrivacyLink",
 								"HelpLink",
 								"support@example.com",
+								false,
 							),
 						),
 					),
@@ -544,15 +553,15 @@ func TestCommandSide_RemovePrivacyPolicy(t *testing.T) {
 	}
 }
 
-func newPrivacyPolicyChangedEvent(ctx context.Context, orgID string, tosLink, privacyLink, helpLink, supportEmail string) *org.PrivacyPolicyChangedEvent {
+func newPrivacyPolicyChangedEvent(ctx context.Context, orgID string, tosLink, privacyLink, helpLink, supportEmail string, additionalChanges ...policy.PrivacyPolicyChanges) *org.PrivacyPolicyChangedEvent {
 	event, _ := org.NewPrivacyPolicyChangedEvent(ctx,
 		&org.NewAggregate(orgID).Aggregate,
-		[]policy.PrivacyPolicyChanges{
+		append([]policy.PrivacyPolicyChanges{
 			policy.ChangeTOSLink(tosLink),
 			policy.ChangePrivacyLink(privacyLink),
 			policy.ChangeHelpLink(helpLink),
 			policy.ChangeSupportEmail(domain.EmailAddress(supportEmail)),
-		},
+		}, additionalChanges...),
 	)
 	return event
 }
@@ -20,6 +20,7 @@ type LoginPolicyWriteModel struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 	PasswordlessType           domain.PasswordlessType
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      time.Duration
@@ -27,6 +28,7 @@ type LoginPolicyWriteModel struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	RiskRules                  domain.RiskRules
 	State                      domain.PolicyState
 }
 
@@ -44,6 +46,7 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.AllowDomainDiscovery = e.AllowDomainDiscovery
 			wm.DisableLoginWithEmail = e.DisableLoginWithEmail
 			wm.DisableLoginWithPhone = e.DisableLoginWithPhone
+			wm.AllowMagicLink = e.AllowMagicLink
 			wm.DefaultRedirectURI = e.DefaultRedirectURI
 			wm.PasswordCheckLifetime = e.PasswordCheckLifetime
 			wm.ExternalLoginCheckLifetime = e.ExternalLoginCheckLifetime
@@ -100,8 +103,22 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.DisableLoginWithPhone != nil {
 				wm.DisableLoginWithPhone = *e.DisableLoginWithPhone
 			}
+			if e.AllowMagicLink != nil {
+				wm.AllowMagicLink = *e.AllowMagicLink
+			}
+		case *policy.RiskRulesSetEvent:
+			wm.RiskRules = domain.RiskRules{
+				Enabled:               e.Enabled,
+				NewDevice:             e.NewDevice,
+				NewNetwork:            e.NewNetwork,
+				NewCountry:            e.NewCountry,
+				ImpossibleTravelSpeed: e.ImpossibleTravelSpeed,
+				MaxRecentFailures:     e.MaxRecentFailures,
+				RecentFailuresWindow:  e.RecentFailuresWindow,
+			}
 		case *policy.LoginPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
+			wm.RiskRules = domain.RiskRules{}
 		}
 	}
 	return wm.WriteModel.Reduce()
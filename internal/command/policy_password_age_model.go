@@ -11,6 +11,8 @@ type PasswordAgePolicyWriteModel struct {
 
 	ExpireWarnDays uint64
 	MaxAgeDays     uint64
+	HistoryCount   uint64
+	MinAgeDays     uint64
 	State          domain.PolicyState
 }
 
@@ -20,6 +22,8 @@ func (wm *PasswordAgePolicyWriteModel) Reduce() error {
 		case *policy.PasswordAgePolicyAddedEvent:
 			wm.ExpireWarnDays = e.ExpireWarnDays
 			wm.MaxAgeDays = e.MaxAgeDays
+			wm.HistoryCount = e.HistoryCount
+			wm.MinAgeDays = e.MinAgeDays
 			wm.State = domain.PolicyStateActive
 		case *policy.PasswordAgePolicyChangedEvent:
 			if e.ExpireWarnDays != nil {
@@ -28,6 +32,12 @@ func (wm *PasswordAgePolicyWriteModel) Reduce() error {
 			if e.MaxAgeDays != nil {
 				wm.MaxAgeDays = *e.MaxAgeDays
 			}
+			if e.HistoryCount != nil {
+				wm.HistoryCount = *e.HistoryCount
+			}
+			if e.MinAgeDays != nil {
+				wm.MinAgeDays = *e.MinAgeDays
+			}
 		case *policy.PasswordAgePolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
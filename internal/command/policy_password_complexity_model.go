@@ -19,12 +19,13 @@ var (
 type PasswordComplexityPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	MinLength    uint64
-	HasLowercase bool
-	HasUppercase bool
-	HasNumber    bool
-	HasSymbol    bool
-	State        domain.PolicyState
+	MinLength     uint64
+	HasLowercase  bool
+	HasUppercase  bool
+	HasNumber     bool
+	HasSymbol     bool
+	CheckBreached bool
+	State         domain.PolicyState
 }
 
 func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
@@ -36,6 +37,7 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			wm.HasUppercase = e.HasUppercase
 			wm.HasNumber = e.HasNumber
 			wm.HasSymbol = e.HasSymbol
+			wm.CheckBreached = e.CheckBreached
 			wm.State = domain.PolicyStateActive
 		case *policy.PasswordComplexityPolicyChangedEvent:
 			if e.MinLength != nil {
@@ -53,6 +55,9 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			if e.HasSymbol != nil {
 				wm.HasSymbol = *e.HasSymbol
 			}
+			if e.CheckBreached != nil {
+				wm.CheckBreached = *e.CheckBreached
+			}
 		case *policy.PasswordComplexityPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -9,11 +9,12 @@ import (
 type PrivacyPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	TOSLink      string
-	PrivacyLink  string
-	HelpLink     string
-	SupportEmail domain.EmailAddress
-	State        domain.PolicyState
+	TOSLink         string
+	PrivacyLink     string
+	HelpLink        string
+	SupportEmail    domain.EmailAddress
+	AllowSelfDelete bool
+	State           domain.PolicyState
 }
 
 func (wm *PrivacyPolicyWriteModel) Reduce() error {
@@ -24,6 +25,7 @@ func (wm *PrivacyPolicyWriteModel) Reduce() error {
 			wm.PrivacyLink = e.PrivacyLink
 			wm.HelpLink = e.HelpLink
 			wm.SupportEmail = e.SupportEmail
+			wm.AllowSelfDelete = e.AllowSelfDelete
 			wm.State = domain.PolicyStateActive
 		case *policy.PrivacyPolicyChangedEvent:
 			if e.PrivacyLink != nil {
@@ -38,6 +40,9 @@ func (wm *PrivacyPolicyWriteModel) Reduce() error {
 			if e.SupportEmail != nil {
 				wm.SupportEmail = *e.SupportEmail
 			}
+			if e.AllowSelfDelete != nil {
+				wm.AllowSelfDelete = *e.AllowSelfDelete
+			}
 		case *policy.PrivacyPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -174,30 +174,33 @@ func (c *Commands) ChangeProject(ctx context.Context, projectChange *domain.Proj
 		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-4m9vS", "Errors.Project.Invalid")
 	}
 
-	existingProject, err := c.getProjectWriteModelByID(ctx, projectChange.AggregateID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
-		return nil, errors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.Project.NotFound")
-	}
+	var existingProject *ProjectWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingProject, err = c.getProjectWriteModelByID(ctx, projectChange.AggregateID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
+			return nil, errors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.Project.NotFound")
+		}
 
-	projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel)
-	changedEvent, hasChanged, err := existingProject.NewChangedEvent(
-		ctx,
-		projectAgg,
-		projectChange.Name,
-		projectChange.ProjectRoleAssertion,
-		projectChange.ProjectRoleCheck,
-		projectChange.HasProjectCheck,
-		projectChange.PrivateLabelingSetting)
-	if err != nil {
-		return nil, err
-	}
-	if !hasChanged {
-		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-2M0fs", "Errors.NoChangesFound")
-	}
-	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+		projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel).ExpectWriteModel(existingProject)
+		changedEvent, hasChanged, err := existingProject.NewChangedEvent(
+			ctx,
+			projectAgg,
+			projectChange.Name,
+			projectChange.ProjectRoleAssertion,
+			projectChange.ProjectRoleCheck,
+			projectChange.HasProjectCheck,
+			projectChange.PrivateLabelingSetting)
+		if err != nil {
+			return nil, err
+		}
+		if !hasChanged {
+			return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-2M0fs", "Errors.NoChangesFound")
+		}
+		return []eventstore.Command{changedEvent}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
 )
 
@@ -25,20 +26,23 @@ func (c *Commands) ChangeApplication(ctx context.Context, projectID string, appC
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-4m9vS", "Errors.Project.App.Invalid")
 	}
 
-	existingApp, err := c.getApplicationWriteModel(ctx, projectID, appChange.GetAppID(), resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingApp.State == domain.AppStateUnspecified || existingApp.State == domain.AppStateRemoved {
-		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-28di9", "Errors.Project.App.NotExisting")
-	}
-	if existingApp.Name == appChange.GetApplicationName() {
-		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-2m8vx", "Errors.NoChangesFound")
-	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel)
-	pushedEvents, err := c.eventstore.Push(
-		ctx,
-		project.NewApplicationChangedEvent(ctx, projectAgg, appChange.GetAppID(), existingApp.Name, appChange.GetApplicationName()))
+	var existingApp *ApplicationWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingApp, err = c.getApplicationWriteModel(ctx, projectID, appChange.GetAppID(), resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingApp.State == domain.AppStateUnspecified || existingApp.State == domain.AppStateRemoved {
+			return nil, caos_errs.ThrowNotFound(nil, "COMMAND-28di9", "Errors.Project.App.NotExisting")
+		}
+		if existingApp.Name == appChange.GetApplicationName() {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-2m8vx", "Errors.NoChangesFound")
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingApp.WriteModel).ExpectWriteModel(existingApp)
+		return []eventstore.Command{
+			project.NewApplicationChangedEvent(ctx, projectAgg, appChange.GetAppID(), existingApp.Name, appChange.GetApplicationName()),
+		}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -152,30 +152,32 @@ func (c *Commands) ChangeAPIApplication(ctx context.Context, apiApp *domain.APIA
 		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-1m900", "Errors.Project.App.APIConfigInvalid")
 	}
 
-	existingAPI, err := c.getAPIAppWriteModel(ctx, apiApp.AggregateID, apiApp.AppID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingAPI.State == domain.AppStateUnspecified || existingAPI.State == domain.AppStateRemoved {
-		return nil, errors.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
-	}
-	if !existingAPI.IsAPI() {
-		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Gnwt3", "Errors.Project.App.IsNotAPI")
-	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingAPI.WriteModel)
-	changedEvent, hasChanged, err := existingAPI.NewChangedEvent(
-		ctx,
-		projectAgg,
-		apiApp.AppID,
-		apiApp.AuthMethodType)
-	if err != nil {
-		return nil, err
-	}
-	if !hasChanged {
-		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
-	}
-
-	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	var existingAPI *APIApplicationWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingAPI, err = c.getAPIAppWriteModel(ctx, apiApp.AggregateID, apiApp.AppID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingAPI.State == domain.AppStateUnspecified || existingAPI.State == domain.AppStateRemoved {
+			return nil, errors.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
+		}
+		if !existingAPI.IsAPI() {
+			return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Gnwt3", "Errors.Project.App.IsNotAPI")
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingAPI.WriteModel).ExpectWriteModel(existingAPI)
+		changedEvent, hasChanged, err := existingAPI.NewChangedEvent(
+			ctx,
+			projectAgg,
+			apiApp.AppID,
+			apiApp.AuthMethodType)
+		if err != nil {
+			return nil, err
+		}
+		if !hasChanged {
+			return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
+		}
+		return []eventstore.Command{changedEvent}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -525,7 +525,7 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app",
 							),
 						),
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewAPIConfigAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"app1",
@@ -541,7 +541,7 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, apiApplicationEventTypes,
 								newAPIAppChangedEvent(context.Background(),
 									"app1",
 									"project1",
@@ -767,3 +767,16 @@ func newAPIAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 	)
 	return event
 }
+
+// apiApplicationEventTypes are the event types queried by the APIApplicationWriteModel
+var apiApplicationEventTypes = []repository.EventType{
+	repository.EventType(project.ApplicationAddedType),
+	repository.EventType(project.ApplicationChangedType),
+	repository.EventType(project.ApplicationDeactivatedType),
+	repository.EventType(project.ApplicationReactivatedType),
+	repository.EventType(project.ApplicationRemovedType),
+	repository.EventType(project.APIConfigAddedType),
+	repository.EventType(project.APIConfigChangedType),
+	repository.EventType(project.APIConfigSecretChangedType),
+	repository.EventType(project.ProjectRemovedType),
+}
@@ -156,8 +156,17 @@ func (c *Commands) AddOIDCApplication(ctx context.Context, oidcApp *domain.OIDCA
 }
 
 func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain.OIDCApp, resourceOwner string, project *domain.Project, appID string, appSecretGenerator crypto.Generator) (_ *domain.OIDCApp, err error) {
-
 	addedApplication := NewOIDCApplicationWriteModel(oidcApp.AggregateID, resourceOwner)
+	events, stringPw, err := c.addOIDCApplicationEvents(ctx, addedApplication, oidcApp, project, appID, appSecretGenerator)
+	if err != nil {
+		return nil, err
+	}
+	return c.pushAddedOIDCApplication(ctx, addedApplication, stringPw, events...)
+}
+
+// addOIDCApplicationEvents creates the events of the new application
+// and returns the generated client secret in plain text
+func (c *Commands) addOIDCApplicationEvents(ctx context.Context, addedApplication *OIDCApplicationWriteModel, oidcApp *domain.OIDCApp, project *domain.Project, appID string, appSecretGenerator crypto.Generator) (_ []eventstore.Command, stringPw string, err error) {
 	projectAgg := ProjectAggregateFromWriteModel(&addedApplication.WriteModel)
 
 	oidcApp.AppID = appID
@@ -166,14 +175,13 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		project_repo.NewApplicationAddedEvent(ctx, projectAgg, oidcApp.AppID, oidcApp.AppName),
 	}
 
-	var stringPw string
 	err = domain.SetNewClientID(oidcApp, c.idGenerator, project)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	stringPw, err = domain.SetNewClientSecretIfNeeded(oidcApp, appSecretGenerator)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	events = append(events, project_repo.NewOIDCConfigAddedEvent(ctx,
 		projectAgg,
@@ -198,6 +206,10 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 	))
 
 	addedApplication.AppID = oidcApp.AppID
+	return events, stringPw, nil
+}
+
+func (c *Commands) pushAddedOIDCApplication(ctx context.Context, addedApplication *OIDCApplicationWriteModel, stringPw string, events ...eventstore.Command) (*domain.OIDCApp, error) {
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
 		return nil, err
@@ -217,45 +229,47 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-5m9fs", "Errors.Project.App.OIDCConfigInvalid")
 	}
 
-	existingOIDC, err := c.getOIDCAppWriteModel(ctx, oidc.AggregateID, oidc.AppID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingOIDC.State == domain.AppStateUnspecified || existingOIDC.State == domain.AppStateRemoved {
-		return nil, errors.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
-	}
-	if !existingOIDC.IsOIDC() {
-		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-GBr34", "Errors.Project.App.IsNotOIDC")
-	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
-	changedEvent, hasChanged, err := existingOIDC.NewChangedEvent(
-		ctx,
-		projectAgg,
-		oidc.AppID,
-		oidc.RedirectUris,
-		oidc.PostLogoutRedirectUris,
-		oidc.ResponseTypes,
-		oidc.GrantTypes,
-		oidc.ApplicationType,
-		oidc.AuthMethodType,
-		oidc.OIDCVersion,
-		oidc.AccessTokenType,
-		oidc.DevMode,
-		oidc.AccessTokenRoleAssertion,
-		oidc.IDTokenRoleAssertion,
-		oidc.IDTokenUserinfoAssertion,
-		oidc.ClockSkew,
-		oidc.AdditionalOrigins,
-		oidc.SkipNativeAppSuccessPage,
-	)
-	if err != nil {
-		return nil, err
-	}
-	if !hasChanged {
-		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
-	}
-
-	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	var existingOIDC *OIDCApplicationWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingOIDC, err = c.getOIDCAppWriteModel(ctx, oidc.AggregateID, oidc.AppID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingOIDC.State == domain.AppStateUnspecified || existingOIDC.State == domain.AppStateRemoved {
+			return nil, errors.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
+		}
+		if !existingOIDC.IsOIDC() {
+			return nil, errors.ThrowInvalidArgument(nil, "COMMAND-GBr34", "Errors.Project.App.IsNotOIDC")
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel).ExpectWriteModel(existingOIDC)
+		changedEvent, hasChanged, err := existingOIDC.NewChangedEvent(
+			ctx,
+			projectAgg,
+			oidc.AppID,
+			oidc.RedirectUris,
+			oidc.PostLogoutRedirectUris,
+			oidc.ResponseTypes,
+			oidc.GrantTypes,
+			oidc.ApplicationType,
+			oidc.AuthMethodType,
+			oidc.OIDCVersion,
+			oidc.AccessTokenType,
+			oidc.DevMode,
+			oidc.AccessTokenRoleAssertion,
+			oidc.IDTokenRoleAssertion,
+			oidc.IDTokenUserinfoAssertion,
+			oidc.ClockSkew,
+			oidc.AdditionalOrigins,
+			oidc.SkipNativeAppSuccessPage,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !hasChanged {
+			return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
+		}
+		return []eventstore.Command{changedEvent}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
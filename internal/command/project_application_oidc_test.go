@@ -613,7 +613,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"app",
 							),
 						),
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewOIDCConfigAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								domain.OIDCVersionV1,
@@ -644,7 +644,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, oidcApplicationEventTypes,
 								newOIDCAppChangedEvent(context.Background(),
 									"app1",
 									"project1",
@@ -934,3 +934,16 @@ func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 	)
 	return event
 }
+
+// oidcApplicationEventTypes are the event types queried by the OIDCApplicationWriteModel
+var oidcApplicationEventTypes = []repository.EventType{
+	repository.EventType(project.ApplicationAddedType),
+	repository.EventType(project.ApplicationChangedType),
+	repository.EventType(project.ApplicationDeactivatedType),
+	repository.EventType(project.ApplicationReactivatedType),
+	repository.EventType(project.ApplicationRemovedType),
+	repository.EventType(project.OIDCConfigAddedType),
+	repository.EventType(project.OIDCConfigChangedType),
+	repository.EventType(project.OIDCConfigSecretChangedType),
+	repository.EventType(project.ProjectRemovedType),
+}
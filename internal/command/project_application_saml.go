@@ -85,46 +85,48 @@ func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SA
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-5n9fs", "Errors.Project.App.SAMLConfigInvalid")
 	}
 
-	existingSAML, err := c.getSAMLAppWriteModel(ctx, samlApp.AggregateID, samlApp.AppID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingSAML.State == domain.AppStateUnspecified || existingSAML.State == domain.AppStateRemoved {
-		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
-	}
-	if !existingSAML.IsSAML() {
-		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-GBr35", "Errors.Project.App.IsNotSAML")
-	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingSAML.WriteModel)
-
-	if samlApp.MetadataURL != "" {
-		data, err := xml.ReadMetadataFromURL(c.httpClient, samlApp.MetadataURL)
+	var existingSAML *SAMLApplicationWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingSAML, err = c.getSAMLAppWriteModel(ctx, samlApp.AggregateID, samlApp.AppID, resourceOwner)
 		if err != nil {
-			return nil, caos_errs.ThrowInvalidArgument(err, "SAML-J3kg3", "Errors.Project.App.SAMLMetadataMissing")
+			return nil, err
+		}
+		if existingSAML.State == domain.AppStateUnspecified || existingSAML.State == domain.AppStateRemoved {
+			return nil, caos_errs.ThrowNotFound(nil, "COMMAND-2n8uU", "Errors.Project.App.NotExisting")
+		}
+		if !existingSAML.IsSAML() {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-GBr35", "Errors.Project.App.IsNotSAML")
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingSAML.WriteModel).ExpectWriteModel(existingSAML)
+
+		if samlApp.MetadataURL != "" {
+			data, err := xml.ReadMetadataFromURL(c.httpClient, samlApp.MetadataURL)
+			if err != nil {
+				return nil, caos_errs.ThrowInvalidArgument(err, "SAML-J3kg3", "Errors.Project.App.SAMLMetadataMissing")
+			}
+			samlApp.Metadata = data
 		}
-		samlApp.Metadata = data
-	}
-
-	entity, err := xml.ParseMetadataXmlIntoStruct(samlApp.Metadata)
-	if err != nil {
-		return nil, caos_errs.ThrowInvalidArgument(err, "SAML-3fk2b", "Errors.Project.App.SAMLMetadataFormat")
-	}
 
-	changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
-		ctx,
-		projectAgg,
-		samlApp.AppID,
-		string(entity.EntityID),
-		samlApp.Metadata,
-		samlApp.MetadataURL)
-	if err != nil {
-		return nil, err
-	}
-	if !hasChanged {
-		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
-	}
+		entity, err := xml.ParseMetadataXmlIntoStruct(samlApp.Metadata)
+		if err != nil {
+			return nil, caos_errs.ThrowInvalidArgument(err, "SAML-3fk2b", "Errors.Project.App.SAMLMetadataFormat")
+		}
 
-	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+		changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
+			ctx,
+			projectAgg,
+			samlApp.AppID,
+			string(entity.EntityID),
+			samlApp.Metadata,
+			samlApp.MetadataURL)
+		if err != nil {
+			return nil, err
+		}
+		if !hasChanged {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
+		}
+		return []eventstore.Command{changedEvent}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
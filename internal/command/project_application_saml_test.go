@@ -573,7 +573,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"app",
 							),
 						),
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewSAMLConfigAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"app1",
@@ -585,7 +585,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, samlApplicationEventTypes,
 								newSAMLAppChangedEventMetadataURL(context.Background(),
 									"app1",
 									"project1",
@@ -645,7 +645,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"app",
 							),
 						),
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewSAMLConfigAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"app1",
@@ -657,7 +657,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, samlApplicationEventTypes,
 								newSAMLAppChangedEventMetadata(context.Background(),
 									"app1",
 									"project1",
@@ -774,3 +774,15 @@ func newTestClient(httpStatus int, metadata []byte) *http.Client {
 		Transport: fn,
 	}
 }
+
+// samlApplicationEventTypes are the event types queried by the SAMLApplicationWriteModel
+var samlApplicationEventTypes = []repository.EventType{
+	repository.EventType(project.ApplicationAddedType),
+	repository.EventType(project.ApplicationChangedType),
+	repository.EventType(project.ApplicationDeactivatedType),
+	repository.EventType(project.ApplicationReactivatedType),
+	repository.EventType(project.ApplicationRemovedType),
+	repository.EventType(project.SAMLConfigAddedType),
+	repository.EventType(project.SAMLConfigChangedType),
+	repository.EventType(project.ProjectRemovedType),
+}
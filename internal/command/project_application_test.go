@@ -146,7 +146,7 @@ func TestCommandSide_ChangeApplication(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+						eventFromEventPusherWithSequence(1, project.NewApplicationAddedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"app1",
 							"app",
@@ -154,7 +154,7 @@ func TestCommandSide_ChangeApplication(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(project.NewApplicationChangedEvent(context.Background(),
+							eventFromEventPusherWithExpectedSequence(1, applicationEventTypes, project.NewApplicationChangedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"app1",
 								"app",
@@ -691,3 +691,13 @@ func TestCommandSide_RemoveApplication(t *testing.T) {
 		})
 	}
 }
+
+// applicationEventTypes are the event types queried by the ApplicationWriteModel
+var applicationEventTypes = []repository.EventType{
+	repository.EventType(project.ApplicationAddedType),
+	repository.EventType(project.ApplicationChangedType),
+	repository.EventType(project.ApplicationDeactivatedType),
+	repository.EventType(project.ApplicationReactivatedType),
+	repository.EventType(project.ApplicationRemovedType),
+	repository.EventType(project.ProjectRemovedType),
+}
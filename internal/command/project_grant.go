@@ -64,46 +64,41 @@ func (c *Commands) ChangeProjectGrant(ctx context.Context, grant *domain.Project
 	if grant.GrantID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-1j83s", "Errors.IDMissing")
 	}
-	existingGrant, err := c.projectGrantWriteModelByID(ctx, grant.GrantID, grant.AggregateID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	grant.GrantedOrgID = existingGrant.GrantedOrgID
-	err = c.checkProjectGrantPreCondition(ctx, grant)
-	if err != nil {
-		return nil, err
-	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingGrant.WriteModel)
-
-	if reflect.DeepEqual(existingGrant.RoleKeys, grant.RoleKeys) {
-		return nil, caos_errs.ThrowPreconditionFailed(nil, "PROJECT-0o0pL", "Errors.NoChangesFoundc")
-	}
-
-	events := []eventstore.Command{
-		project.NewGrantChangedEvent(ctx, projectAgg, grant.GrantID, grant.RoleKeys),
-	}
-
-	removedRoles := domain.GetRemovedRoles(existingGrant.RoleKeys, grant.RoleKeys)
-	if len(removedRoles) == 0 {
-		pushedEvents, err := c.eventstore.Push(ctx, events...)
+	var existingGrant *ProjectGrantWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingGrant, err = c.projectGrantWriteModelByID(ctx, grant.GrantID, grant.AggregateID, resourceOwner)
 		if err != nil {
 			return nil, err
 		}
-		err = AppendAndReduce(existingGrant, pushedEvents...)
+		grant.GrantedOrgID = existingGrant.GrantedOrgID
+		err = c.checkProjectGrantPreCondition(ctx, grant)
 		if err != nil {
 			return nil, err
 		}
-		return projectGrantWriteModelToProjectGrant(existingGrant), nil
-	}
+		projectAgg := ProjectAggregateFromWriteModel(&existingGrant.WriteModel).ExpectWriteModel(existingGrant)
 
-	for _, userGrantID := range cascadeUserGrantIDs {
-		event, err := c.removeRoleFromUserGrant(ctx, userGrantID, removedRoles, true)
-		if err != nil {
-			continue
+		if reflect.DeepEqual(existingGrant.RoleKeys, grant.RoleKeys) {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "PROJECT-0o0pL", "Errors.NoChangesFoundc")
 		}
-		events = append(events, event)
-	}
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
+
+		events := []eventstore.Command{
+			project.NewGrantChangedEvent(ctx, projectAgg, grant.GrantID, grant.RoleKeys),
+		}
+
+		removedRoles := domain.GetRemovedRoles(existingGrant.RoleKeys, grant.RoleKeys)
+		if len(removedRoles) == 0 {
+			return events, nil
+		}
+
+		for _, userGrantID := range cascadeUserGrantIDs {
+			event, err := c.removeRoleFromUserGrant(ctx, userGrantID, removedRoles, true)
+			if err != nil {
+				continue
+			}
+			events = append(events, event)
+		}
+		return events, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -15,10 +15,14 @@ func (c *Commands) AddProjectGrantMember(ctx context.Context, member *domain.Pro
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-8fi7G", "Errors.Project.Grant.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-m9gKK", "Errors.Project.Grant.Member.Invalid")
 	}
-	err := c.checkUserExists(ctx, member.UserID, "")
+	err = c.checkUserExists(ctx, member.UserID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +54,11 @@ func (c *Commands) ChangeProjectGrantMember(ctx context.Context, member *domain.
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-109fs", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-m0sDf", "Errors.Project.Member.Invalid")
 	}
 
@@ -474,7 +474,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(project.NewGrantAddedEvent(context.Background(),
+						eventFromEventPusherWithSequence(1, project.NewGrantAddedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"projectgrant1",
 							"grantedorg1",
@@ -514,7 +514,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(project.NewGrantChangedEvent(context.Background(),
+							eventFromEventPusherWithExpectedSequence(1, projectGrantEventTypes, project.NewGrantChangedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectgrant1",
 								[]string{"key1", "key2"},
@@ -554,7 +554,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(project.NewGrantAddedEvent(context.Background(),
+						eventFromEventPusherWithSequence(1, project.NewGrantAddedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"projectgrant1",
 							"grantedorg1",
@@ -595,7 +595,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 					expectFilter(),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(project.NewGrantChangedEvent(context.Background(),
+							eventFromEventPusherWithExpectedSequence(1, projectGrantEventTypes, project.NewGrantChangedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectgrant1",
 								[]string{"key1"},
@@ -636,7 +636,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(project.NewGrantAddedEvent(context.Background(),
+						eventFromEventPusherWithSequence(1, project.NewGrantAddedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"projectgrant1",
 							"grantedorg1",
@@ -686,7 +686,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(project.NewGrantChangedEvent(context.Background(),
+							eventFromEventPusherWithExpectedSequence(1, projectGrantEventTypes, project.NewGrantChangedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectgrant1",
 								[]string{"key1"},
@@ -1415,3 +1415,14 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 		})
 	}
 }
+
+// projectGrantEventTypes are the event types queried by the ProjectGrantWriteModel
+var projectGrantEventTypes = []repository.EventType{
+	repository.EventType(project.GrantAddedType),
+	repository.EventType(project.GrantChangedType),
+	repository.EventType(project.GrantCascadeChangedType),
+	repository.EventType(project.GrantDeactivatedType),
+	repository.EventType(project.GrantReactivatedType),
+	repository.EventType(project.GrantRemovedType),
+	repository.EventType(project.ProjectRemovedType),
+}
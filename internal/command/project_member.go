@@ -35,11 +35,15 @@ func (c *Commands) addProjectMember(ctx context.Context, projectAgg *eventstore.
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-W8m4l", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, validRoles)) > 0 {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
 
-	err := c.checkUserExists(ctx, addedMember.UserID, "")
+	err = c.checkUserExists(ctx, addedMember.UserID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -59,20 +63,27 @@ func (c *Commands) ChangeProjectMember(ctx context.Context, member *domain.Membe
 	if !member.IsValid() {
 		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-LiaZi", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
-		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
-	}
-
-	existingMember, err := c.projectMemberWriteModelByID(ctx, member.AggregateID, member.UserID, resourceOwner)
+	validRoles, err := c.memberRoleMappings(ctx, c.eventstore.Filter, member.Roles)
 	if err != nil {
 		return nil, err
 	}
-
-	if reflect.DeepEqual(existingMember.Roles, member.Roles) {
-		return nil, errors.ThrowPreconditionFailed(nil, "PROJECT-LiaZi", "Errors.Project.Member.RolesNotChanged")
+	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, validRoles)) > 0 {
+		return nil, errors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
 	}
-	projectAgg := ProjectAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel)
-	pushedEvents, err := c.eventstore.Push(ctx, project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...))
+
+	var existingMember *ProjectMemberWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingMember, err = c.projectMemberWriteModelByID(ctx, member.AggregateID, member.UserID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+
+		if reflect.DeepEqual(existingMember.Roles, member.Roles) {
+			return nil, errors.ThrowPreconditionFailed(nil, "PROJECT-LiaZi", "Errors.Project.Member.RolesNotChanged")
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel).ExpectWriteModel(existingMember)
+		return []eventstore.Command{project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...)}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
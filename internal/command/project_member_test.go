@@ -62,6 +62,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -339,6 +340,7 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -426,7 +428,7 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
@@ -436,7 +438,7 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(project.NewProjectMemberChangedEvent(context.Background(),
+							eventFromEventPusherWithExpectedSequence(1, projectMemberEventTypes, project.NewProjectMemberChangedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
 								[]string{"PROJECT_OWNER", "PROJECT_VIEWER"}...,
@@ -475,6 +477,133 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "member changed concurrently, retries exceeded, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(1, projectMemberEventTypes, project.NewProjectMemberChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER", "PROJECT_VIEWER"}...,
+							)),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+						eventFromEventPusherWithSequence(2,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user2",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(2, projectMemberEventTypes, project.NewProjectMemberChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER", "PROJECT_VIEWER"}...,
+							)),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+						eventFromEventPusherWithSequence(3,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user3",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(3, projectMemberEventTypes, project.NewProjectMemberChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER", "PROJECT_VIEWER"}...,
+							)),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+						eventFromEventPusherWithSequence(4,
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user4",
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(4, projectMemberEventTypes, project.NewProjectMemberChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								[]string{"PROJECT_OWNER", "PROJECT_VIEWER"}...,
+							)),
+						},
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleProjectOwner,
+					},
+					{
+						Role: "PROJECT_VIEWER",
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				member: &domain.Member{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					UserID: "user1",
+					Roles:  []string{"PROJECT_OWNER", "PROJECT_VIEWER"},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: eventstore.IsSequenceConflict,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -624,3 +753,11 @@ func TestCommandSide_RemoveProjectMember(t *testing.T) {
 		})
 	}
 }
+
+// projectMemberEventTypes are the event types queried by the ProjectMemberWriteModel
+var projectMemberEventTypes = []repository.EventType{
+	repository.EventType(project.MemberAddedType),
+	repository.EventType(project.MemberChangedType),
+	repository.EventType(project.MemberRemovedType),
+	repository.EventType(project.MemberCascadeRemovedType),
+}
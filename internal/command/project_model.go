@@ -85,6 +85,16 @@ func (wm *ProjectWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// SnapshotType implements [eventstore.SnapshotWriteModel]
+func (wm *ProjectWriteModel) SnapshotType() string {
+	return "project"
+}
+
+// SnapshotVersion implements [eventstore.SnapshotWriteModel]
+func (wm *ProjectWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func (wm *ProjectWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
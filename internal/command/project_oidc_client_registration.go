@@ -0,0 +1,291 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	oidcInitialAccessTokenPrefix      = "iat"
+	oidcRegistrationAccessTokenPrefix = "rat"
+)
+
+// AddOIDCInitialAccessToken creates a new initial access token (RFC 7591) for the project.
+// The returned token is only available once and is required to register clients
+// through the dynamic client registration endpoint.
+func (c *Commands) AddOIDCInitialAccessToken(ctx context.Context, token *domain.OIDCInitialAccessToken, resourceOwner string) (_ *domain.OIDCInitialAccessToken, err error) {
+	if token.AggregateID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Gd2fq", "Errors.Project.ProjectIDMissing")
+	}
+	token.ExpirationDate, err = domain.ValidateExpirationDate(token.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	projectWriteModel, err := c.getProjectWriteModelByID(ctx, token.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if projectWriteModel.State == domain.ProjectStateUnspecified || projectWriteModel.State == domain.ProjectStateRemoved {
+		return nil, errors.ThrowPreconditionFailed(nil, "COMMAND-3n9fS", "Errors.Project.NotFound")
+	}
+	token.TokenID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	token.Token, err = c.createOIDCClientRegistrationToken(oidcInitialAccessTokenPrefix, token.AggregateID, token.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewOIDCInitialAccessTokenWriteModel(token.AggregateID, token.TokenID, projectWriteModel.ResourceOwner)
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewOIDCInitialAccessTokenAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		token.TokenID,
+		token.ExpirationDate,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	token.ObjectRoot = writeModelToObjectRoot(writeModel.WriteModel)
+	return token, nil
+}
+
+func (c *Commands) RemoveOIDCInitialAccessToken(ctx context.Context, projectID, tokenID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || tokenID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-0Fjw2", "Errors.IDMissing")
+	}
+	writeModel := NewOIDCInitialAccessTokenWriteModel(projectID, tokenID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Sf3g1", "Errors.OIDCClientRegistration.InitialAccessToken.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, project.NewOIDCInitialAccessTokenRemovedEvent(ctx, ProjectAggregateFromWriteModel(&writeModel.WriteModel), tokenID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RegisterOIDCClient registers a new oidc application (RFC 7591) on the project the initialAccessToken is bound to.
+// Besides the application it returns the registration access token (RFC 7592) needed to read, update and delete the client.
+func (c *Commands) RegisterOIDCClient(ctx context.Context, initialAccessToken string, oidcApp *domain.OIDCApp, appSecretGenerator crypto.Generator) (_ *domain.OIDCApp, registrationAccessToken string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ids, err := c.verifyOIDCClientRegistrationToken(oidcInitialAccessTokenPrefix, initialAccessToken, 2)
+	if err != nil {
+		return nil, "", err
+	}
+	tokenWriteModel := NewOIDCInitialAccessTokenWriteModel(ids[0], ids[1], "")
+	err = c.eventstore.FilterToQueryReducer(ctx, tokenWriteModel)
+	if err != nil {
+		return nil, "", err
+	}
+	if !tokenWriteModel.isValid() {
+		return nil, "", errors.ThrowPermissionDenied(nil, "COMMAND-Hr3g2", "Errors.OIDCClientRegistration.InitialAccessToken.Invalid")
+	}
+	if err = c.checkOIDCClientRegistrationAllowed(ctx, oidcApp); err != nil {
+		return nil, "", err
+	}
+	oidcApp.AggregateID = tokenWriteModel.AggregateID
+	project, err := c.getProjectByID(ctx, oidcApp.AggregateID, tokenWriteModel.ResourceOwner)
+	if err != nil {
+		return nil, "", errors.ThrowPreconditionFailed(err, "COMMAND-Rk2fa", "Errors.Project.NotFound")
+	}
+	if oidcApp.AppName == "" || !oidcApp.IsValid() {
+		return nil, "", errors.ThrowInvalidArgument(nil, "COMMAND-Yf3bq", "Errors.Project.App.Invalid")
+	}
+	appID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, "", err
+	}
+	addedApplication := NewOIDCApplicationWriteModel(oidcApp.AggregateID, tokenWriteModel.ResourceOwner)
+	events, stringPw, err := c.addOIDCApplicationEvents(ctx, addedApplication, oidcApp, project, appID, appSecretGenerator)
+	if err != nil {
+		return nil, "", err
+	}
+	// the client and its registration access token are pushed together,
+	// so a registered client can always be managed by the client
+	tokenEvent, registrationAccessToken, err := c.newOIDCRegistrationAccessTokenEvent(ctx, ProjectAggregateFromWriteModel(&addedApplication.WriteModel), appID)
+	if err != nil {
+		return nil, "", err
+	}
+	app, err := c.pushAddedOIDCApplication(ctx, addedApplication, stringPw, append(events, tokenEvent)...)
+	if err != nil {
+		return nil, "", err
+	}
+	return app, registrationAccessToken, nil
+}
+
+// VerifyOIDCRegistrationAccessToken checks the registration access token (RFC 7592)
+// and returns the project and app id of the client it is bound to.
+func (c *Commands) VerifyOIDCRegistrationAccessToken(ctx context.Context, registrationAccessToken string) (projectID, appID string, err error) {
+	writeModel, err := c.oidcRegistrationAccessTokenWriteModel(ctx, registrationAccessToken)
+	if err != nil {
+		return "", "", err
+	}
+	return writeModel.AggregateID, writeModel.AppID, nil
+}
+
+// UpdateRegisteredOIDCClient replaces the configuration of a dynamically registered client (RFC 7592).
+// Unlike ChangeOIDCApplication, an unchanged configuration is not treated as an error.
+func (c *Commands) UpdateRegisteredOIDCClient(ctx context.Context, registrationAccessToken string, oidcApp *domain.OIDCApp) (_ *domain.OIDCApp, err error) {
+	tokenWriteModel, err := c.oidcRegistrationAccessTokenWriteModel(ctx, registrationAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if !oidcApp.IsValid() {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-2n0Fs", "Errors.Project.App.OIDCConfigInvalid")
+	}
+	if err = c.checkOIDCClientRegistrationAllowed(ctx, oidcApp); err != nil {
+		return nil, err
+	}
+	existingOIDC, err := c.getOIDCAppWriteModel(ctx, tokenWriteModel.AggregateID, tokenWriteModel.AppID, tokenWriteModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingOIDC.State.Exists() || !existingOIDC.IsOIDC() {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-p2Nfa", "Errors.Project.App.NotExisting")
+	}
+	changedEvent, hasChanged, err := existingOIDC.NewChangedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&existingOIDC.WriteModel),
+		existingOIDC.AppID,
+		oidcApp.RedirectUris,
+		oidcApp.PostLogoutRedirectUris,
+		oidcApp.ResponseTypes,
+		oidcApp.GrantTypes,
+		oidcApp.ApplicationType,
+		oidcApp.AuthMethodType,
+		existingOIDC.OIDCVersion,
+		existingOIDC.AccessTokenType,
+		existingOIDC.DevMode,
+		existingOIDC.AccessTokenRoleAssertion,
+		existingOIDC.IDTokenRoleAssertion,
+		existingOIDC.IDTokenUserinfoAssertion,
+		existingOIDC.ClockSkew,
+		existingOIDC.AdditionalOrigins,
+		existingOIDC.SkipNativeAppSuccessPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if hasChanged {
+		pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+		if err != nil {
+			return nil, err
+		}
+		if err = AppendAndReduce(existingOIDC, pushedEvents...); err != nil {
+			return nil, err
+		}
+	}
+	result := oidcWriteModelToOIDCConfig(existingOIDC)
+	result.FillCompliance()
+	return result, nil
+}
+
+// RemoveRegisteredOIDCClient removes a dynamically registered client (RFC 7592).
+func (c *Commands) RemoveRegisteredOIDCClient(ctx context.Context, registrationAccessToken string) (*domain.ObjectDetails, error) {
+	writeModel, err := c.oidcRegistrationAccessTokenWriteModel(ctx, registrationAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return c.RemoveApplication(ctx, writeModel.AggregateID, writeModel.AppID, writeModel.ResourceOwner)
+}
+
+func (c *Commands) checkOIDCClientRegistrationAllowed(ctx context.Context, oidcApp *domain.OIDCApp) error {
+	settings, err := c.getOIDCClientRegistrationSettingsWriteModel(ctx)
+	if err != nil {
+		return err
+	}
+	if !settings.settings().GrantTypesAllowed(oidcApp.GrantTypes) {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Gq2n1", "Errors.OIDCClientRegistration.GrantTypeNotAllowed")
+	}
+	if !settings.settings().RedirectURIsAllowed(oidcApp.RedirectUris) || !settings.settings().RedirectURIsAllowed(oidcApp.PostLogoutRedirectUris) {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Lw0sE", "Errors.OIDCClientRegistration.RedirectURINotAllowed")
+	}
+	return nil
+}
+
+func (c *Commands) newOIDCRegistrationAccessTokenEvent(ctx context.Context, projectAgg *eventstore.Aggregate, appID string) (_ eventstore.Command, token string, err error) {
+	tokenID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, "", err
+	}
+	token, err = c.createOIDCClientRegistrationToken(oidcRegistrationAccessTokenPrefix, projectAgg.ID, appID, tokenID)
+	if err != nil {
+		return nil, "", err
+	}
+	return project.NewOIDCRegistrationAccessTokenSetEvent(ctx, projectAgg, appID, tokenID), token, nil
+}
+
+func (c *Commands) oidcRegistrationAccessTokenWriteModel(ctx context.Context, registrationAccessToken string) (*OIDCRegistrationAccessTokenWriteModel, error) {
+	ids, err := c.verifyOIDCClientRegistrationToken(oidcRegistrationAccessTokenPrefix, registrationAccessToken, 3)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewOIDCRegistrationAccessTokenWriteModel(ids[0], ids[1], "")
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || writeModel.TokenID != ids[2] {
+		return nil, errors.ThrowPermissionDenied(nil, "COMMAND-Kgw2d", "Errors.OIDCClientRegistration.RegistrationAccessToken.Invalid")
+	}
+	return writeModel, nil
+}
+
+// createOIDCClientRegistrationToken encrypts the prefixed ids, so they can be restored on verification
+// without the need of storing (a hash of) the token.
+// The id of the encryption key is prepended, so the token can still be decrypted after a key rotation.
+func (c *Commands) createOIDCClientRegistrationToken(prefix string, ids ...string) (string, error) {
+	encrypted, err := c.keyAlgorithm.Encrypt([]byte(strings.Join(append([]string{prefix}, ids...), ":")))
+	if err != nil {
+		return "", err
+	}
+	keyID := base64.RawURLEncoding.EncodeToString([]byte(c.keyAlgorithm.EncryptionKeyID()))
+	return keyID + "." + base64.RawURLEncoding.EncodeToString(encrypted), nil
+}
+
+func (c *Commands) verifyOIDCClientRegistrationToken(prefix, token string, idCount int) ([]string, error) {
+	encodedKeyID, encodedValue, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.ThrowPermissionDenied(nil, "COMMAND-Lm3gq", "Errors.OIDCClientRegistration.Token.Invalid")
+	}
+	keyID, err := base64.RawURLEncoding.DecodeString(encodedKeyID)
+	if err != nil {
+		return nil, errors.ThrowPermissionDenied(err, "COMMAND-Vn2sd", "Errors.OIDCClientRegistration.Token.Invalid")
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encodedValue)
+	if err != nil {
+		return nil, errors.ThrowPermissionDenied(err, "COMMAND-Sg3qw", "Errors.OIDCClientRegistration.Token.Invalid")
+	}
+	decrypted, err := c.keyAlgorithm.DecryptString(decoded, string(keyID))
+	if err != nil {
+		return nil, errors.ThrowPermissionDenied(err, "COMMAND-3mgS1", "Errors.OIDCClientRegistration.Token.Invalid")
+	}
+	parts := strings.Split(decrypted, ":")
+	if len(parts) != idCount+1 || parts[0] != prefix {
+		return nil, errors.ThrowPermissionDenied(nil, "COMMAND-Pq2m0", "Errors.OIDCClientRegistration.Token.Invalid")
+	}
+	return parts[1:], nil
+}
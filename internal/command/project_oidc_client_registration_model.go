@@ -0,0 +1,152 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type OIDCInitialAccessTokenWriteModel struct {
+	eventstore.WriteModel
+
+	TokenID        string
+	ExpirationDate time.Time
+	State          domain.OIDCInitialAccessTokenState
+}
+
+func NewOIDCInitialAccessTokenWriteModel(projectID, tokenID, resourceOwner string) *OIDCInitialAccessTokenWriteModel {
+	return &OIDCInitialAccessTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		TokenID: tokenID,
+	}
+}
+
+func (wm *OIDCInitialAccessTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.OIDCInitialAccessTokenAddedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.OIDCInitialAccessTokenRemovedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OIDCInitialAccessTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.OIDCInitialAccessTokenAddedEvent:
+			wm.ExpirationDate = e.ExpirationDate
+			wm.State = domain.OIDCInitialAccessTokenStateActive
+		case *project.OIDCInitialAccessTokenRemovedEvent:
+			wm.State = domain.OIDCInitialAccessTokenStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.OIDCInitialAccessTokenStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OIDCInitialAccessTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.OIDCInitialAccessTokenAddedType,
+			project.OIDCInitialAccessTokenRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+func (wm *OIDCInitialAccessTokenWriteModel) isValid() bool {
+	return wm.State.Exists() && wm.ExpirationDate.After(time.Now())
+}
+
+type OIDCRegistrationAccessTokenWriteModel struct {
+	eventstore.WriteModel
+
+	AppID    string
+	ClientID string
+	TokenID  string
+	State    domain.AppState
+}
+
+func NewOIDCRegistrationAccessTokenWriteModel(projectID, appID, resourceOwner string) *OIDCRegistrationAccessTokenWriteModel {
+	return &OIDCRegistrationAccessTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *OIDCRegistrationAccessTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.OIDCConfigAddedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.OIDCRegistrationAccessTokenSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OIDCRegistrationAccessTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.OIDCConfigAddedEvent:
+			wm.ClientID = e.ClientID
+			wm.State = domain.AppStateActive
+		case *project.OIDCRegistrationAccessTokenSetEvent:
+			wm.TokenID = e.TokenID
+		case *project.ApplicationRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OIDCRegistrationAccessTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.OIDCConfigAddedType,
+			project.OIDCRegistrationAccessTokenSetType,
+			project.ApplicationRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
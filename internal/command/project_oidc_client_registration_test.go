@@ -0,0 +1,756 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestCommandSide_AddOIDCInitialAccessToken(t *testing.T) {
+	expirationDate := time.Now().Add(time.Hour).UTC()
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		idGenerator  id.Generator
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx           context.Context
+		token         *domain.OIDCInitialAccessToken
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.OIDCInitialAccessToken
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no project id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				token:         &domain.OIDCInitialAccessToken{},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "expiration in the past, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &domain.OIDCInitialAccessToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "project1"},
+					ExpirationDate: time.Now().Add(-time.Hour),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &domain.OIDCInitialAccessToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "project1"},
+					ExpirationDate: expirationDate,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "token added, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						eventPusherToEvents(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								expirationDate,
+							),
+						),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				token: &domain.OIDCInitialAccessToken{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "project1"},
+					ExpirationDate: expirationDate,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.OIDCInitialAccessToken{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					TokenID:        "token1",
+					ExpirationDate: expirationDate,
+					Token:          testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				idGenerator:  tt.fields.idGenerator,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			got, err := r.AddOIDCInitialAccessToken(tt.args.ctx, tt.args.token, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want.AggregateID, got.AggregateID)
+				assert.Equal(t, tt.res.want.ResourceOwner, got.ResourceOwner)
+				assert.Equal(t, tt.res.want.TokenID, got.TokenID)
+				assert.Equal(t, tt.res.want.ExpirationDate, got.ExpirationDate)
+				assert.Equal(t, tt.res.want.Token, got.Token)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOIDCInitialAccessToken(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		tokenID       string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no token id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "token not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "token removed, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+					expectPush(
+						eventPusherToEvents(
+							project.NewOIDCInitialAccessTokenRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveOIDCInitialAccessToken(tt.args.ctx, tt.args.projectID, tt.args.tokenID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RegisterOIDCClient(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		idGenerator  id.Generator
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx                context.Context
+		initialAccessToken string
+		oidcApp            *domain.OIDCApp
+	}
+	type res struct {
+		want                    *domain.OIDCApp
+		registrationAccessToken string
+		err                     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "malformed token, permission denied error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: "not-a-token",
+				oidcApp:            &domain.OIDCApp{},
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "unknown encryption key, permission denied error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("rotated", "iat:project1:token1"),
+				oidcApp:            &domain.OIDCApp{},
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "registration access token used, permission denied error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "rat:project1:app1:token1"),
+				oidcApp:            &domain.OIDCApp{},
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "token removed, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(time.Hour),
+							),
+						),
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				oidcApp:            &domain.OIDCApp{},
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "token expired, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(-time.Hour),
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				oidcApp:            &domain.OIDCApp{},
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "grant type not allowed, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOIDCClientRegistrationSettingsSetEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								nil,
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				oidcApp: &domain.OIDCApp{
+					AppName:       "client",
+					RedirectUris:  []string{"https://partner.example.com/callback"},
+					ResponseTypes: []domain.OIDCResponseType{domain.OIDCResponseTypeIDToken},
+					GrantTypes:    []domain.OIDCGrantType{domain.OIDCGrantTypeImplicit},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "redirect uri not allowed, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewOIDCClientRegistrationSettingsSetEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								nil,
+								[]string{"https://partner.example.com/"},
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				oidcApp: &domain.OIDCApp{
+					AppName:       "client",
+					RedirectUris:  []string{"https://evil.example.com/callback"},
+					ResponseTypes: []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:    []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "client registered with registration access token, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCInitialAccessTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								project.NewApplicationAddedEvent(context.Background(),
+									&project.NewAggregate("project1", "org1").Aggregate,
+									"app1",
+									"client",
+								),
+							),
+							eventFromEventPusherWithInstanceID("instance1",
+								project.NewOIDCConfigAddedEvent(context.Background(),
+									&project.NewAggregate("project1", "org1").Aggregate,
+									domain.OIDCVersionV1,
+									"app1",
+									"client1@project",
+									nil,
+									[]string{"https://partner.example.com/callback"},
+									[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+									[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+									domain.OIDCApplicationTypeWeb,
+									domain.OIDCAuthMethodTypeNone,
+									nil,
+									false,
+									domain.OIDCTokenTypeBearer,
+									false,
+									false,
+									false,
+									0,
+									nil,
+									false,
+								),
+							),
+							eventFromEventPusherWithInstanceID("instance1",
+								project.NewOIDCRegistrationAccessTokenSetEvent(context.Background(),
+									&project.NewAggregate("project1", "org1").Aggregate,
+									"app1",
+									"token2",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", project.NewAddApplicationUniqueConstraint("client", "project1")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1", "token2"),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:                authz.WithInstanceID(context.Background(), "instance1"),
+				initialAccessToken: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+				oidcApp: &domain.OIDCApp{
+					AppName:         "client",
+					OIDCVersion:     domain.OIDCVersionV1,
+					RedirectUris:    []string{"https://partner.example.com/callback"},
+					ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType: domain.OIDCApplicationTypeWeb,
+					AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				},
+			},
+			res: res{
+				want: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+						InstanceID:    "instance1",
+					},
+					AppID:           "app1",
+					AppName:         "client",
+					ClientID:        "client1@project",
+					OIDCVersion:     domain.OIDCVersionV1,
+					RedirectUris:    []string{"https://partner.example.com/callback"},
+					ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType: domain.OIDCApplicationTypeWeb,
+					AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+					AccessTokenType: domain.OIDCTokenTypeBearer,
+					State:           domain.AppStateActive,
+					Compliance:      &domain.Compliance{},
+				},
+				registrationAccessToken: testOIDCClientRegistrationToken("id", "rat:project1:app1:token2"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				idGenerator:  tt.fields.idGenerator,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			got, registrationAccessToken, err := r.RegisterOIDCClient(tt.args.ctx, tt.args.initialAccessToken, tt.args.oidcApp, nil)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, tt.res.registrationAccessToken, registrationAccessToken)
+			}
+		})
+	}
+}
+
+func TestCommandSide_VerifyOIDCRegistrationAccessToken(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx   context.Context
+		token string
+	}
+	type res struct {
+		projectID string
+		appID     string
+		err       func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "initial access token used, permission denied error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				token: testOIDCClientRegistrationToken("id", "iat:project1:token1"),
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "token replaced, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								domain.OIDCVersionV1,
+								"app1",
+								"client1",
+								nil,
+								[]string{"https://partner.example.com/callback"},
+								[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+								[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								domain.OIDCApplicationTypeWeb,
+								domain.OIDCAuthMethodTypeNone,
+								nil,
+								false,
+								domain.OIDCTokenTypeBearer,
+								false,
+								false,
+								false,
+								0,
+								nil,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							project.NewOIDCRegistrationAccessTokenSetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"token2",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				token: testOIDCClientRegistrationToken("id", "rat:project1:app1:token1"),
+			},
+			res: res{
+				err: caos_errs.IsPermissionDenied,
+			},
+		},
+		{
+			name: "valid token, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewOIDCConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								domain.OIDCVersionV1,
+								"app1",
+								"client1",
+								nil,
+								[]string{"https://partner.example.com/callback"},
+								[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+								[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								domain.OIDCApplicationTypeWeb,
+								domain.OIDCAuthMethodTypeNone,
+								nil,
+								false,
+								domain.OIDCTokenTypeBearer,
+								false,
+								false,
+								false,
+								0,
+								nil,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							project.NewOIDCRegistrationAccessTokenSetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"token1",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				token: testOIDCClientRegistrationToken("id", "rat:project1:app1:token1"),
+			},
+			res: res{
+				projectID: "project1",
+				appID:     "app1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			projectID, appID, err := r.VerifyOIDCRegistrationAccessToken(tt.args.ctx, tt.args.token)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.projectID, projectID)
+				assert.Equal(t, tt.res.appID, appID)
+			}
+		})
+	}
+}
+
+func testOIDCClientRegistrationToken(keyID, value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(keyID)) + "." + base64.RawURLEncoding.EncodeToString([]byte(value))
+}
@@ -85,25 +85,27 @@ func (c *Commands) ChangeProjectRole(ctx context.Context, projectRole *domain.Pr
 		return nil, err
 	}
 
-	existingRole, err := c.getProjectRoleWriteModelByID(ctx, projectRole.Key, projectRole.AggregateID, resourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	if existingRole.State == domain.ProjectRoleStateUnspecified || existingRole.State == domain.ProjectRoleStateRemoved {
-		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-vv8M9", "Errors.Project.Role.NotExisting")
-	}
-
-	projectAgg := ProjectAggregateFromWriteModel(&existingRole.WriteModel)
+	var existingRole *ProjectRoleWriteModel
+	pushedEvents, err := c.pushWithSequenceCheck(ctx, func(ctx context.Context) (_ []eventstore.Command, err error) {
+		existingRole, err = c.getProjectRoleWriteModelByID(ctx, projectRole.Key, projectRole.AggregateID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingRole.State == domain.ProjectRoleStateUnspecified || existingRole.State == domain.ProjectRoleStateRemoved {
+			return nil, caos_errs.ThrowNotFound(nil, "COMMAND-vv8M9", "Errors.Project.Role.NotExisting")
+		}
 
-	changeEvent, changed, err := existingRole.NewProjectRoleChangedEvent(ctx, projectAgg, projectRole.Key, projectRole.DisplayName, projectRole.Group)
-	if err != nil {
-		return nil, err
-	}
-	if !changed {
-		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-5M0cs", "Errors.NoChangesFound")
-	}
+		projectAgg := ProjectAggregateFromWriteModel(&existingRole.WriteModel).ExpectWriteModel(existingRole)
 
-	pushedEvents, err := c.eventstore.Push(ctx, changeEvent)
+		changeEvent, changed, err := existingRole.NewProjectRoleChangedEvent(ctx, projectAgg, projectRole.Key, projectRole.DisplayName, projectRole.Group)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-5M0cs", "Errors.NoChangesFound")
+		}
+		return []eventstore.Command{changeEvent}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
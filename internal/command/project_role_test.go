@@ -630,8 +630,83 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1",
+								"key",
+								"group",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(1, projectRoleEventTypes,
+								newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keychanged", "groupchanged"),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				role: &domain.ProjectRole{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "key1",
+					DisplayName: "keychanged",
+					Group:       "groupchanged",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ProjectRole{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Key:         "key1",
+					DisplayName: "keychanged",
+					Group:       "groupchanged",
+				},
+			},
+		},
+		{
+			name: "role changed concurrently, retry ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
 					expectFilter(
 						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1",
+								"key",
+								"group",
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(1, projectRoleEventTypes,
+								newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keychanged", "groupchanged"),
+							),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
 							project.NewRoleAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"key1",
@@ -639,10 +714,13 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 								"group",
 							),
 						),
+						eventFromEventPusherWithSequence(2,
+							newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keyconcurrent", "groupconcurrent"),
+						),
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(2, projectRoleEventTypes,
 								newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keychanged", "groupchanged"),
 							),
 						},
@@ -673,6 +751,69 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "role changed concurrently to same values, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1",
+								"key",
+								"group",
+							),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(1, projectRoleEventTypes,
+								newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keychanged", "groupchanged"),
+							),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1",
+								"key",
+								"group",
+							),
+						),
+						eventFromEventPusherWithSequence(2,
+							newRoleChangedEvent(context.Background(), "project1", "org1", "key1", "keychanged", "groupchanged"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				role: &domain.ProjectRole{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Key:         "key1",
+					DisplayName: "keychanged",
+					Group:       "groupchanged",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1068,3 +1209,11 @@ func newRoleChangedEvent(ctx context.Context, projectID, resourceOwner, key, dis
 	)
 	return event
 }
+
+// projectRoleEventTypes are the event types queried by the ProjectRoleWriteModel
+var projectRoleEventTypes = []repository.EventType{
+	repository.EventType(project.RoleAddedType),
+	repository.EventType(project.RoleChangedType),
+	repository.EventType(project.RoleRemovedType),
+	repository.EventType(project.ProjectRemovedType),
+}
@@ -326,7 +326,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
@@ -335,7 +335,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, projectEventTypes,
 								newProjectChangedEvent(context.Background(),
 									"project1",
 									"org1",
@@ -386,7 +386,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
-						eventFromEventPusher(
+						eventFromEventPusherWithSequence(1,
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
@@ -395,7 +395,100 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 					),
 					expectPush(
 						[]*repository.Event{
-							eventFromEventPusher(
+							eventFromEventPusherWithExpectedSequence(1, projectEventTypes,
+								newProjectChangedEvent(context.Background(),
+									"project1",
+									"org1",
+									"project",
+									"",
+									false,
+									false,
+									false,
+									domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				project: &domain.Project{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					Name:                   "project",
+					ProjectRoleAssertion:   false,
+					ProjectRoleCheck:       false,
+					HasProjectCheck:        false,
+					PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.Project{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					Name:                   "project",
+					ProjectRoleAssertion:   false,
+					ProjectRoleCheck:       false,
+					HasProjectCheck:        false,
+					PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				},
+			},
+		},
+		{
+			name: "project changed concurrently, retry ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+						),
+					),
+					expectPushFailed(
+						errors.ThrowPreconditionFailed(&repository.SequenceConflictError{}, "SQL-Sq3cF", "Errors.Event.SequenceConflict"),
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(1, projectEventTypes,
+								newProjectChangedEvent(context.Background(),
+									"project1",
+									"org1",
+									"project",
+									"",
+									false,
+									false,
+									false,
+									domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy),
+							),
+						},
+					),
+					expectFilter(
+						eventFromEventPusherWithSequence(1,
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+						),
+						eventFromEventPusherWithSequence(2,
+							newProjectChangedEvent(context.Background(),
+								"project1",
+								"org1",
+								"project",
+								"",
+								true,
+								true,
+								true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithExpectedSequence(2, projectEventTypes,
 								newProjectChangedEvent(context.Background(),
 									"project1",
 									"org1",
@@ -1319,3 +1412,12 @@ func TestAddProject(t *testing.T) {
 // 		})
 // 	}
 // }
+
+// projectEventTypes are the event types queried by the ProjectWriteModel
+var projectEventTypes = []repository.EventType{
+	repository.EventType(project.ProjectAddedType),
+	repository.EventType(project.ProjectChangedType),
+	repository.EventType(project.ProjectDeactivatedType),
+	repository.EventType(project.ProjectReactivatedType),
+	repository.EventType(project.ProjectRemovedType),
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	caos_errs "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -25,18 +28,29 @@ type SessionChecks struct {
 	passwordWriteModel *HumanPasswordWriteModel
 	eventstore         *eventstore.Eventstore
 	userPasswordAlg    crypto.HashAlgorithm
+	userEncryption     crypto.EncryptionAlgorithm
+	newMagicLinkCode   cryptoCodeFunc
+	getLoginPolicy     func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)
 	createToken        func(sessionID string) (id string, token string, err error)
+	checkLoginThrottle func(ctx context.Context, userID, remoteIP string, requireCaptcha bool) error
 	now                func() time.Time
+
+	// magicLinkCode is only set if the code was requested to be returned
+	magicLinkCode string
 }
 
 func (c *Commands) NewSessionChecks(checks []SessionCheck, session *SessionWriteModel) *SessionChecks {
 	return &SessionChecks{
-		checks:            checks,
-		sessionWriteModel: session,
-		eventstore:        c.eventstore,
-		userPasswordAlg:   c.userPasswordAlg,
-		createToken:       c.sessionTokenCreator,
-		now:               time.Now,
+		checks:             checks,
+		sessionWriteModel:  session,
+		eventstore:         c.eventstore,
+		userPasswordAlg:    c.userPasswordAlg,
+		userEncryption:     c.userEncryption,
+		newMagicLinkCode:   c.newMagicLinkCode,
+		getLoginPolicy:     c.getOrgLoginPolicy,
+		createToken:        c.sessionTokenCreator,
+		checkLoginThrottle: c.checkLoginThrottle,
+		now:                time.Now,
 	}
 }
 
@@ -56,6 +70,9 @@ func CheckPassword(password string) SessionCheck {
 		if cmd.sessionWriteModel.UserID == "" {
 			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sfw3f", "Errors.User.UserIDMissing")
 		}
+		if err := cmd.checkLoginThrottle(ctx, cmd.sessionWriteModel.UserID, http_utils.RemoteIPFromCtx(ctx), true); err != nil {
+			return err
+		}
 		cmd.passwordWriteModel = NewHumanPasswordWriteModel(cmd.sessionWriteModel.UserID, "")
 		err := cmd.eventstore.FilterToQueryReducer(ctx, cmd.passwordWriteModel)
 		if err != nil {
@@ -80,6 +97,80 @@ func CheckPassword(password string) SessionCheck {
 	}
 }
 
+// RequestMagicLink defines a magic link challenge to be created for a session update.
+// The link is sent to the verified email of the user, unless returnCode is set.
+// The code can only be checked on the same session.
+func RequestMagicLink(urlTmpl string, returnCode bool) SessionCheck {
+	return func(ctx context.Context, cmd *SessionChecks) error {
+		if cmd.sessionWriteModel.UserID == "" {
+			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk4n", "Errors.User.UserIDMissing")
+		}
+		if !returnCode && urlTmpl == "" {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mlk5o", "Errors.User.MagicLink.URLTemplateMissing")
+		}
+		if urlTmpl != "" {
+			if err := domain.RenderMagicLinkURLTemplate(io.Discard, urlTmpl, cmd.sessionWriteModel.UserID, "orgID", cmd.sessionWriteModel.AggregateID, "code"); err != nil {
+				return err
+			}
+		}
+		wm := NewHumanMagicLinkWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return err
+		}
+		if err := checkMagicLinkAllowed(ctx, wm, cmd.getLoginPolicy); err != nil {
+			return err
+		}
+		code, err := cmd.newMagicLinkCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeMagicLinkCode, cmd.userEncryption)
+		if err != nil {
+			return err
+		}
+		cmd.sessionWriteModel.MagicLinkRequested(user.NewHumanMagicLinkCodeAddedEvent(ctx,
+			UserAggregateFromWriteModel(&wm.WriteModel),
+			code.Crypted,
+			code.Expiry,
+			urlTmpl,
+			returnCode,
+			cmd.sessionWriteModel.AggregateID,
+			nil,
+		))
+		if returnCode {
+			cmd.magicLinkCode = code.Plain
+		}
+		return nil
+	}
+}
+
+// CheckMagicLink defines a magic link check to be executed for a session update
+func CheckMagicLink(code string) SessionCheck {
+	return func(ctx context.Context, cmd *SessionChecks) error {
+		if cmd.sessionWriteModel.UserID == "" {
+			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk6p", "Errors.User.UserIDMissing")
+		}
+		if err := cmd.checkLoginThrottle(ctx, cmd.sessionWriteModel.UserID, http_utils.RemoteIPFromCtx(ctx), false); err != nil {
+			return err
+		}
+		wm := NewHumanMagicLinkWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return err
+		}
+		if wm.UserState == domain.UserStateUnspecified || wm.UserState == domain.UserStateDeleted {
+			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk7q", "Errors.User.NotFound")
+		}
+		if wm.Code == nil {
+			return caos_errs.ThrowNotFound(nil, "COMMAND-Mlk8r", "Errors.User.MagicLink.NotFound")
+		}
+		if wm.SessionID != cmd.sessionWriteModel.AggregateID {
+			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk9s", "Errors.User.MagicLink.OtherBrowser")
+		}
+		if err := verifyMagicLinkCode(ctx, cmd.eventstore.Filter, cmd.userEncryption, wm, code); err != nil {
+			//TODO: maybe we want to reset the session in the future https://github.com/zitadel/zitadel/issues/5807
+			return err
+		}
+		cmd.sessionWriteModel.MagicLinkChecked(ctx, user.NewHumanMagicLinkCheckSucceededEvent(ctx, UserAggregateFromWriteModel(&wm.WriteModel), nil), cmd.now())
+		return nil
+	}
+}
+
 // Check will execute the checks specified and return an error on the first occurrence
 func (s *SessionChecks) Check(ctx context.Context) error {
 	for _, check := range s.checks {
@@ -154,6 +245,23 @@ func (c *Commands) TerminateSession(ctx context.Context, sessionID, sessionToken
 	return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
 }
 
+// terminateUserSessions returns the terminate events of the active sessions of the user.
+// Sessions of other users are ignored.
+func (c *Commands) terminateUserSessions(ctx context.Context, userID string, sessionIDs []string) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		sessionWriteModel := NewSessionWriteModel(sessionID, "")
+		if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+			return nil, err
+		}
+		if sessionWriteModel.State != domain.SessionStateActive || sessionWriteModel.UserID != userID {
+			continue
+		}
+		events = append(events, session.NewTerminateEvent(ctx, &session.NewAggregate(sessionWriteModel.AggregateID, sessionWriteModel.ResourceOwner).Aggregate))
+	}
+	return events, nil
+}
+
 // updateSession execute the [SessionChecks] where new events will be created and as well as for metadata (changes)
 func (c *Commands) updateSession(ctx context.Context, checks *SessionChecks, metadata map[string][]byte) (set *SessionChanged, err error) {
 	if checks.sessionWriteModel.State == domain.SessionStateTerminated {
@@ -181,6 +289,7 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionChecks, met
 	}
 	changed := sessionWriteModelToSessionChanged(checks.sessionWriteModel)
 	changed.NewToken = sessionToken
+	changed.MagicLinkCode = checks.magicLinkCode
 	return changed, nil
 }
 
@@ -209,8 +318,9 @@ func sessionTokenCreator(idGenerator id.Generator, sessionAlg crypto.EncryptionA
 
 type SessionChanged struct {
 	*domain.ObjectDetails
-	ID       string
-	NewToken string
+	ID            string
+	NewToken      string
+	MagicLinkCode string
 }
 
 func sessionWriteModelToSessionChanged(wm *SessionWriteModel) *SessionChanged {
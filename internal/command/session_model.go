@@ -13,12 +13,13 @@ import (
 type SessionWriteModel struct {
 	eventstore.WriteModel
 
-	TokenID           string
-	UserID            string
-	UserCheckedAt     time.Time
-	PasswordCheckedAt time.Time
-	Metadata          map[string][]byte
-	State             domain.SessionState
+	TokenID            string
+	UserID             string
+	UserCheckedAt      time.Time
+	PasswordCheckedAt  time.Time
+	MagicLinkCheckedAt time.Time
+	Metadata           map[string][]byte
+	State              domain.SessionState
 
 	commands  []eventstore.Command
 	aggregate *eventstore.Aggregate
@@ -44,6 +45,8 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceUserChecked(e)
 		case *session.PasswordCheckedEvent:
 			wm.reducePasswordChecked(e)
+		case *session.MagicLinkCheckedEvent:
+			wm.reduceMagicLinkChecked(e)
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.TerminateEvent:
@@ -62,6 +65,7 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.AddedType,
 			session.UserCheckedType,
 			session.PasswordCheckedType,
+			session.MagicLinkCheckedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.TerminateType,
@@ -87,6 +91,10 @@ func (wm *SessionWriteModel) reducePasswordChecked(e *session.PasswordCheckedEve
 	wm.PasswordCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reduceMagicLinkChecked(e *session.MagicLinkCheckedEvent) {
+	wm.MagicLinkCheckedAt = e.CheckedAt
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -110,6 +118,19 @@ func (wm *SessionWriteModel) PasswordChecked(ctx context.Context, checkedAt time
 	wm.commands = append(wm.commands, session.NewPasswordCheckedEvent(ctx, wm.aggregate, checkedAt))
 }
 
+// MagicLinkRequested adds the event of the user aggregate, which creates the code of the link
+func (wm *SessionWriteModel) MagicLinkRequested(codeAdded eventstore.Command) {
+	wm.commands = append(wm.commands, codeAdded)
+}
+
+// MagicLinkChecked invalidates the code on the user aggregate and sets the check on the session
+func (wm *SessionWriteModel) MagicLinkChecked(ctx context.Context, checkSucceeded eventstore.Command, checkedAt time.Time) {
+	wm.commands = append(wm.commands,
+		checkSucceeded,
+		session.NewMagicLinkCheckedEvent(ctx, wm.aggregate, checkedAt),
+	)
+}
+
 func (wm *SessionWriteModel) SetToken(ctx context.Context, tokenID string) {
 	wm.commands = append(wm.commands, session.NewTokenSetEvent(ctx, wm.aggregate, tokenID))
 }
@@ -301,6 +301,34 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"password check throttled",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				checks: &SessionChecks{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "org1"),
+					checks: []SessionCheck{
+						CheckUser("userID"),
+						CheckPassword("password"),
+					},
+					checkLoginThrottle: func(ctx context.Context, userID, remoteIP string, requireCaptcha bool) error {
+						if userID != "userID" || !requireCaptcha {
+							return caos_errs.ThrowInternal(nil, "id", "unexpected throttle check")
+						}
+						return caos_errs.ThrowResourceExhausted(nil, "COMMAND-Thr1a", "Errors.Login.TooManyAttempts")
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: caos_errs.ThrowResourceExhausted(nil, "COMMAND-Thr1a", "Errors.Login.TooManyAttempts"),
+			},
+		},
 		{
 			"set user, password, metadata and token",
 			fields{
@@ -327,6 +355,7 @@ func TestCommands_updateSession(t *testing.T) {
 						CheckUser("userID"),
 						CheckPassword("password"),
 					},
+					checkLoginThrottle: noLoginThrottle,
 					eventstore: eventstoreExpect(t,
 						expectFilter(
 							eventFromEventPusher(
@@ -368,6 +397,178 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"set user, request magic link with returned code and token",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						eventPusherToEvents(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "org1").Aggregate,
+								"userID", testNow),
+							user.NewHumanMagicLinkCodeAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("code"),
+								}, time.Minute*10, "", true, "sessionID", nil),
+							session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "org1").Aggregate,
+								"tokenID"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				checks: &SessionChecks{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "org1"),
+					checks: []SessionCheck{
+						CheckUser("userID"),
+						RequestMagicLink("", true),
+					},
+					eventstore: eventstoreExpect(t,
+						expectFilter(
+							eventFromEventPusher(
+								user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+									"username", "", "", "", "", language.English, domain.GenderUnspecified, "email@test.ch", false),
+							),
+							eventFromEventPusher(
+								user.NewHumanEmailVerifiedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+							),
+						),
+					),
+					getLoginPolicy: func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+						return &domain.LoginPolicy{AllowMagicLink: true}, nil
+					},
+					newMagicLinkCode: mockCode("code", time.Minute*10),
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ID:            "sessionID",
+					NewToken:      "token",
+					MagicLinkCode: "code",
+				},
+			},
+		},
+		{
+			"magic link of other session",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				checks: &SessionChecks{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "org1"),
+					checks: []SessionCheck{
+						CheckUser("userID"),
+						CheckMagicLink("code"),
+					},
+					checkLoginThrottle: noLoginThrottle,
+					eventstore: eventstoreExpect(t,
+						expectFilter(
+							eventFromEventPusher(
+								user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+									"username", "", "", "", "", language.English, domain.GenderUnspecified, "email@test.ch", false),
+							),
+							eventFromEventPusherWithCreationDateNow(
+								user.NewHumanMagicLinkCodeAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("code"),
+									}, time.Minute*10, "", true, "otherSessionID", nil),
+							),
+						),
+					),
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk9s", "Errors.User.MagicLink.OtherBrowser"),
+			},
+		},
+		{
+			"set user, magic link and token",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						eventPusherToEvents(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "org1").Aggregate,
+								"userID", testNow),
+							user.NewHumanMagicLinkCheckSucceededEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+							session.NewMagicLinkCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "org1").Aggregate,
+								testNow),
+							session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "org1").Aggregate,
+								"tokenID"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				checks: &SessionChecks{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "org1"),
+					checks: []SessionCheck{
+						CheckUser("userID"),
+						CheckMagicLink("code"),
+					},
+					checkLoginThrottle: noLoginThrottle,
+					eventstore: eventstoreExpect(t,
+						expectFilter(
+							eventFromEventPusher(
+								user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+									"username", "", "", "", "", language.English, domain.GenderUnspecified, "email@test.ch", false),
+							),
+							eventFromEventPusherWithCreationDateNow(
+								user.NewHumanMagicLinkCodeAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("code"),
+									}, time.Minute*10, "", true, "sessionID", nil),
+							),
+						),
+						expectFilter(
+							eventFromEventPusher(newMagicLinkSecretGeneratorAddedEvent()),
+						),
+					),
+					userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -545,3 +746,7 @@ func TestCommands_TerminateSession(t *testing.T) {
 		})
 	}
 }
+
+func noLoginThrottle(context.Context, string, string, bool) error {
+	return nil
+}
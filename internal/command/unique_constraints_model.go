@@ -159,10 +159,14 @@ func (rm *UniqueConstraintReadModel) Reduce() error {
 			rm.removeUniqueConstraint(e.Aggregate().ID, e.IDPConfigID+e.ExternalUserID, user.UniqueUserIDPLinkType)
 		case *usergrant.UserGrantAddedEvent:
 			rm.addUniqueConstraint(e.Aggregate().ID, e.Aggregate().ID, usergrant.NewAddUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID))
+		case *usergrant.UserGrantRequestedEvent:
+			rm.addUniqueConstraint(e.Aggregate().ID, e.Aggregate().ID, usergrant.NewAddUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID))
 		case *usergrant.UserGrantRemovedEvent:
 			rm.removeUniqueConstraint(e.Aggregate().ID, e.Aggregate().ID, usergrant.UniqueUserGrant)
 		case *usergrant.UserGrantCascadeRemovedEvent:
 			rm.removeUniqueConstraint(e.Aggregate().ID, e.Aggregate().ID, usergrant.UniqueUserGrant)
+		case *usergrant.UserGrantRejectedEvent:
+			rm.removeUniqueConstraint(e.Aggregate().ID, e.Aggregate().ID, usergrant.UniqueUserGrant)
 		case *instance.MemberAddedEvent:
 			rm.addUniqueConstraint(e.Aggregate().ID, e.UserID, member.NewAddMemberUniqueConstraint(e.Aggregate().ID, e.UserID))
 		case *instance.MemberRemovedEvent:
@@ -233,8 +237,10 @@ func (rm *UniqueConstraintReadModel) Query() *eventstore.SearchQueryBuilder {
 			user.UserIDPLinkRemovedType,
 			user.UserIDPLinkCascadeRemovedType,
 			usergrant.UserGrantAddedType,
+			usergrant.UserGrantRequestedType,
 			usergrant.UserGrantRemovedType,
 			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantRejectedType,
 			instance.MemberAddedEventType,
 			instance.MemberRemovedEventType,
 			instance.MemberCascadeRemovedEventType,
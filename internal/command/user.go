@@ -187,7 +187,75 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 	if !isUserStateExists(existingUser.UserState) {
 		return nil, errors.ThrowNotFound(nil, "COMMAND-m9od", "Errors.User.NotFound")
 	}
+	events, err := c.removeUserEvents(ctx, existingUser, cascadingUserMemberships, cascadingGrantIDs...)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	c.destroyUserPersonalData(ctx, userID)
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
 
+// RemoveMyUser removes the user of the context.
+// Self deletion must either be allowed by the privacy policy of the user's organisation
+// or the user needs the permission to delete itself.
+// The passed (active) sessions of the user are terminated together with the removal.
+func (c *Commands) RemoveMyUser(ctx context.Context, sessionIDs []string, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) (*domain.ObjectDetails, error) {
+	userID := authz.GetCtxData(ctx).UserID
+	if userID == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Rm2Sd", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, errors.ThrowNotFound(nil, "COMMAND-Rm3Nf", "Errors.User.NotFound")
+	}
+	privacyPolicy, err := c.getOrgPrivacyPolicy(ctx, existingUser.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !privacyPolicy.AllowSelfDelete {
+		if err = c.checkPermission(ctx, domain.PermissionUserSelfDelete, existingUser.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	events, err := c.removeUserEvents(ctx, existingUser, cascadingUserMemberships, cascadingGrantIDs...)
+	if err != nil {
+		return nil, err
+	}
+	terminateEvents, err := c.terminateUserSessions(ctx, userID, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, append(events, terminateEvents...)...)
+	if err != nil {
+		return nil, err
+	}
+	c.destroyUserPersonalData(ctx, userID)
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
+
+// destroyUserPersonalData destroys the key of the personal data of the removed user.
+// The user is already removed, therefore a failure is only logged.
+func (c *Commands) destroyUserPersonalData(ctx context.Context, userID string) {
+	err := c.eventstore.DestroyPersonalData(ctx, userID)
+	logging.WithFields("userID", userID).OnError(err).Error("unable to destroy personal data of removed user")
+}
+
+func (c *Commands) removeUserEvents(ctx context.Context, existingUser *UserWriteModel, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) ([]eventstore.Command, error) {
 	domainPolicy, err := c.getOrgDomainPolicy(ctx, existingUser.ResourceOwner)
 	if err != nil {
 		return nil, errors.ThrowPreconditionFailed(err, "COMMAND-3M9fs", "Errors.Org.DomainPolicy.NotExisting")
@@ -212,16 +280,7 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 		}
 		events = append(events, membershipEvents...)
 	}
-
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
-	if err != nil {
-		return nil, err
-	}
-	err = AppendAndReduce(existingUser, pushedEvents...)
-	if err != nil {
-		return nil, err
-	}
-	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+	return events, nil
 }
 
 func (c *Commands) AddUserToken(ctx context.Context, orgID, agentID, clientID, userID string, audience, scopes []string, lifetime time.Duration) (*domain.Token, error) {
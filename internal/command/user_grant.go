@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 
@@ -13,11 +14,11 @@ import (
 )
 
 func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
-	event, addedUserGrant, err := c.addUserGrant(ctx, usergrant, resourceOwner)
+	cmds, addedUserGrant, err := c.addUserGrant(ctx, usergrant, resourceOwner, false)
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +30,31 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	return userGrantWriteModelToUserGrant(addedUserGrant), nil
 }
 
-func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
+// RequestUserGrant adds a user grant which is only effective after it was approved by a member of the project
+func (c *Commands) RequestUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
+	cmds, requestedUserGrant, err := c.addUserGrant(ctx, userGrant, resourceOwner, true)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = AppendAndReduce(requestedUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return userGrantWriteModelToUserGrant(requestedUserGrant), nil
+}
+
+func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string, requested bool) (cmds []eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if err = checkUserGrantValidity(userGrant.ValidFrom, userGrant.ValidUntil); err != nil {
+		return nil, nil, err
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, resourceOwner)
 	if err != nil {
 		return nil, nil, err
@@ -44,15 +66,29 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, resourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&addedUserGrant.WriteModel)
-	command = usergrant.NewUserGrantAddedEvent(
-		ctx,
-		userGrantAgg,
-		userGrant.UserID,
-		userGrant.ProjectID,
-		userGrant.ProjectGrantID,
-		userGrant.RoleKeys,
-	)
-	return command, addedUserGrant, nil
+	if requested {
+		cmds = append(cmds, usergrant.NewUserGrantRequestedEvent(
+			ctx,
+			userGrantAgg,
+			userGrant.UserID,
+			userGrant.ProjectID,
+			userGrant.ProjectGrantID,
+			userGrant.RoleKeys,
+		))
+	} else {
+		cmds = append(cmds, usergrant.NewUserGrantAddedEvent(
+			ctx,
+			userGrantAgg,
+			userGrant.UserID,
+			userGrant.ProjectID,
+			userGrant.ProjectGrantID,
+			userGrant.RoleKeys,
+		))
+	}
+	if userGrant.HasValidity() {
+		cmds = append(cmds, usergrant.NewUserGrantValidityChangedEvent(ctx, userGrantAgg, userGrant.ValidFrom, userGrant.ValidUntil))
+	}
+	return cmds, addedUserGrant, nil
 }
 
 func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
@@ -203,6 +239,155 @@ func (c *Commands) ReactivateUserGrant(ctx context.Context, grantID, resourceOwn
 	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 }
 
+// ApproveUserGrant activates a requested user grant
+func (c *Commands) ApproveUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
+	existingUserGrant, err := c.requestedUserGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantApprovedEvent(ctx, userGrantAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// RejectUserGrant removes a requested user grant
+func (c *Commands) RejectUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
+	existingUserGrant, err := c.requestedUserGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantRejectedEvent(
+		ctx,
+		userGrantAgg,
+		existingUserGrant.UserID,
+		existingUserGrant.ProjectID,
+		existingUserGrant.ProjectGrantID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+func (c *Commands) requestedUserGrantWriteModelByID(ctx context.Context, grantID, resourceOwner string) (*UserGrantWriteModel, error) {
+	if grantID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bq3ns", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Xk2md", "Errors.UserGrant.NotFound")
+	}
+	if existingUserGrant.State != domain.UserGrantStateRequested {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ue8wd", "Errors.UserGrant.NotRequested")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return existingUserGrant, nil
+}
+
+// SetUserGrantValidity changes the period the user grant is effective in.
+// An expired grant is restored to its state before the expiry if the new period has not ended yet.
+func (c *Commands) SetUserGrantValidity(ctx context.Context, grantID, resourceOwner string, validFrom, validUntil time.Time) (objectDetails *domain.ObjectDetails, err error) {
+	if grantID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hd9ew", "Errors.UserGrant.IDMissing")
+	}
+	if err = checkUserGrantValidity(validFrom, validUntil); err != nil {
+		return nil, err
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Gm2xs", "Errors.UserGrant.NotFound")
+	}
+	if existingUserGrant.ValidFrom.Equal(validFrom) && existingUserGrant.ValidUntil.Equal(validUntil) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pw3ns", "Errors.UserGrant.NotChanged")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	cmds := []eventstore.Command{
+		usergrant.NewUserGrantValidityChangedEvent(ctx, userGrantAgg, validFrom, validUntil),
+	}
+	if existingUserGrant.State == domain.UserGrantStateExpired && (validUntil.IsZero() || time.Now().Before(validUntil)) {
+		if existingUserGrant.StateBeforeExpiry == domain.UserGrantStateInactive {
+			cmds = append(cmds, usergrant.NewUserGrantDeactivatedEvent(ctx, userGrantAgg))
+		} else {
+			cmds = append(cmds, usergrant.NewUserGrantReactivatedEvent(ctx, userGrantAgg))
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// ExpireUserGrant deactivates a user grant whose validity has ended.
+// It's called by the system and therefore doesn't check the permissions of the caller.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
+	if grantID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Nw8fq", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Fw9ds", "Errors.UserGrant.NotFound")
+	}
+	if existingUserGrant.State != domain.UserGrantStateActive && existingUserGrant.State != domain.UserGrantStateInactive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ka9ex", "Errors.UserGrant.NotActive")
+	}
+	if existingUserGrant.ValidUntil.IsZero() || existingUserGrant.ValidUntil.After(time.Now()) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Tz2mv", "Errors.UserGrant.NotExpired")
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantExpiredEvent(ctx, userGrantAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+func checkUserGrantValidity(validFrom, validUntil time.Time) error {
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validFrom.Before(validUntil) {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vq2fn", "Errors.UserGrant.ValidityInvalid")
+	}
+	if !validUntil.IsZero() && validUntil.Before(time.Now()) {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lo3mx", "Errors.UserGrant.ValidityInvalid")
+	}
+	return nil
+}
+
 func (c *Commands) RemoveUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
 	event, existingUserGrant, err := c.removeUserGrant(ctx, grantID, resourceOwner, false)
 	if err != nil {
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -15,7 +17,11 @@ type UserGrantWriteModel struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ValidFrom      time.Time
+	ValidUntil     time.Time
 	State          domain.UserGrantState
+	// StateBeforeExpiry is the state an expired grant is restored to
+	StateBeforeExpiry domain.UserGrantState
 }
 
 func NewUserGrantWriteModel(userGrantID string, resourceOwner string) *UserGrantWriteModel {
@@ -36,6 +42,20 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.ProjectGrantID = e.ProjectGrantID
 			wm.RoleKeys = e.RoleKeys
 			wm.State = domain.UserGrantStateActive
+		case *usergrant.UserGrantRequestedEvent:
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+			wm.ProjectGrantID = e.ProjectGrantID
+			wm.RoleKeys = e.RoleKeys
+			wm.State = domain.UserGrantStateRequested
+		case *usergrant.UserGrantApprovedEvent:
+			wm.State = domain.UserGrantStateActive
+		case *usergrant.UserGrantValidityChangedEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *usergrant.UserGrantExpiredEvent:
+			wm.StateBeforeExpiry = wm.State
+			wm.State = domain.UserGrantStateExpired
 		case *usergrant.UserGrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
 		case *usergrant.UserGrantCascadeChangedEvent:
@@ -54,6 +74,8 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.State = domain.UserGrantStateRemoved
 		case *usergrant.UserGrantCascadeRemovedEvent:
 			wm.State = domain.UserGrantStateRemoved
+		case *usergrant.UserGrantRejectedEvent:
+			wm.State = domain.UserGrantStateRemoved
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -70,7 +92,12 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
-			usergrant.UserGrantCascadeRemovedType).
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantValidityChangedType,
+			usergrant.UserGrantExpiredType,
+			usergrant.UserGrantRequestedType,
+			usergrant.UserGrantApprovedType,
+			usergrant.UserGrantRejectedType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -158,19 +185,26 @@ func (wm *UserGrantPreConditionReadModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
+// Query only checks the project (grant) and its roles if no UserID is set,
+// e.g. for grants to a group
 func (wm *UserGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder {
-	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent)
+	if wm.UserID != "" {
+		query = query.
+			AddQuery().
+			AggregateTypes(user.AggregateType).
+			AggregateIDs(wm.UserID).
+			EventTypes(
+				user.UserV1AddedType,
+				user.HumanAddedType,
+				user.UserV1RegisteredType,
+				user.HumanRegisteredType,
+				user.MachineAddedEventType,
+				user.UserRemovedType).
+			Builder()
+	}
+	return query.
 		AddQuery().
-		AggregateTypes(user.AggregateType).
-		AggregateIDs(wm.UserID).
-		EventTypes(
-			user.UserV1AddedType,
-			user.HumanAddedType,
-			user.UserV1RegisteredType,
-			user.HumanRegisteredType,
-			user.MachineAddedEventType,
-			user.UserRemovedType).
-		Or().
 		AggregateTypes(project.AggregateType).
 		AggregateIDs(wm.ProjectID).
 		EventTypes(
@@ -182,5 +216,4 @@ func (wm *UserGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder
 			project.RoleAddedType,
 			project.RoleRemovedType).
 		Builder()
-	return query
 }
@@ -6,6 +6,7 @@ import (
 
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -210,7 +211,7 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, passwordAlg cr
 				createCmd.AddPhoneData(human.Phone.Number)
 			}
 
-			if err := addHumanCommandPassword(ctx, filter, createCmd, human, passwordAlg); err != nil {
+			if err := addHumanCommandPassword(ctx, filter, createCmd, human, passwordAlg, c.breachedPasswords); err != nil {
 				return nil, err
 			}
 
@@ -316,9 +317,9 @@ func (c *Commands) addHumanCommandCheckID(ctx context.Context, filter preparatio
 	return nil
 }
 
-func addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, passwordAlg crypto.HashAlgorithm) (err error) {
+func addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, passwordAlg crypto.HashAlgorithm, breachedPasswords breach.Checker) (err error) {
 	if human.Password != "" {
-		if err = humanValidatePassword(ctx, filter, human.Password); err != nil {
+		if err = humanValidatePassword(ctx, filter, human.Password, breachedPasswords); err != nil {
 			return err
 		}
 
@@ -363,13 +364,16 @@ func userValidateDomain(ctx context.Context, a *user.Aggregate, username string,
 	return nil
 }
 
-func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
+func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string, breachedPasswords breach.Checker) error {
 	passwordComplexity, err := passwordComplexityPolicyWriteModel(ctx, filter)
 	if err != nil {
 		return err
 	}
 
-	return passwordComplexity.Validate(password)
+	if err = passwordComplexity.Validate(password); err != nil {
+		return err
+	}
+	return checkPasswordBreached(ctx, breachedPasswords, passwordComplexity.CheckBreached, password)
 }
 
 func (h *AddHuman) ensureDisplayName() {
@@ -581,6 +585,9 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 		if err := human.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg, human.Password.ChangeRequired); err != nil {
 			return nil, nil, err
 		}
+		if err := checkPasswordBreached(ctx, c.breachedPasswords, pwPolicy.CheckBreached, human.Password.SecretString); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	addedHuman = NewHumanWriteModel(human.AggregateID, orgID)
@@ -85,7 +85,7 @@ func (c *Commands) HumanVerifyInitCode(ctx context.Context, userID, resourceOwne
 			SecretString:   passwordString,
 			ChangeRequired: false,
 		}
-		passwordEvent, err := c.changePassword(ctx, "", password, userAgg, passwordWriteModel)
+		passwordEvent, err := c.changePassword(ctx, "", password, userAgg, passwordWriteModel, false)
 		if err != nil {
 			return err
 		}
@@ -571,6 +571,18 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordAgePolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
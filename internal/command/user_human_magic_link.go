@@ -0,0 +1,135 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// RequestMagicLink creates a one-time login link, which will be sent to the verified email address of the user.
+// The link can only be used once and only by the browser of the auth request.
+func (c *Commands) RequestMagicLink(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mlk1a", "Errors.User.UserIDMissing")
+	}
+	if authRequest == nil || authRequest.AgentID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mlk2b", "Errors.User.MagicLink.Invalid")
+	}
+	wm, err := c.magicLinkWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if err = checkMagicLinkAllowed(ctx, wm, c.getOrgLoginPolicy); err != nil {
+		return err
+	}
+	code, err := c.newMagicLinkCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeMagicLinkCode, c.userEncryption)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCodeAddedEvent(ctx,
+		UserAggregateFromWriteModel(&wm.WriteModel),
+		code.Crypted,
+		code.Expiry,
+		"",
+		false,
+		"",
+		authRequestDomainToAuthRequestInfo(authRequest),
+	))
+	return err
+}
+
+// HumanCheckMagicLink checks the code of the login link.
+// The check fails if the link was already used, is expired or is used by another browser than it was requested from.
+func (c *Commands) HumanCheckMagicLink(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mlk3c", "Errors.User.UserIDMissing")
+	}
+	if code == "" || authRequest == nil {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mlk4d", "Errors.User.MagicLink.Invalid")
+	}
+	if err = c.checkLoginThrottle(ctx, userID, authRequestRemoteIP(authRequest), false); err != nil {
+		return err
+	}
+	wm, err := c.magicLinkWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if wm.UserState == domain.UserStateUnspecified || wm.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk5e", "Errors.User.NotFound")
+	}
+	if wm.Code == nil {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Mlk6f", "Errors.User.MagicLink.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&wm.WriteModel)
+	info := authRequestDomainToAuthRequestInfo(authRequest)
+	err = verifyMagicLinkCode(ctx, c.eventstore.Filter, c.userEncryption, wm, code)
+	if err == nil && (wm.SessionID != "" || wm.UserAgentID != authRequest.AgentID || wm.AuthRequestID != authRequest.ID) {
+		err = caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk7g", "Errors.User.MagicLink.OtherBrowser")
+	}
+	if err != nil {
+		_, pushErr := c.eventstore.Push(ctx, user.NewHumanMagicLinkCheckFailedEvent(ctx, userAgg, info))
+		logging.WithFields("userID", userID).OnError(pushErr).Error("error create magic link check failed event")
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCheckSucceededEvent(ctx, userAgg, info))
+	return err
+}
+
+func (c *Commands) HumanMagicLinkCodeSent(ctx context.Context, orgID, userID string) (err error) {
+	wm, err := c.magicLinkWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if wm.UserState == domain.UserStateUnspecified || wm.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Mlk8h", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCodeSentEvent(ctx, UserAggregateFromWriteModel(&wm.WriteModel)))
+	return err
+}
+
+func (c *Commands) magicLinkWriteModel(ctx context.Context, userID, resourceOwner string) (*HumanMagicLinkWriteModel, error) {
+	wm := NewHumanMagicLinkWriteModel(userID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// checkMagicLinkAllowed checks if a login link can be sent to the user
+func checkMagicLinkAllowed(ctx context.Context, wm *HumanMagicLinkWriteModel, getLoginPolicy func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)) error {
+	if wm.UserState != domain.UserStateActive {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk9i", "Errors.User.NotFound")
+	}
+	loginPolicy, err := getLoginPolicy(ctx, wm.ResourceOwner)
+	if err != nil {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Mlk0j", "Errors.Org.LoginPolicy.NotFound")
+	}
+	if !loginPolicy.AllowMagicLink {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk1k", "Errors.Org.LoginPolicy.MagicLinkNotAllowed")
+	}
+	if !wm.IsEmailVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Mlk2l", "Errors.User.MagicLink.EmailNotVerified")
+	}
+	return nil
+}
+
+func verifyMagicLinkCode(ctx context.Context, filter preparation.FilterToQueryReducer, alg crypto.EncryptionAlgorithm, wm *HumanMagicLinkWriteModel, code string) error {
+	err := verifyCryptoCode(ctx, filter, domain.SecretGeneratorTypeMagicLinkCode, alg, wm.CodeCreationDate, wm.CodeExpiry, wm.Code, code)
+	if err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Mlk3m", "Errors.User.MagicLink.Invalid")
+	}
+	return nil
+}
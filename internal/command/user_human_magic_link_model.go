@@ -0,0 +1,122 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanMagicLinkWriteModel struct {
+	eventstore.WriteModel
+
+	IsEmailVerified bool
+	UserState       domain.UserState
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	UserAgentID      string
+	AuthRequestID    string
+	SessionID        string
+}
+
+func NewHumanMagicLinkWriteModel(userID, resourceOwner string) *HumanMagicLinkWriteModel {
+	return &HumanMagicLinkWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanMagicLinkWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanInitialCodeAddedEvent:
+			wm.UserState = domain.UserStateInitial
+		case *user.HumanInitializedCheckSucceededEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+			wm.resetCode()
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+			wm.resetCode()
+		case *user.UserReactivatedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanEmailChangedEvent:
+			// the link was sent to the previous address
+			wm.IsEmailVerified = false
+			wm.resetCode()
+		case *user.HumanEmailVerifiedEvent:
+			wm.IsEmailVerified = true
+		case *user.HumanMagicLinkCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+			wm.SessionID = e.SessionID
+			wm.UserAgentID = ""
+			wm.AuthRequestID = ""
+			if e.AuthRequestInfo != nil {
+				wm.UserAgentID = e.UserAgentID
+				wm.AuthRequestID = e.ID
+			}
+		case *user.HumanMagicLinkCheckSucceededEvent:
+			// a link can only be used once
+			wm.resetCode()
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanMagicLinkWriteModel) resetCode() {
+	wm.Code = nil
+	wm.CodeCreationDate = time.Time{}
+	wm.CodeExpiry = 0
+	wm.UserAgentID = ""
+	wm.AuthRequestID = ""
+	wm.SessionID = ""
+}
+
+func (wm *HumanMagicLinkWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1InitialCodeAddedType,
+			user.HumanInitialCodeAddedType,
+			user.UserV1InitializedCheckSucceededType,
+			user.HumanInitializedCheckSucceededType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserV1EmailChangedType,
+			user.HumanEmailChangedType,
+			user.UserV1EmailVerifiedType,
+			user.HumanEmailVerifiedType,
+			user.HumanMagicLinkCodeAddedType,
+			user.HumanMagicLinkCheckSucceededType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
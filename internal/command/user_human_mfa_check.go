@@ -0,0 +1,27 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// pushFailedMFACheck pushes the failed check event of an otp, u2f or passwordless check
+// and locks the user if the lockout policy limits the failed attempts and the limit is reached
+func (c *Commands) pushFailedMFACheck(ctx context.Context, userID, resourceOwner string, failedEvent eventstore.Command, lockoutPolicy *domain.LockoutPolicy) {
+	events := []eventstore.Command{failedEvent}
+	if lockoutPolicy != nil && lockoutPolicy.MaxMFAAttempts > 0 {
+		writeModel := NewHumanMFACheckWriteModel(userID, resourceOwner)
+		err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+		logging.WithFields("userID", userID).OnError(err).Warn("unable to get failed mfa checks")
+		if err == nil && writeModel.UserState != domain.UserStateLocked && writeModel.CheckFailedCount+1 >= lockoutPolicy.MaxMFAAttempts {
+			events = append(events, user.NewUserLockedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+		}
+	}
+	_, err := c.eventstore.Push(ctx, events...)
+	logging.WithFields("userID", userID, "resourceOwner", resourceOwner).OnError(err).Warn("could not push failed mfa check event")
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanMFACheckWriteModel counts the consecutive failed otp, u2f and passwordless checks of a user
+type HumanMFACheckWriteModel struct {
+	eventstore.WriteModel
+
+	UserState        domain.UserState
+	CheckFailedCount uint64
+}
+
+func NewHumanMFACheckWriteModel(userID, resourceOwner string) *HumanMFACheckWriteModel {
+	return &HumanMFACheckWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanMFACheckWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanAddedEvent,
+			*user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanOTPCheckFailedEvent,
+			*user.HumanU2FCheckFailedEvent,
+			*user.HumanPasswordlessCheckFailedEvent:
+			wm.CheckFailedCount += 1
+		case *user.HumanOTPCheckSucceededEvent,
+			*user.HumanU2FCheckSucceededEvent,
+			*user.HumanPasswordlessCheckSucceededEvent:
+			wm.CheckFailedCount = 0
+		case *user.UserUnlockedEvent:
+			wm.CheckFailedCount = 0
+			wm.UserState = domain.UserStateActive
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanMFACheckWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanMFAOTPCheckFailedType,
+			user.HumanMFAOTPCheckSucceededType,
+			user.HumanU2FTokenCheckFailedType,
+			user.HumanU2FTokenCheckSucceededType,
+			user.HumanPasswordlessTokenCheckFailedType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+			user.UserV1MFAOTPCheckFailedType,
+			user.UserV1MFAOTPCheckSucceededType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
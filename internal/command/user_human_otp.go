@@ -124,10 +124,13 @@ func (c *Commands) HumanCheckMFAOTPSetup(ctx context.Context, userID, code, user
 	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
 }
 
-func (c *Commands) HumanCheckMFAOTP(ctx context.Context, userID, code, resourceowner string, authRequest *domain.AuthRequest) error {
+func (c *Commands) HumanCheckMFAOTP(ctx context.Context, userID, code, resourceowner string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
 	if userID == "" {
 		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-8N9ds", "Errors.User.UserIDMissing")
 	}
+	if err := c.checkLoginThrottle(ctx, userID, authRequestRemoteIP(authRequest), false); err != nil {
+		return err
+	}
 	existingOTP, err := c.otpWriteModelByID(ctx, userID, resourceowner)
 	if err != nil {
 		return err
@@ -141,8 +144,7 @@ func (c *Commands) HumanCheckMFAOTP(ctx context.Context, userID, code, resourceo
 		_, err = c.eventstore.Push(ctx, user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
 		return err
 	}
-	_, pushErr := c.eventstore.Push(ctx, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
-	logging.Log("COMMAND-9fj7s").OnError(pushErr).Error("error create password check failed event")
+	c.pushFailedMFACheck(ctx, userID, resourceowner, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)), lockoutPolicy)
 	return err
 }
 
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/logging"
 
@@ -31,7 +32,7 @@ func (c *Commands) SetPassword(ctx context.Context, orgID, userID, passwordStrin
 		ChangeRequired: oneTime,
 	}
 	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
-	passwordEvent, err := c.changePassword(ctx, "", password, userAgg, existingPassword)
+	passwordEvent, err := c.changePassword(ctx, "", password, userAgg, existingPassword, false)
 	if err != nil {
 		return nil, err
 	}
@@ -47,27 +48,32 @@ func (c *Commands) SetPassword(ctx context.Context, orgID, userID, passwordStrin
 }
 
 func (c *Commands) SetPasswordWithVerifyCode(ctx context.Context, orgID, userID, code, passwordString, userAgentID string, passwordVerificationCode crypto.Generator) (err error) {
+	_, err = c.setPasswordWithVerifyCode(ctx, orgID, userID, code, passwordString, userAgentID, passwordVerificationCode)
+	return err
+}
+
+func (c *Commands) setPasswordWithVerifyCode(ctx context.Context, orgID, userID, code, passwordString, userAgentID string, passwordVerificationCode crypto.Generator) (_ *domain.ObjectDetails, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	if userID == "" {
-		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-3M9fs", "Errors.IDMissing")
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-3M9fs", "Errors.IDMissing")
 	}
 	if passwordString == "" {
-		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mf0sd", "Errors.User.Password.Empty")
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mf0sd", "Errors.User.Password.Empty")
 	}
 	existingCode, err := c.passwordWriteModel(ctx, userID, orgID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if existingCode.Code == nil || existingCode.UserState == domain.UserStateUnspecified || existingCode.UserState == domain.UserStateDeleted {
-		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-2M9fs", "Errors.User.Code.NotFound")
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-2M9fs", "Errors.User.Code.NotFound")
 	}
 
 	err = crypto.VerifyCode(existingCode.CodeCreationDate, existingCode.CodeExpiry, existingCode.Code, code, passwordVerificationCode)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	password := &domain.Password{
@@ -75,12 +81,19 @@ func (c *Commands) SetPasswordWithVerifyCode(ctx context.Context, orgID, userID,
 		ChangeRequired: false,
 	}
 	userAgg := UserAggregateFromWriteModel(&existingCode.WriteModel)
-	passwordEvent, err := c.changePassword(ctx, userAgentID, password, userAgg, existingCode)
+	passwordEvent, err := c.changePassword(ctx, userAgentID, password, userAgg, existingCode, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = c.eventstore.Push(ctx, passwordEvent)
-	return err
+	pushedEvents, err := c.eventstore.Push(ctx, passwordEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingCode, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingCode.WriteModel), nil
 }
 
 func (c *Commands) ChangePassword(ctx context.Context, orgID, userID, oldPassword, newPassword, userAgentID string) (objectDetails *domain.ObjectDetails, err error) {
@@ -113,7 +126,7 @@ func (c *Commands) ChangePassword(ctx context.Context, orgID, userID, oldPasswor
 	}
 
 	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
-	command, err := c.changePassword(ctx, userAgentID, password, userAgg, existingPassword)
+	command, err := c.changePassword(ctx, userAgentID, password, userAgg, existingPassword, true)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +141,10 @@ func (c *Commands) ChangePassword(ctx context.Context, orgID, userID, oldPasswor
 	return writeModelToObjectDetails(&existingPassword.WriteModel), nil
 }
 
-func (c *Commands) changePassword(ctx context.Context, userAgentID string, password *domain.Password, userAgg *eventstore.Aggregate, existingPassword *HumanPasswordWriteModel) (event eventstore.Command, err error) {
+// changePassword checks the new password against the password complexity and age policy of the organization.
+// The minimum age of the password is only checked for changes by the user itself (checkMinAge),
+// so an administrator or a password reset can always set a new password.
+func (c *Commands) changePassword(ctx context.Context, userAgentID string, password *domain.Password, userAgg *eventstore.Aggregate, existingPassword *HumanPasswordWriteModel, checkMinAge bool) (event eventstore.Command, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -142,12 +158,54 @@ func (c *Commands) changePassword(ctx context.Context, userAgentID string, passw
 	if err != nil {
 		return nil, err
 	}
+	agePolicy, err := c.getOrgPasswordAgePolicy(ctx, userAgg.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if checkMinAge {
+		if err := checkPasswordMinAge(agePolicy, existingPassword.SecretChangeDate); err != nil {
+			return nil, err
+		}
+	}
 	if err := password.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg); err != nil {
 		return nil, err
 	}
+	if err := c.checkPasswordHistory(ctx, agePolicy, existingPassword, password.SecretString); err != nil {
+		return nil, err
+	}
+	if err := checkPasswordBreached(ctx, c.breachedPasswords, pwPolicy.CheckBreached, password.SecretString); err != nil {
+		return nil, err
+	}
 	return user.NewHumanPasswordChangedEvent(ctx, userAgg, password.SecretCrypto, password.ChangeRequired, userAgentID), nil
 }
 
+// checkPasswordMinAge returns an error if the last password change is more recent than the minimum age of the policy
+func checkPasswordMinAge(policy *domain.PasswordAgePolicy, lastChange time.Time) error {
+	if policy.MinAgeDays == 0 || lastChange.IsZero() {
+		return nil
+	}
+	if time.Since(lastChange) < time.Duration(policy.MinAgeDays)*24*time.Hour {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pma2d", "Errors.User.Password.MinAgeNotReached")
+	}
+	return nil
+}
+
+// checkPasswordHistory returns an error if the password matches one of the previous passwords restricted by the policy
+func (c *Commands) checkPasswordHistory(ctx context.Context, policy *domain.PasswordAgePolicy, existingPassword *HumanPasswordWriteModel, password string) (err error) {
+	if policy.HistoryCount == 0 || password == "" {
+		return nil
+	}
+	ctx, span := tracing.NewNamedSpan(ctx, "crypto.CompareHash")
+	defer func() { span.EndWithError(err) }()
+
+	for _, secret := range existingPassword.PreviousSecrets(policy.HistoryCount) {
+		if crypto.CompareHash(secret, []byte(password), c.userPasswordAlg) == nil {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Phs2d", "Errors.User.Password.UsedBefore")
+		}
+	}
+	return nil
+}
+
 func (c *Commands) RequestSetPassword(ctx context.Context, userID, resourceOwner string, notifyType domain.NotificationType, passwordVerificationCode crypto.Generator) (objectDetails *domain.ObjectDetails, err error) {
 	if userID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-M00oL", "Errors.User.UserIDMissing")
@@ -223,6 +281,9 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	if password == "" {
 		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-3n8fs", "Errors.User.Password.Empty")
 	}
+	if err = c.checkLoginThrottle(ctx, userID, authRequestRemoteIP(authRequest), true); err != nil {
+		return err
+	}
 
 	loginPolicy, err := c.getOrgLoginPolicy(ctx, orgID)
 	if err != nil {
@@ -249,7 +310,11 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	err = crypto.CompareHash(existingPassword.Secret, []byte(password), c.userPasswordAlg)
 	spanPasswordComparison.EndWithError(err)
 	if err == nil {
-		_, err = c.eventstore.Push(ctx, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		events := []eventstore.Command{user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest))}
+		if c.isPasswordBreachedOnLogin(ctx, existingPassword, password) {
+			events = append(events, user.NewHumanPasswordBreachedEvent(ctx, userAgg))
+		}
+		_, err = c.eventstore.Push(ctx, events...)
 		return err
 	}
 	events := make([]eventstore.Command, 0)
@@ -265,6 +330,42 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	return caos_errs.ThrowInvalidArgument(nil, "COMMAND-452ad", "Errors.User.Password.Invalid")
 }
 
+// isPasswordBreachedOnLogin checks the password of the user against known data breaches if the password complexity policy requires it.
+// It only reports a breach once for the current password, so the user is not notified on every login.
+func (c *Commands) isPasswordBreachedOnLogin(ctx context.Context, existingPassword *HumanPasswordWriteModel, password string) bool {
+	if c.breachedPasswords == nil || existingPassword.PasswordBreached {
+		return false
+	}
+	pwPolicy, err := c.getOrgPasswordComplexityPolicy(ctx, existingPassword.ResourceOwner)
+	if err != nil {
+		logging.WithFields("userID", existingPassword.AggregateID).OnError(err).Warn("unable to get password complexity policy for breached password check")
+		return false
+	}
+	if !pwPolicy.CheckBreached {
+		return false
+	}
+	breached, err := c.breachedPasswords.IsBreached(ctx, password)
+	logging.WithFields("userID", existingPassword.AggregateID).OnError(err).Warn("unable to check if password is breached")
+	return breached
+}
+
+func (c *Commands) PasswordBreachSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Brs2d", "Errors.User.UserIDMissing")
+	}
+
+	existingPassword, err := c.passwordWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingPassword.UserState == domain.UserStateUnspecified || existingPassword.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Brs3d", "Errors.User.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordBreachSentEvent(ctx, userAgg))
+	return err
+}
+
 func (c *Commands) passwordWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPasswordWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -15,11 +15,16 @@ type HumanPasswordWriteModel struct {
 
 	Secret               *crypto.CryptoValue
 	SecretChangeRequired bool
+	SecretChangeDate     time.Time
+	// SecretHistory contains all hashes of the passwords set on the user, the current one last
+	SecretHistory []*crypto.CryptoValue
 
 	Code                     *crypto.CryptoValue
 	CodeCreationDate         time.Time
 	CodeExpiry               time.Duration
 	PasswordCheckFailedCount uint64
+	// PasswordBreached is set if the current password was found in known data breaches on login
+	PasswordBreached bool
 
 	UserState domain.UserState
 }
@@ -40,10 +45,14 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.Secret = e.Secret
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.PasswordBreached = false
+			wm.appendSecretHistory(e.Secret, e.CreationDate())
 		case *user.HumanRegisteredEvent:
 			wm.Secret = e.Secret
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.PasswordBreached = false
+			wm.appendSecretHistory(e.Secret, e.CreationDate())
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
 		case *user.HumanInitializedCheckSucceededEvent:
@@ -53,6 +62,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
 			wm.PasswordCheckFailedCount = 0
+			wm.PasswordBreached = false
+			wm.appendSecretHistory(e.Secret, e.CreationDate())
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.Code = e.Code
 			wm.CodeCreationDate = e.CreationDate()
@@ -65,6 +76,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.PasswordCheckFailedCount += 1
 		case *user.HumanPasswordCheckSucceededEvent:
 			wm.PasswordCheckFailedCount = 0
+		case *user.HumanPasswordBreachedEvent:
+			wm.PasswordBreached = true
 		case *user.UserUnlockedEvent:
 			wm.PasswordCheckFailedCount = 0
 		case *user.UserRemovedEvent:
@@ -74,6 +87,22 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
+func (wm *HumanPasswordWriteModel) appendSecretHistory(secret *crypto.CryptoValue, changeDate time.Time) {
+	if secret == nil {
+		return
+	}
+	wm.SecretHistory = append(wm.SecretHistory, secret)
+	wm.SecretChangeDate = changeDate
+}
+
+// PreviousSecrets returns the hashes of the last count passwords, including the current one
+func (wm *HumanPasswordWriteModel) PreviousSecrets(count uint64) []*crypto.CryptoValue {
+	if count >= uint64(len(wm.SecretHistory)) {
+		return wm.SecretHistory
+	}
+	return wm.SecretHistory[uint64(len(wm.SecretHistory))-count:]
+}
+
 func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		AddQuery().
@@ -88,6 +117,7 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanEmailVerifiedType,
 			user.HumanPasswordCheckFailedType,
 			user.HumanPasswordCheckSucceededType,
+			user.HumanPasswordBreachedType,
 			user.UserRemovedType,
 			user.UserUnlockedType,
 			user.UserV1AddedType,
@@ -357,7 +357,10 @@ func (c *Commands) beginWebAuthNLogin(ctx context.Context, userID, resourceOwner
 	return userAgg, webAuthNLogin, nil
 }
 
-func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest) error {
+func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
+	if err := c.checkLoginThrottle(ctx, userID, authRequestRemoteIP(authRequest), false); err != nil {
+		return err
+	}
 	webAuthNLogin, err := c.getHumanU2FLogin(ctx, userID, authRequest.ID, resourceOwner)
 	if err != nil {
 		return err
@@ -373,14 +376,14 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			logging.WithFields("userID", userID, "resourceOwner", resourceOwner).WithError(err).Warn("missing userAggregate for pushing failed u2f check event")
 			return err
 		}
-		_, pushErr := c.eventstore.Push(ctx,
+		c.pushFailedMFACheck(ctx, userID, resourceOwner,
 			usr_repo.NewHumanU2FCheckFailedEvent(
 				ctx,
 				userAgg,
 				authRequestDomainToAuthRequestInfo(authRequest),
 			),
+			lockoutPolicy,
 		)
-		logging.WithFields("userID", userID, "resourceOwner", resourceOwner).OnError(pushErr).Warn("could not push failed u2f check event")
 		return err
 	}
 
@@ -401,7 +404,10 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 	return err
 }
 
-func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest) error {
+func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
+	if err := c.checkLoginThrottle(ctx, userID, authRequestRemoteIP(authRequest), false); err != nil {
+		return err
+	}
 	webAuthNLogin, err := c.getHumanPasswordlessLogin(ctx, userID, authRequest.ID, resourceOwner)
 	if err != nil {
 		return err
@@ -418,14 +424,14 @@ func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, res
 			logging.WithFields("userID", userID, "resourceOwner", resourceOwner).WithError(err).Warn("missing userAggregate for pushing failed passwordless check event")
 			return err
 		}
-		_, pushErr := c.eventstore.Push(ctx,
+		c.pushFailedMFACheck(ctx, userID, resourceOwner,
 			usr_repo.NewHumanPasswordlessCheckFailedEvent(
 				ctx,
 				userAgg,
 				authRequestDomainToAuthRequestInfo(authRequest),
 			),
+			lockoutPolicy,
 		)
-		logging.WithFields("userID", userID, "resourceOwner", resourceOwner).OnError(pushErr).Warn("could not push failed passwordless check event")
 		return err
 	}
 
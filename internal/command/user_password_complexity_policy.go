@@ -3,7 +3,10 @@ package command
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/errors"
 )
@@ -47,3 +50,24 @@ func defaultPasswordComplexityPolicy(ctx context.Context, filter preparation.Fil
 	err = policy.Reduce()
 	return &policy.PasswordComplexityPolicyWriteModel, err
 }
+
+// checkPasswordBreached returns an error if the policy requires the check and the password was found in known data breaches.
+// If the checker is not configured or unavailable, the password is accepted.
+func checkPasswordBreached(ctx context.Context, checker breach.Checker, checkBreached bool, password string) error {
+	if !checkBreached || password == "" {
+		return nil
+	}
+	if checker == nil {
+		logging.Warn("password complexity policy requires breached password check, but no checker is configured")
+		return nil
+	}
+	breached, err := checker.IsBreached(ctx, password)
+	if err != nil {
+		logging.WithError(err).Warn("unable to check if password is breached")
+		return nil
+	}
+	if breached {
+		return errors.ThrowInvalidArgument(nil, "COMMAND-Brc3d", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
@@ -57,6 +58,7 @@ func Test_customPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					}, nil
 				},
@@ -134,6 +136,7 @@ func Test_defaultPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					}, nil
 				},
@@ -202,6 +205,7 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					}, nil
 				},
@@ -253,6 +257,7 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						}, nil
 					}).
@@ -298,3 +303,85 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 		})
 	}
 }
+
+// mockBreachChecker reports the passwords of the map as breached
+type mockBreachChecker map[string]bool
+
+func (m mockBreachChecker) IsBreached(_ context.Context, password string) (bool, error) {
+	return m[password], nil
+}
+
+type failingBreachChecker struct{}
+
+func (failingBreachChecker) IsBreached(context.Context, string) (bool, error) {
+	return false, errors.ThrowUnavailable(nil, "COMMAND-Fbc2s", "unavailable")
+}
+
+func Test_checkPasswordBreached(t *testing.T) {
+	type args struct {
+		checker       breach.Checker
+		checkBreached bool
+		password      string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr func(error) bool
+	}{
+		{
+			name: "check disabled",
+			args: args{
+				checker:       mockBreachChecker{"password": true},
+				checkBreached: false,
+				password:      "password",
+			},
+		},
+		{
+			name: "no checker configured",
+			args: args{
+				checker:       nil,
+				checkBreached: true,
+				password:      "password",
+			},
+		},
+		{
+			name: "checker unavailable",
+			args: args{
+				checker:       failingBreachChecker{},
+				checkBreached: true,
+				password:      "password",
+			},
+		},
+		{
+			name: "not breached",
+			args: args{
+				checker:       mockBreachChecker{"password": true},
+				checkBreached: true,
+				password:      "Corr3ct-Horse-Battery-Stapl3",
+			},
+		},
+		{
+			name: "breached",
+			args: args{
+				checker:       mockBreachChecker{"password": true},
+				checkBreached: true,
+				password:      "password",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPasswordBreached(context.Background(), tt.args.checker, tt.args.checkBreached, tt.args.password)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("checkPasswordBreached() unexpected error = %v", err)
+				}
+				return
+			}
+			if !tt.wantErr(err) {
+				t.Errorf("checkPasswordBreached() wrong error = %v", err)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/member"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
 )
 
@@ -1381,6 +1383,177 @@ func TestCommandSide_RemoveUser(t *testing.T) {
 	}
 }
 
+func TestCommandSide_RemoveMyUser(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx        context.Context
+		sessionIDs []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "self delete not allowed, permission denied error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newHumanAddedEventV2("user1", "org1"),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewPrivacyPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								"TOSLink",
+								"PrivacyLink",
+								"HelpLink",
+								"support@example.com",
+								false,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx: authz.NewMockContext("instance1", "org1", "user1"),
+			},
+			res: res{
+				err: errors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "self delete allowed by policy, sessions terminated, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newHumanAddedEventV2("user1", "org1"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPrivacyPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"TOSLink",
+								"PrivacyLink",
+								"HelpLink",
+								"support@example.com",
+								true,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session1", "instance1").Aggregate),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session1", "instance1").Aggregate,
+								"user1", time.Now(),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate,
+								"user2", time.Now(),
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewUserRemovedEvent(authz.NewMockContext("instance1", "org1", "user1"),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									"username",
+									nil,
+									true,
+								),
+							),
+							eventFromEventPusher(
+								session.NewTerminateEvent(authz.NewMockContext("instance1", "org1", "user1"), &session.NewAggregate("session1", "instance1").Aggregate),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(user.NewRemoveUsernameUniqueConstraint("username", "org1", true)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:        authz.NewMockContext("instance1", "org1", "user1"),
+				sessionIDs: []string{"session1", "session2"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.RemoveMyUser(tt.args.ctx, tt.args.sessionIDs, nil)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_AddUserToken(t *testing.T) {
 	type fields struct {
 		eventstore  *eventstore.Eventstore
@@ -0,0 +1,244 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// The V2 commands resolve the organisation of the user from the eventstore
+// instead of relying on the organisation of the caller
+// and check the permission of the caller on that organisation.
+
+func (c *Commands) LockUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.LockUser(ctx, userID, resourceOwner)
+}
+
+func (c *Commands) UnlockUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.UnlockUser(ctx, userID, resourceOwner)
+}
+
+func (c *Commands) DeactivateUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.DeactivateUser(ctx, userID, resourceOwner)
+}
+
+func (c *Commands) ReactivateUserV2(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.ReactivateUser(ctx, userID, resourceOwner)
+}
+
+func (c *Commands) RemoveUserV2(ctx context.Context, userID string, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserDelete)
+	if err != nil {
+		return nil, err
+	}
+	return c.RemoveUser(ctx, userID, resourceOwner, cascadingUserMemberships, cascadingGrantIDs...)
+}
+
+func (c *Commands) ChangeUsernameV2(ctx context.Context, userID, username string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.ChangeUsername(ctx, resourceOwner, userID, username)
+}
+
+// ChangeHumanProfileV2 changes the profile of the user with the ID of the profile's aggregate.
+func (c *Commands) ChangeHumanProfileV2(ctx context.Context, profile *domain.Profile) (*domain.Profile, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, profile.AggregateID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	profile.ResourceOwner = resourceOwner
+	return c.ChangeHumanProfile(ctx, profile)
+}
+
+// ChangeMachineV2 changes the name and description of a machine user.
+// Fields which are not passed keep their current value.
+func (c *Commands) ChangeMachineV2(ctx context.Context, userID string, name, description *string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mc2va", "Errors.User.UserIDMissing")
+	}
+	existingMachine, err := getMachineWriteModel(ctx, userID, "", c.eventstore.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingMachine.UserState) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Mc3nf", "Errors.User.NotFound")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionUserWrite, existingMachine.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	machine := &Machine{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   userID,
+			ResourceOwner: existingMachine.ResourceOwner,
+		},
+		Name:            existingMachine.Name,
+		Description:     existingMachine.Description,
+		AccessTokenType: existingMachine.AccessTokenType,
+	}
+	if name != nil {
+		machine.Name = *name
+	}
+	if description != nil {
+		machine.Description = *description
+	}
+	return c.ChangeMachine(ctx, machine)
+}
+
+// ChangeUserPhone sets the phone number of a user.
+// If the number is not marked as verified, a code is generated and sent to the user.
+func (c *Commands) ChangeUserPhone(ctx context.Context, userID, phone string, verified bool, alg crypto.EncryptionAlgorithm) (*domain.Phone, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	gen, err := c.userCodeGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, alg)
+	if err != nil {
+		return nil, err
+	}
+	return c.ChangeHumanPhone(ctx,
+		&domain.Phone{
+			ObjectRoot:      models.ObjectRoot{AggregateID: userID},
+			PhoneNumber:     domain.PhoneNumber(phone),
+			IsPhoneVerified: verified,
+		},
+		resourceOwner,
+		gen,
+	)
+}
+
+// VerifyUserPhone verifies the phone number of a user with the code sent to it.
+// The code itself proves the possession of the phone, so no permission is checked.
+func (c *Commands) VerifyUserPhone(ctx context.Context, userID, code string, alg crypto.EncryptionAlgorithm) (*domain.ObjectDetails, error) {
+	gen, err := c.userCodeGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, alg)
+	if err != nil {
+		return nil, err
+	}
+	return c.VerifyHumanPhone(ctx, userID, code, "", gen)
+}
+
+func (c *Commands) ResendUserPhoneCode(ctx context.Context, userID string, alg crypto.EncryptionAlgorithm) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	gen, err := c.userCodeGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, alg)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateHumanPhoneVerificationCode(ctx, userID, resourceOwner, gen)
+}
+
+func (c *Commands) RemoveUserPhone(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.RemoveHumanPhone(ctx, userID, resourceOwner)
+}
+
+// SetPasswordV2 sets a new password of a user without knowing the current one.
+func (c *Commands) SetPasswordV2(ctx context.Context, userID, password string, changeRequired bool) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.SetPassword(ctx, resourceOwner, userID, password, changeRequired)
+}
+
+// ChangePasswordV2 sets a new password of a user, which is verified by the current password.
+func (c *Commands) ChangePasswordV2(ctx context.Context, userID, currentPassword, newPassword string) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.ChangePassword(ctx, resourceOwner, userID, currentPassword, newPassword, authz.GetCtxData(ctx).AgentID)
+}
+
+// SetPasswordWithVerifyCodeV2 sets a new password of a user, which is verified by the code of a password reset.
+// The code itself proves the password reset was requested for the user, so no permission is checked.
+func (c *Commands) SetPasswordWithVerifyCodeV2(ctx context.Context, userID, code, password string, alg crypto.EncryptionAlgorithm) (*domain.ObjectDetails, error) {
+	gen, err := c.userCodeGenerator(ctx, domain.SecretGeneratorTypePasswordResetCode, alg)
+	if err != nil {
+		return nil, err
+	}
+	return c.setPasswordWithVerifyCode(ctx, "", userID, code, password, authz.GetCtxData(ctx).AgentID, gen)
+}
+
+// RequestPasswordReset generates a password reset code, which is sent to the user by the notification type.
+func (c *Commands) RequestPasswordReset(ctx context.Context, userID string, notifyType domain.NotificationType, alg crypto.EncryptionAlgorithm) (*domain.ObjectDetails, error) {
+	resourceOwner, err := c.checkSelfOrUserPermission(ctx, userID, domain.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	gen, err := c.userCodeGenerator(ctx, domain.SecretGeneratorTypePasswordResetCode, alg)
+	if err != nil {
+		return nil, err
+	}
+	return c.RequestSetPassword(ctx, userID, resourceOwner, notifyType, gen)
+}
+
+// checkUserPermission returns the organisation of the user
+// if the caller has the permission on the user.
+func (c *Commands) checkUserPermission(ctx context.Context, userID, permission string) (string, error) {
+	resourceOwner, err := c.existingUserResourceOwner(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err = c.checkPermission(ctx, permission, resourceOwner, userID); err != nil {
+		return "", err
+	}
+	return resourceOwner, nil
+}
+
+// checkSelfOrUserPermission returns the organisation of the user
+// if the caller is the user itself or has the permission on the user.
+func (c *Commands) checkSelfOrUserPermission(ctx context.Context, userID, permission string) (string, error) {
+	if userID != "" && authz.GetCtxData(ctx).UserID == userID {
+		return c.existingUserResourceOwner(ctx, userID)
+	}
+	return c.checkUserPermission(ctx, userID, permission)
+}
+
+func (c *Commands) existingUserResourceOwner(ctx context.Context, userID string) (string, error) {
+	if userID == "" {
+		return "", caos_errs.ThrowInvalidArgument(nil, "COMMAND-Us2ma", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, "")
+	if err != nil {
+		return "", err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return "", caos_errs.ThrowNotFound(nil, "COMMAND-Us3nf", "Errors.User.NotFound")
+	}
+	return existingUser.ResourceOwner, nil
+}
+
+func (c *Commands) userCodeGenerator(ctx context.Context, typ domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm) (crypto.Generator, error) {
+	config, err := secretGeneratorConfig(ctx, c.eventstore.Filter, typ)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewEncryptionGenerator(*config, alg), nil
+}
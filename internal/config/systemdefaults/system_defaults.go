@@ -3,7 +3,10 @@ package systemdefaults
 import (
 	"time"
 
+	"github.com/zitadel/zitadel/internal/breach"
+	"github.com/zitadel/zitadel/internal/bruteforce"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/risk"
 )
 
 type SystemDefaults struct {
@@ -12,12 +15,17 @@ type SystemDefaults struct {
 	DomainVerification DomainVerification
 	Notifications      Notifications
 	KeyConfig          KeyConfig
+	BreachedPasswords  breach.Config
+	LoginRisk          risk.Config
+	LoginThrottle      bruteforce.Config
 }
 
 type SecretGenerators struct {
 	PasswordSaltCost   int
 	MachineKeySize     uint32
 	ApplicationKeySize uint32
+	// MagicLinkCode is used if the instance has no own configuration
+	MagicLinkCode *crypto.GeneratorConfig
 }
 
 type MultifactorConfig struct {
@@ -2,6 +2,7 @@ package database
 
 import (
 	"database/sql"
+	"errors"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -82,6 +83,9 @@ func (d *database) ReadKey(id string) (*crypto.Key, error) {
 	}
 	var encryptionKey string
 	err = row.Scan(&encryptionKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, caos_errs.ThrowNotFound(err, "", "key not found")
+	}
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "", "unable to read key")
 	}
@@ -125,6 +129,21 @@ func (d *database) CreateKeys(keys ...*crypto.Key) error {
 	return nil
 }
 
+func (d *database) DeleteKeys(ids ...string) error {
+	stmt, args, err := sq.Delete(EncryptionKeysTable).
+		Where(sq.Eq{encryptionKeysIDCol: ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "", "unable to delete keys")
+	}
+	_, err = d.client.Exec(stmt, args...)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "", "unable to delete keys")
+	}
+	return nil
+}
+
 func checkMasterKeyLength(masterKey string) error {
 	if length := len([]byte(masterKey)); length != 32 {
 		return caos_errs.ThrowInternalf(nil, "", "masterkey must be 32 bytes, but is %d", length)
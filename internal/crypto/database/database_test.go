@@ -186,7 +186,7 @@ func Test_database_ReadKey(t *testing.T) {
 				id: "id1",
 			},
 			res{
-				err: caos_errs.IsInternal,
+				err: caos_errs.IsNotFound,
 			},
 		},
 		{
@@ -296,8 +296,8 @@ func Test_database_CreateKeys(t *testing.T) {
 			args{
 				keys: []*crypto.Key{
 					{
-						"id1",
-						"key1",
+						ID:    "id1",
+						Value: "key1",
 					},
 				},
 			},
@@ -321,8 +321,8 @@ func Test_database_CreateKeys(t *testing.T) {
 			args{
 				keys: []*crypto.Key{
 					{
-						"id1",
-						"key1",
+						ID:    "id1",
+						Value: "key1",
 					},
 				},
 			},
@@ -348,8 +348,8 @@ func Test_database_CreateKeys(t *testing.T) {
 			args{
 				keys: []*crypto.Key{
 					{
-						"id1",
-						"key1",
+						ID:    "id1",
+						Value: "key1",
 					},
 				},
 			},
@@ -373,12 +373,12 @@ func Test_database_CreateKeys(t *testing.T) {
 			args{
 				keys: []*crypto.Key{
 					{
-						"id1",
-						"key1",
+						ID:    "id1",
+						Value: "key1",
 					},
 					{
-						"id2",
-						"key2",
+						ID:    "id2",
+						Value: "key2",
 					},
 				},
 			},
@@ -407,6 +407,85 @@ func Test_database_CreateKeys(t *testing.T) {
 	}
 }
 
+func Test_database_DeleteKeys(t *testing.T) {
+	type fields struct {
+		client db
+	}
+	type args struct {
+		ids []string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"delete fails, error",
+			fields{
+				client: dbMock(t,
+					expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1)", sql.ErrConnDone, "id1"),
+				),
+			},
+			args{
+				ids: []string{"id1"},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, sql.ErrConnDone)
+				},
+			},
+		},
+		{
+			"single delete ok",
+			fields{
+				client: dbMock(t,
+					expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1)", nil, "id1"),
+				),
+			},
+			args{
+				ids: []string{"id1"},
+			},
+			res{
+				err: nil,
+			},
+		},
+		{
+			"multiple delete ok",
+			fields{
+				client: dbMock(t,
+					expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1,$2)", nil, "id1", "id2"),
+				),
+			},
+			args{
+				ids: []string{"id1", "id2"},
+			},
+			res{
+				err: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &database{
+				client: tt.fields.client.db,
+			}
+			err := d.DeleteKeys(tt.args.ids...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			} else if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+			if err := tt.fields.client.mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func Test_checkMasterKeyLength(t *testing.T) {
 	type args struct {
 		masterKey string
@@ -4,4 +4,5 @@ type KeyStorage interface {
 	ReadKeys() (Keys, error)
 	ReadKey(id string) (*Key, error)
 	CreateKeys(...*Key) error
+	DeleteKeys(ids ...string) error
 }
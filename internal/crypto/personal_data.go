@@ -0,0 +1,270 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	personalDataKeyPrefix = "personal_data."
+	// the tombstone of a destroyed data key prevents that the key is created again
+	personalDataTombstonePrefix = "personal_data_destroyed."
+	personalDataTombstoneValue  = "destroyed"
+	// personalDataKeyLifetime defines how long a loaded data key is cached.
+	// The cached keys of an instance are evicted as soon as a key of the instance was destroyed on any node,
+	// the lifetime only limits the time a destroyed key is used by nodes which are not notified.
+	personalDataKeyLifetime = time.Minute
+)
+
+// PersonalDataEncryption encrypts the personal data in the payload of events.
+// Every aggregate (e.g. a user) has its own data key, which is stored in the [KeyStorage].
+// Destroying the key makes the personal data of the aggregate unreadable (crypto-shredding).
+type PersonalDataEncryption struct {
+	storage KeyStorage
+
+	fieldsMutex sync.RWMutex
+	fields      map[string][]string
+
+	keysMutex sync.Mutex
+	keys      map[string]*personalDataKey
+}
+
+type personalDataKey struct {
+	value     string
+	destroyed bool
+	loadedAt  time.Time
+}
+
+// encryptedPersonalData replaces the value of an encrypted field in the payload
+type encryptedPersonalData struct {
+	PersonalData string `json:"personalData"`
+}
+
+func NewPersonalDataEncryption(storage KeyStorage) *PersonalDataEncryption {
+	return &PersonalDataEncryption{
+		storage: storage,
+		fields:  make(map[string][]string),
+		keys:    make(map[string]*personalDataKey),
+	}
+}
+
+// RegisterFields registers the (json) fields of the payload of the event type which contain personal data.
+func (p *PersonalDataEncryption) RegisterFields(eventType string, fields ...string) {
+	p.fieldsMutex.Lock()
+	defer p.fieldsMutex.Unlock()
+	p.fields[eventType] = fields
+}
+
+// EncryptPayload encrypts the registered fields of the payload with the data key of the aggregate.
+// The data key is created if it does not exist yet.
+func (p *PersonalDataEncryption) EncryptPayload(instanceID, aggregateID, eventType string, payload []byte) ([]byte, error) {
+	fields := p.registeredFields(eventType)
+	if len(fields) == 0 || len(payload) == 0 {
+		return payload, nil
+	}
+	data := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, errors.ThrowInternal(err, "CRYPT-Pd2ma", "unable to unmarshal payload")
+	}
+	var key string
+	for _, field := range fields {
+		value, ok := data[field]
+		if !ok {
+			continue
+		}
+		if key == "" {
+			var err error
+			if key, err = p.ensureKey(personalDataKeyID(instanceID, aggregateID)); err != nil {
+				return nil, err
+			}
+		}
+		encrypted, err := EncryptAES(value, key)
+		if err != nil {
+			return nil, err
+		}
+		data[field], err = json.Marshal(&encryptedPersonalData{PersonalData: base64.RawURLEncoding.EncodeToString(encrypted)})
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "CRYPT-Pd3ma", "unable to marshal personal data")
+		}
+	}
+	return json.Marshal(data)
+}
+
+// DecryptPayload decrypts the encrypted fields of the payload with the data key of the aggregate.
+// If the data key was destroyed, the fields are removed from the payload,
+// so they will be mapped to their zero values.
+func (p *PersonalDataEncryption) DecryptPayload(instanceID, aggregateID, eventType string, payload []byte) ([]byte, error) {
+	fields := p.registeredFields(eventType)
+	if len(fields) == 0 || len(payload) == 0 {
+		return payload, nil
+	}
+	data := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, errors.ThrowInternal(err, "CRYPT-Pd4ma", "unable to unmarshal payload")
+	}
+	var (
+		key       string
+		destroyed bool
+		changed   bool
+	)
+	for _, field := range fields {
+		encrypted, ok := encryptedField(data[field])
+		if !ok {
+			continue
+		}
+		changed = true
+		if key == "" && !destroyed {
+			var err error
+			key, err = p.loadKey(personalDataKeyID(instanceID, aggregateID))
+			if errors.IsNotFound(err) {
+				destroyed = true
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if destroyed {
+			delete(data, field)
+			continue
+		}
+		value, err := DecryptAES(encrypted, key)
+		if err != nil {
+			delete(data, field)
+			continue
+		}
+		data[field] = value
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(data)
+}
+
+// DestroyKey removes the data key of the aggregate,
+// so its personal data can no longer be decrypted.
+// A tombstone is stored before the key is removed, so the key is never created again.
+func (p *PersonalDataEncryption) DestroyKey(instanceID, aggregateID string) error {
+	keyID := personalDataKeyID(instanceID, aggregateID)
+	err := p.storage.CreateKeys(&Key{ID: personalDataTombstoneID(keyID), Value: personalDataTombstoneValue})
+	if err != nil {
+		// the key might have been destroyed before
+		if destroyed, _ := p.isDestroyed(keyID); !destroyed {
+			return err
+		}
+	}
+	p.keysMutex.Lock()
+	p.keys[keyID] = &personalDataKey{destroyed: true, loadedAt: time.Now()}
+	p.keysMutex.Unlock()
+	return p.storage.DeleteKeys(keyID)
+}
+
+// EvictKeys removes the cached data keys of the instance,
+// so they are read from the [KeyStorage] on the next use.
+// It's called if a data key of the instance was destroyed on another node.
+func (p *PersonalDataEncryption) EvictKeys(instanceID string) {
+	prefix := personalDataKeyID(instanceID, "")
+	p.keysMutex.Lock()
+	defer p.keysMutex.Unlock()
+	for keyID := range p.keys {
+		if strings.HasPrefix(keyID, prefix) {
+			delete(p.keys, keyID)
+		}
+	}
+}
+
+func (p *PersonalDataEncryption) registeredFields(eventType string) []string {
+	p.fieldsMutex.RLock()
+	defer p.fieldsMutex.RUnlock()
+	return p.fields[eventType]
+}
+
+func (p *PersonalDataEncryption) ensureKey(keyID string) (string, error) {
+	key, err := p.loadKey(keyID)
+	if err == nil || !errors.IsNotFound(err) {
+		return key, err
+	}
+	if destroyed, err := p.isDestroyed(keyID); err != nil || destroyed {
+		return "", errors.ThrowPreconditionFailed(err, "CRYPT-Pd5ma", "personal data key was destroyed")
+	}
+	newKey, err := NewKey(keyID)
+	if err != nil {
+		return "", err
+	}
+	if err = p.storage.CreateKeys(newKey); err != nil {
+		// the key might have been created concurrently
+		return p.loadKey(keyID)
+	}
+	// the key might have been destroyed concurrently,
+	// the tombstone is always stored before the key is deleted
+	if destroyed, err := p.isDestroyed(keyID); err != nil {
+		return "", err
+	} else if destroyed {
+		err = p.storage.DeleteKeys(keyID)
+		logging.WithFields("keyID", keyID).OnError(err).Error("unable to delete personal data key of destroyed key")
+		return "", errors.ThrowPreconditionFailed(nil, "CRYPT-Pd6ma", "personal data key was destroyed")
+	}
+	p.cacheKey(keyID, newKey.Value)
+	return newKey.Value, nil
+}
+
+func (p *PersonalDataEncryption) loadKey(keyID string) (string, error) {
+	p.keysMutex.Lock()
+	cached, ok := p.keys[keyID]
+	p.keysMutex.Unlock()
+	if ok && time.Since(cached.loadedAt) < personalDataKeyLifetime {
+		if cached.destroyed {
+			return "", errors.ThrowNotFound(nil, "CRYPT-Pd7ma", "personal data key was destroyed")
+		}
+		return cached.value, nil
+	}
+	key, err := p.storage.ReadKey(keyID)
+	if err != nil {
+		return "", err
+	}
+	p.cacheKey(keyID, key.Value)
+	return key.Value, nil
+}
+
+// isDestroyed checks if the tombstone of the key exists
+func (p *PersonalDataEncryption) isDestroyed(keyID string) (bool, error) {
+	_, err := p.storage.ReadKey(personalDataTombstoneID(keyID))
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (p *PersonalDataEncryption) cacheKey(keyID, value string) {
+	p.keysMutex.Lock()
+	defer p.keysMutex.Unlock()
+	p.keys[keyID] = &personalDataKey{value: value, loadedAt: time.Now()}
+}
+
+func encryptedField(value json.RawMessage) ([]byte, bool) {
+	if len(value) == 0 || value[0] != '{' {
+		return nil, false
+	}
+	encrypted := new(encryptedPersonalData)
+	if err := json.Unmarshal(value, encrypted); err != nil || encrypted.PersonalData == "" {
+		return nil, false
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encrypted.PersonalData)
+	if err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
+func personalDataKeyID(instanceID, aggregateID string) string {
+	return personalDataKeyPrefix + instanceID + "." + aggregateID
+}
+
+func personalDataTombstoneID(keyID string) string {
+	return personalDataTombstonePrefix + strings.TrimPrefix(keyID, personalDataKeyPrefix)
+}
@@ -0,0 +1,171 @@
+package crypto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+type memoryKeyStorage struct {
+	keys Keys
+}
+
+func (s *memoryKeyStorage) ReadKeys() (Keys, error) {
+	return s.keys, nil
+}
+
+func (s *memoryKeyStorage) ReadKey(id string) (*Key, error) {
+	value, ok := s.keys[id]
+	if !ok {
+		return nil, errors.ThrowNotFound(nil, "", "key not found")
+	}
+	return &Key{ID: id, Value: value}, nil
+}
+
+func (s *memoryKeyStorage) CreateKeys(keys ...*Key) error {
+	for _, key := range keys {
+		if _, ok := s.keys[key.ID]; ok {
+			return errors.ThrowAlreadyExists(nil, "", "key already exists")
+		}
+		s.keys[key.ID] = key.Value
+	}
+	return nil
+}
+
+func (s *memoryKeyStorage) DeleteKeys(ids ...string) error {
+	for _, id := range ids {
+		delete(s.keys, id)
+	}
+	return nil
+}
+
+func newTestPersonalDataEncryption() (*PersonalDataEncryption, *memoryKeyStorage) {
+	storage := &memoryKeyStorage{keys: make(Keys)}
+	encryption := NewPersonalDataEncryption(storage)
+	encryption.RegisterFields("user.human.added", "firstName", "email")
+	return encryption, storage
+}
+
+func TestPersonalDataEncryption_EncryptPayload(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+
+	payload := []byte(`{"userName":"username","firstName":"Gigi","email":"gigi@example.com"}`)
+	encrypted, err := encryption.EncryptPayload("instance1", "user1", "user.human.added", payload)
+	require.NoError(t, err)
+
+	data := make(map[string]json.RawMessage)
+	require.NoError(t, json.Unmarshal(encrypted, &data))
+	assert.JSONEq(t, `"username"`, string(data["userName"]))
+	assert.NotContains(t, string(encrypted), "Gigi")
+	assert.NotContains(t, string(encrypted), "gigi@example.com")
+	assert.Len(t, storage.keys, 1)
+	assert.Contains(t, storage.keys, "personal_data.instance1.user1")
+
+	decrypted, err := encryption.DecryptPayload("instance1", "user1", "user.human.added", encrypted)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(payload), string(decrypted))
+}
+
+func TestPersonalDataEncryption_EncryptPayload_unregistered(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+
+	payload := []byte(`{"firstName":"Gigi"}`)
+	encrypted, err := encryption.EncryptPayload("instance1", "user1", "user.human.profile.changed", payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, encrypted)
+	assert.Empty(t, storage.keys)
+}
+
+func TestPersonalDataEncryption_EncryptPayload_existingKey(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+
+	_, err := encryption.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"firstName":"Gigi"}`))
+	require.NoError(t, err)
+	key := storage.keys["personal_data.instance1.user1"]
+
+	// a second instance of ZITADEL must reuse the key
+	other := NewPersonalDataEncryption(storage)
+	other.RegisterFields("user.human.added", "firstName")
+	encrypted, err := other.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"firstName":"Gigi"}`))
+	require.NoError(t, err)
+	assert.Equal(t, key, storage.keys["personal_data.instance1.user1"])
+
+	decrypted, err := encryption.DecryptPayload("instance1", "user1", "user.human.added", encrypted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"firstName":"Gigi"}`, string(decrypted))
+}
+
+func TestPersonalDataEncryption_DecryptPayload_plaintext(t *testing.T) {
+	encryption, _ := newTestPersonalDataEncryption()
+
+	payload := []byte(`{"userName":"username","firstName":"Gigi"}`)
+	decrypted, err := encryption.DecryptPayload("instance1", "user1", "user.human.added", payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decrypted)
+}
+
+func TestPersonalDataEncryption_DestroyKey(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+
+	encrypted, err := encryption.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"userName":"username","firstName":"Gigi","email":"gigi@example.com"}`))
+	require.NoError(t, err)
+	other, err := encryption.EncryptPayload("instance1", "user2", "user.human.added", []byte(`{"userName":"other","firstName":"Max"}`))
+	require.NoError(t, err)
+
+	require.NoError(t, encryption.DestroyKey("instance1", "user1"))
+	assert.NotContains(t, storage.keys, "personal_data.instance1.user1")
+	assert.Contains(t, storage.keys, "personal_data_destroyed.instance1.user1")
+	// destroying the key again must not fail
+	require.NoError(t, encryption.DestroyKey("instance1", "user1"))
+
+	decrypted, err := encryption.DecryptPayload("instance1", "user1", "user.human.added", encrypted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"userName":"username"}`, string(decrypted))
+
+	decrypted, err = encryption.DecryptPayload("instance1", "user2", "user.human.added", other)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"userName":"other","firstName":"Max"}`, string(decrypted))
+}
+
+func TestPersonalDataEncryption_DestroyKey_noRecreation(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+
+	_, err := encryption.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"firstName":"Gigi"}`))
+	require.NoError(t, err)
+	require.NoError(t, encryption.DestroyKey("instance1", "user1"))
+
+	// a second instance of ZITADEL must not create the key again
+	other := NewPersonalDataEncryption(storage)
+	other.RegisterFields("user.human.added", "firstName")
+	_, err = other.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"firstName":"Gigi"}`))
+	assert.True(t, errors.IsPreconditionFailed(err))
+	_, err = encryption.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"firstName":"Gigi"}`))
+	assert.True(t, errors.IsPreconditionFailed(err))
+	assert.NotContains(t, storage.keys, "personal_data.instance1.user1")
+}
+
+func TestPersonalDataEncryption_EvictKeys(t *testing.T) {
+	encryption, storage := newTestPersonalDataEncryption()
+	other := NewPersonalDataEncryption(storage)
+	other.RegisterFields("user.human.added", "firstName")
+
+	encrypted, err := encryption.EncryptPayload("instance1", "user1", "user.human.added", []byte(`{"userName":"username","firstName":"Gigi"}`))
+	require.NoError(t, err)
+	decrypted, err := other.DecryptPayload("instance1", "user1", "user.human.added", encrypted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"userName":"username","firstName":"Gigi"}`, string(decrypted))
+
+	require.NoError(t, encryption.DestroyKey("instance1", "user1"))
+	// the key of another instance must stay cached
+	other.cacheKey(personalDataKeyID("instance2", "user1"), "key")
+
+	other.EvictKeys("instance1")
+	decrypted, err = other.DecryptPayload("instance1", "user1", "user.human.added", encrypted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"userName":"username"}`, string(decrypted))
+	assert.Contains(t, other.keys, personalDataKeyID("instance2", "user1"))
+}
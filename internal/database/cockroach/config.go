@@ -31,6 +31,14 @@ type Config struct {
 	//Additional options to be appended as options=<Options>
 	//The value will be taken as is. Multiple options are space separated.
 	Options string
+
+	//Replica is an optional read only connection used for queries which tolerate staleness
+	Replica Replica
+}
+
+type Replica struct {
+	Host string
+	Port uint16
 }
 
 func (c *Config) MatchName(name string) bool {
@@ -74,6 +82,28 @@ func (c *Config) Connect(useAdmin bool) (*sql.DB, error) {
 	return client, nil
 }
 
+func (c *Config) ConnectReplica() (*sql.DB, error) {
+	if c.Replica.Host == "" {
+		return nil, nil
+	}
+	replica := *c
+	replica.Host = c.Replica.Host
+	if c.Replica.Port != 0 {
+		replica.Port = c.Replica.Port
+	}
+	client, err := sql.Open("pgx", replica.String(false))
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetMaxOpenConns(int(c.MaxOpenConns))
+	client.SetMaxIdleConns(int(c.MaxIdleConns))
+	client.SetConnMaxLifetime(c.MaxConnLifetime)
+	client.SetConnMaxIdleTime(c.MaxConnIdleTime)
+
+	return client, nil
+}
+
 func (c *Config) DatabaseName() string {
 	return c.Database
 }
@@ -22,6 +22,10 @@ func (c *Config) SetConnector(connector dialect.Connector) {
 type DB struct {
 	*sql.DB
 	dialect.Database
+	// Replica is nil if no replica is configured
+	Replica *sql.DB
+	// shards are the databases of the instances routed to other databases, see shard.go
+	shards map[string]*DB
 }
 
 func Connect(config Config, useAdmin bool) (*DB, error) {
@@ -34,10 +38,26 @@ func Connect(config Config, useAdmin bool) (*DB, error) {
 		return nil, errors.ThrowPreconditionFailed(err, "DATAB-0pIWD", "Errors.Database.Connection.Failed")
 	}
 
-	return &DB{
+	db := &DB{
 		DB:       client,
 		Database: config.connector,
-	}, nil
+	}
+	if useAdmin {
+		return db, nil
+	}
+
+	db.Replica, err = config.connector.ConnectReplica()
+	if err != nil {
+		return nil, err
+	}
+	if db.Replica == nil {
+		return db, nil
+	}
+	if err := db.Replica.Ping(); err != nil {
+		return nil, errors.ThrowPreconditionFailed(err, "DATAB-Rr6tH", "Errors.Database.Connection.Failed")
+	}
+
+	return db, nil
 }
 
 func DecodeHook(from, to reflect.Value) (interface{}, error) {
@@ -30,6 +30,8 @@ type Matcher interface {
 
 type Connector interface {
 	Connect(useAdmin bool) (*sql.DB, error)
+	// ConnectReplica returns nil if no replica is configured
+	ConnectReplica() (*sql.DB, error)
 	Password() string
 	Database
 }
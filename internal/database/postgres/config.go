@@ -29,6 +29,14 @@ type Config struct {
 	//Additional options to be appended as options=<Options>
 	//The value will be taken as is. Multiple options are space separated.
 	Options string
+
+	//Replica is an optional read only connection used for queries which tolerate staleness
+	Replica Replica
+}
+
+type Replica struct {
+	Host string
+	Port int32
 }
 
 func (c *Config) MatchName(name string) bool {
@@ -73,6 +81,28 @@ func (c *Config) Connect(useAdmin bool) (*sql.DB, error) {
 	return db, nil
 }
 
+func (c *Config) ConnectReplica() (*sql.DB, error) {
+	if c.Replica.Host == "" {
+		return nil, nil
+	}
+	replica := *c
+	replica.Host = c.Replica.Host
+	if c.Replica.Port != 0 {
+		replica.Port = c.Replica.Port
+	}
+	db, err := sql.Open("pgx", replica.String(false))
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(int(c.MaxOpenConns))
+	db.SetMaxIdleConns(int(c.MaxIdleConns))
+	db.SetConnMaxLifetime(c.MaxConnLifetime)
+	db.SetConnMaxIdleTime(c.MaxConnIdleTime)
+
+	return db, nil
+}
+
 func (c *Config) DatabaseName() string {
 	return c.Database
 }
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"sort"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	shardOfStmt  = "SELECT shard, moving FROM system.instance_shards WHERE instance_id = $1"
+	setShardStmt = "INSERT INTO system.instance_shards (instance_id, shard, moving) VALUES ($1, $2, $3)" +
+		" ON CONFLICT (instance_id) DO UPDATE SET shard = excluded.shard, moving = excluded.moving"
+	instanceShardsStmt = "SELECT instance_id, shard, moving FROM system.instance_shards"
+)
+
+type shardKey struct{}
+
+// WithShard selects the database shard used by the context aware methods of [DB],
+// the empty name selects the default database
+func WithShard(ctx context.Context, shard string) context.Context {
+	return context.WithValue(ctx, shardKey{}, shard)
+}
+
+// ShardFromContext returns the name of the shard selected by [WithShard]
+func ShardFromContext(ctx context.Context) string {
+	shard, _ := ctx.Value(shardKey{}).(string)
+	return shard
+}
+
+// ConnectShards connects to the databases of the shards.
+// The routing of the instances to the shards is stored in the default database, see [DB.ShardOf].
+func (db *DB) ConnectShards(configs map[string]Config) error {
+	if len(configs) == 0 {
+		return nil
+	}
+	db.shards = make(map[string]*DB, len(configs))
+	for name, config := range configs {
+		if name == "" {
+			return errors.ThrowInvalidArgument(nil, "DATAB-Wq3vb", "name of shard must not be empty")
+		}
+		shard, err := Connect(config, false)
+		if err != nil {
+			return err
+		}
+		db.shards[name] = shard
+	}
+	return nil
+}
+
+// Shard returns the database of the shard selected in the context.
+// The default database is returned if no shard is selected.
+func (db *DB) Shard(ctx context.Context) *DB {
+	if shard, ok := db.shards[ShardFromContext(ctx)]; ok {
+		return shard
+	}
+	return db
+}
+
+// ShardNames returns the names of all databases sorted by name,
+// the first name is the default database ("")
+func (db *DB) ShardNames() []string {
+	names := make([]string, 0, len(db.shards)+1)
+	for name := range db.shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{""}, names...)
+}
+
+// HasShard checks if the shard is configured, the default database ("") always exists
+func (db *DB) HasShard(shard string) bool {
+	_, ok := db.shards[shard]
+	return ok || shard == ""
+}
+
+// QueryContext executes the query on the shard selected in the context
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return db.Shard(ctx).DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext executes the query on the shard selected in the context
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return db.Shard(ctx).DB.QueryRowContext(ctx, query, args...)
+}
+
+// ExecContext executes the statement on the shard selected in the context
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return db.Shard(ctx).DB.ExecContext(ctx, query, args...)
+}
+
+// BeginTx starts the transaction on the shard selected in the context
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return db.Shard(ctx).DB.BeginTx(ctx, opts)
+}
+
+// ShardOf returns the shard the instance is stored in.
+// Instances without routing are stored in the default database ("").
+// While the instance is moved to another shard, an unavailable error is returned.
+func (db *DB) ShardOf(ctx context.Context, instanceID string) (string, error) {
+	if len(db.shards) == 0 {
+		return "", nil
+	}
+	var (
+		shard  string
+		moving bool
+	)
+	err := db.DB.QueryRowContext(ctx, shardOfStmt, instanceID).Scan(&shard, &moving)
+	if errs.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.ThrowInternal(err, "DATAB-Rk9s2", "Errors.Internal")
+	}
+	if moving {
+		return "", errors.ThrowUnavailable(nil, "DATAB-Mv2pq", "Errors.Instance.Moving")
+	}
+	if !db.HasShard(shard) {
+		return "", errors.ThrowInternal(nil, "DATAB-Ue3xm", "Errors.Instance.Shard.NotFound")
+	}
+	return shard, nil
+}
+
+// CheckRouting verifies that the instance is routed to the shard selected in the context.
+// An unavailable error is returned while the instance is moved or after it was moved to another shard,
+// so the data of the instance is not changed in the database it is moved from.
+func (db *DB) CheckRouting(ctx context.Context, instanceID string) error {
+	shard, err := db.ShardOf(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if shard != ShardFromContext(ctx) {
+		return errors.ThrowUnavailable(nil, "DATAB-Rt6mv", "Errors.Instance.Moving")
+	}
+	return nil
+}
+
+// InstanceRouting is the shard an instance is routed to
+type InstanceRouting struct {
+	Shard string
+	// Moving is true while the instance is moved to another shard
+	Moving bool
+}
+
+// InstanceShards returns the routing of all routed instances by their id,
+// instances not contained are stored in the default database
+func (db *DB) InstanceShards(ctx context.Context) (map[string]InstanceRouting, error) {
+	if len(db.shards) == 0 {
+		return nil, nil
+	}
+	rows, err := db.DB.QueryContext(ctx, instanceShardsStmt)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DATAB-Vf8qk", "Errors.Internal")
+	}
+	defer rows.Close()
+	shards := make(map[string]InstanceRouting)
+	for rows.Next() {
+		var (
+			instanceID string
+			routing    InstanceRouting
+		)
+		if err = rows.Scan(&instanceID, &routing.Shard, &routing.Moving); err != nil {
+			return nil, errors.ThrowInternal(err, "DATAB-Lp3nd", "Errors.Internal")
+		}
+		shards[instanceID] = routing
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "DATAB-Cz7wq", "Errors.Internal")
+	}
+	return shards, nil
+}
+
+// SetShard routes the instance to the shard
+func (db *DB) SetShard(ctx context.Context, instanceID, shard string) error {
+	return db.setRouting(ctx, instanceID, shard, false)
+}
+
+// SetMoving marks the instance stored in the shard as being moved,
+// [DB.ShardOf] rejects the instance until the new shard is set
+func (db *DB) SetMoving(ctx context.Context, instanceID, shard string) error {
+	return db.setRouting(ctx, instanceID, shard, true)
+}
+
+func (db *DB) setRouting(ctx context.Context, instanceID, shard string, moving bool) error {
+	if !db.HasShard(shard) || len(db.shards) == 0 {
+		return errors.ThrowInvalidArgument(nil, "DATAB-Ys4ka", "Errors.Instance.Shard.NotFound")
+	}
+	if _, err := db.DB.ExecContext(ctx, setShardStmt, instanceID, shard, moving); err != nil {
+		return errors.ThrowInternal(err, "DATAB-Hn5ts", "Errors.Internal")
+	}
+	return nil
+}
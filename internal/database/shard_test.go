@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func newShardedMock(t *testing.T, shards ...string) (*DB, sqlmock.Sqlmock, map[string]sqlmock.Sqlmock) {
+	t.Helper()
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := &DB{DB: client, shards: make(map[string]*DB, len(shards))}
+	shardMocks := make(map[string]sqlmock.Sqlmock, len(shards))
+	for _, name := range shards {
+		shardClient, shardMock, err := sqlmock.New()
+		require.NoError(t, err)
+		db.shards[name] = &DB{DB: shardClient}
+		shardMocks[name] = shardMock
+	}
+	return db, mock, shardMocks
+}
+
+func TestDB_ShardNames(t *testing.T) {
+	db, _, _ := newShardedMock(t, "eu2", "ch1")
+	assert.Equal(t, []string{"", "ch1", "eu2"}, db.ShardNames())
+	assert.Equal(t, []string{""}, (&DB{}).ShardNames())
+}
+
+func TestDB_QueryContext(t *testing.T) {
+	db, mock, shardMocks := newShardedMock(t, "eu2")
+	mock.ExpectExec(regexp.QuoteMeta("SELECT 'default'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	shardMocks["eu2"].ExpectExec(regexp.QuoteMeta("SELECT 'shard'")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 'default'")
+	require.NoError(t, err)
+	_, err = db.ExecContext(WithShard(context.Background(), "eu2"), "SELECT 'shard'")
+	require.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, shardMocks["eu2"].ExpectationsWereMet())
+}
+
+func TestDB_ShardOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		shards  []string
+		expect  func(sqlmock.Sqlmock)
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name:   "no shards, default",
+			expect: func(sqlmock.Sqlmock) {},
+			want:   "",
+		},
+		{
+			name:   "not routed, default",
+			shards: []string{"eu2"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnError(sql.ErrNoRows)
+			},
+			want: "",
+		},
+		{
+			name:   "routed, shard",
+			shards: []string{"eu2"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("eu2", false))
+			},
+			want: "eu2",
+		},
+		{
+			name:   "moving, unavailable",
+			shards: []string{"eu2"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("", true))
+			},
+			wantErr: errors.IsUnavailable,
+		},
+		{
+			name:   "shard not configured, internal",
+			shards: []string{"eu2"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("us1", false))
+			},
+			wantErr: errors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := newShardedMock(t, tt.shards...)
+			tt.expect(mock)
+			got, err := db.ShardOf(context.Background(), "instance")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDB_CheckRouting(t *testing.T) {
+	tests := []struct {
+		name    string
+		shard   string
+		expect  func(sqlmock.Sqlmock)
+		wantErr func(error) bool
+	}{
+		{
+			name:  "routed to selected shard",
+			shard: "eu2",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("eu2", false))
+			},
+		},
+		{
+			name:  "moving, unavailable",
+			shard: "eu2",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("eu2", true))
+			},
+			wantErr: errors.IsUnavailable,
+		},
+		{
+			name:  "moved to other shard, unavailable",
+			shard: "",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(shardOfStmt)).
+					WithArgs("instance").
+					WillReturnRows(sqlmock.NewRows([]string{"shard", "moving"}).AddRow("eu2", false))
+			},
+			wantErr: errors.IsUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, _ := newShardedMock(t, "eu2")
+			tt.expect(mock)
+			err := db.CheckRouting(WithShard(context.Background(), tt.shard), "instance")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDB_SetShard(t *testing.T) {
+	db, mock, _ := newShardedMock(t, "eu2")
+	mock.ExpectExec(regexp.QuoteMeta(setShardStmt)).
+		WithArgs("instance", "eu2", true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(setShardStmt)).
+		WithArgs("instance", "eu2", false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// the routing is stored in the default database, independent of the selected shard
+	ctx := WithShard(context.Background(), "eu2")
+	require.NoError(t, db.SetMoving(ctx, "instance", "eu2"))
+	require.NoError(t, db.SetShard(ctx, "instance", "eu2"))
+	assert.True(t, errors.IsErrorInvalidArgument(db.SetShard(ctx, "instance", "us1")))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_InstanceShards(t *testing.T) {
+	db, mock, _ := newShardedMock(t, "eu2")
+	mock.ExpectQuery(regexp.QuoteMeta(instanceShardsStmt)).
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id", "shard", "moving"}).
+			AddRow("instance1", "eu2", false).
+			AddRow("instance2", "", true),
+		)
+
+	got, err := db.InstanceShards(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]InstanceRouting{
+		"instance1": {Shard: "eu2"},
+		"instance2": {Shard: "", Moving: true},
+	}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
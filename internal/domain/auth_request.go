@@ -53,6 +53,7 @@ type AuthRequest struct {
 	LabelPolicy              *LabelPolicy
 	PrivacyPolicy            *PrivacyPolicy
 	LockoutPolicy            *LockoutPolicy
+	RiskAssessment           *RiskAssessment
 	DefaultTranslations      []*CustomText
 	OrgTranslations          []*CustomText
 }
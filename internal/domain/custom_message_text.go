@@ -14,6 +14,8 @@ const (
 	DomainClaimedMessageType            = "DomainClaimed"
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
+	PasswordBreachedMessageType         = "PasswordBreached"
+	MagicLinkMessageType                = "MagicLink"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -31,6 +33,8 @@ type MessageTexts struct {
 	DomainClaimed            CustomMessageText
 	PasswordlessRegistration CustomMessageText
 	PasswordChange           CustomMessageText
+	PasswordBreached         CustomMessageText
+	MagicLink                CustomMessageText
 }
 
 type CustomMessageText struct {
@@ -69,6 +73,10 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *CustomMessageText {
 		return &m.PasswordlessRegistration
 	case PasswordChangeMessageType:
 		return &m.PasswordChange
+	case PasswordBreachedMessageType:
+		return &m.PasswordBreached
+	case MagicLinkMessageType:
+		return &m.MagicLink
 	}
 	return nil
 }
@@ -80,5 +88,7 @@ func IsMessageTextType(textType string) bool {
 		textType == VerifyPhoneMessageType ||
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
-		textType == PasswordChangeMessageType
+		textType == PasswordChangeMessageType ||
+		textType == PasswordBreachedMessageType ||
+		textType == MagicLinkMessageType
 }
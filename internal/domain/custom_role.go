@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// CustomRole is a member role defined by the instance administrators.
+// Like the roles of the configuration, it grants a set of permissions
+// and can be assigned to the members of the level its name is prefixed with (IAM_, ORG_, PROJECT_ or PROJECT_GRANT_).
+type CustomRole struct {
+	models.ObjectRoot
+
+	Role        string
+	DisplayName string
+	Permissions []string
+}
+
+func (r *CustomRole) HasValidPrefix() bool {
+	return r.Prefix() != ""
+}
+
+// Prefix returns the prefix (IAM, ORG or PROJECT) of the role, which defines the level the role is assigned on
+func (r *CustomRole) Prefix() string {
+	for _, prefix := range []string{IAMRolePrefix, OrgRolePrefix, ProjectRolePrefix} {
+		if strings.HasPrefix(r.Role, prefix+"_") && len(r.Role) > len(prefix)+1 {
+			return prefix
+		}
+	}
+	return ""
+}
+
+type CustomRoleState int32
+
+const (
+	CustomRoleStateUnspecified CustomRoleState = iota
+	CustomRoleStateActive
+	CustomRoleStateRemoved
+)
+
+func (s CustomRoleState) Exists() bool {
+	return s == CustomRoleStateActive
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// Group bundles users of an organisation,
+// the members of the group inherit the roles granted to it
+type Group struct {
+	models.ObjectRoot
+
+	Name        string
+	Description string
+}
+
+func (g *Group) IsValid() bool {
+	return strings.TrimSpace(g.Name) != ""
+}
+
+type GroupState int32
+
+const (
+	GroupStateUnspecified GroupState = iota
+	GroupStateActive
+	GroupStateRemoved
+)
+
+func (s GroupState) Exists() bool {
+	return s == GroupStateActive
+}
+
+// GroupGrant grants roles of a project (or a project grant) to all members of a group
+type GroupGrant struct {
+	models.ObjectRoot
+
+	GrantID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (g *GroupGrant) IsValid() bool {
+	return g.AggregateID != "" && g.ProjectID != "" && len(g.RoleKeys) > 0
+}
+
+func (g *GroupGrant) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range g.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type LoginBlockKeyType int32
+
+const (
+	LoginBlockKeyTypeUnspecified LoginBlockKeyType = iota
+	LoginBlockKeyTypeIP
+	LoginBlockKeyTypeUser
+)
+
+func (t LoginBlockKeyType) Valid() bool {
+	return t == LoginBlockKeyTypeIP || t == LoginBlockKeyTypeUser
+}
+
+// LoginAttemptsProvider returns the failed login attempts of a user or an IP address since the given time
+type LoginAttemptsProvider func(ctx context.Context, keyType LoginBlockKeyType, key string, since time.Time) (failures uint64, lastFailure time.Time, err error)
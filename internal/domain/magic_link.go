@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+)
+
+type MagicLinkURLData struct {
+	UserID    string
+	OrgID     string
+	SessionID string
+	Code      string
+}
+
+// RenderMagicLinkURLTemplate parses and renders tmpl.
+// userID, orgID, sessionID and code are passed into the [MagicLinkURLData].
+func RenderMagicLinkURLTemplate(w io.Writer, tmpl, userID, orgID, sessionID, code string) error {
+	return renderURLTemplate(w, tmpl, &MagicLinkURLData{userID, orgID, sessionID, code})
+}
+
+func MagicLinkLoginLink(baseURL, userID, resourceOwner, authRequestID, code string) string {
+	return fmt.Sprintf("%s?userID=%s&orgID=%s&authRequestID=%s&code=%s", baseURL, userID, resourceOwner, authRequestID, code)
+}
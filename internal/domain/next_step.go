@@ -29,6 +29,7 @@ const (
 	NextStepProjectRequired
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
+	NextStepMagicLink
 )
 
 type LoginStep struct{}
@@ -117,6 +118,12 @@ func (s *PasswordlessRegistrationPromptStep) Type() NextStepType {
 	return NextStepPasswordlessRegistrationPrompt
 }
 
+type MagicLinkStep struct{}
+
+func (s *MagicLinkStep) Type() NextStepType {
+	return NextStepMagicLink
+}
+
 type ChangePasswordStep struct{}
 
 func (s *ChangePasswordStep) Type() NextStepType {
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// OIDCClientRegistrationSettings restrict the clients which can be registered
+// through the dynamic client registration endpoint (RFC 7591) of an instance.
+// Empty lists do not restrict the corresponding property.
+type OIDCClientRegistrationSettings struct {
+	models.ObjectRoot
+
+	AllowedGrantTypes          []OIDCGrantType
+	AllowedRedirectURIPrefixes []string
+}
+
+func (s *OIDCClientRegistrationSettings) GrantTypesAllowed(grantTypes []OIDCGrantType) bool {
+	if s == nil || len(s.AllowedGrantTypes) == 0 {
+		return true
+	}
+	return ContainsOIDCGrantTypes(grantTypes, s.AllowedGrantTypes)
+}
+
+func (s *OIDCClientRegistrationSettings) RedirectURIsAllowed(redirectURIs []string) bool {
+	if s == nil || len(s.AllowedRedirectURIPrefixes) == 0 {
+		return true
+	}
+	for _, uri := range redirectURIs {
+		if !matchesOneOfPrefixes(uri, s.AllowedRedirectURIPrefixes) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesOneOfPrefixes(uri string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if redirectURIMatchesPrefix(uri, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectURIMatchesPrefix checks if the uri has the same scheme, host and port as the prefix
+// and its path is the path of the prefix or lies below it.
+// The uris are compared parsed so e.g. https://example.com does not allow https://example.com.evil.com
+func redirectURIMatchesPrefix(uri, prefix string) bool {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	p, err := url.Parse(prefix)
+	if err != nil || p.Scheme == "" {
+		return false
+	}
+	if !strings.EqualFold(u.Scheme, p.Scheme) ||
+		!strings.EqualFold(u.Hostname(), p.Hostname()) ||
+		u.Port() != p.Port() ||
+		u.User != nil {
+		return false
+	}
+	return pathHasPrefix(uriPath(u), uriPath(p))
+}
+
+// uriPath returns the path of the uri, including the opaque part of uris like com.example.app:callback
+func uriPath(u *url.URL) string {
+	if u.Opaque != "" {
+		return u.Opaque
+	}
+	return u.Path
+}
+
+// pathHasPrefix checks if path equals prefix or lies below it, only whole segments of the path are matched
+// paths with dot segments are rejected as they could leave the prefix
+func pathHasPrefix(path, prefix string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "." || segment == ".." {
+			return false
+		}
+	}
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+type OIDCInitialAccessToken struct {
+	models.ObjectRoot
+
+	TokenID        string
+	ExpirationDate time.Time
+	Token          string
+}
+
+type OIDCInitialAccessTokenState int32
+
+const (
+	OIDCInitialAccessTokenStateUnspecified OIDCInitialAccessTokenState = iota
+	OIDCInitialAccessTokenStateActive
+	OIDCInitialAccessTokenStateRemoved
+)
+
+func (s OIDCInitialAccessTokenState) Exists() bool {
+	return s == OIDCInitialAccessTokenStateActive
+}
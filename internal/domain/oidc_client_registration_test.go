@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOIDCClientRegistrationSettings_RedirectURIsAllowed(t *testing.T) {
+	tests := []struct {
+		name         string
+		prefixes     []string
+		redirectURIs []string
+		want         bool
+	}{
+		{
+			name:         "no prefixes, allowed",
+			redirectURIs: []string{"https://evil.com/cb"},
+			want:         true,
+		},
+		{
+			name:         "same host, allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.com/cb"},
+			want:         true,
+		},
+		{
+			name:         "path below prefix, allowed",
+			prefixes:     []string{"https://partner.example.com/app/"},
+			redirectURIs: []string{"https://partner.example.com/app/cb", "https://partner.example.com/app"},
+			want:         true,
+		},
+		{
+			name:         "host case insensitive, allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://Partner.Example.com/cb"},
+			want:         true,
+		},
+		{
+			name:         "custom scheme, allowed",
+			prefixes:     []string{"com.example.app:/callback"},
+			redirectURIs: []string{"com.example.app:/callback"},
+			want:         true,
+		},
+		{
+			name:         "one of the uris not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.com/cb", "https://evil.com/cb"},
+			want:         false,
+		},
+		{
+			name:         "subdomain of other host, not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.com.evil.com/cb"},
+			want:         false,
+		},
+		{
+			name:         "host with suffix, not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.comevil/cb"},
+			want:         false,
+		},
+		{
+			name:         "user info, not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.com@evil.com/cb"},
+			want:         false,
+		},
+		{
+			name:         "other scheme, not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"http://partner.example.com/cb"},
+			want:         false,
+		},
+		{
+			name:         "other port, not allowed",
+			prefixes:     []string{"https://partner.example.com"},
+			redirectURIs: []string{"https://partner.example.com:8443/cb"},
+			want:         false,
+		},
+		{
+			name:         "path not on segment boundary, not allowed",
+			prefixes:     []string{"https://partner.example.com/app"},
+			redirectURIs: []string{"https://partner.example.com/application/cb"},
+			want:         false,
+		},
+		{
+			name:         "path leaving prefix, not allowed",
+			prefixes:     []string{"https://partner.example.com/app/"},
+			redirectURIs: []string{"https://partner.example.com/app/../admin"},
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &OIDCClientRegistrationSettings{AllowedRedirectURIPrefixes: tt.prefixes}
+			assert.Equal(t, tt.want, s.RedirectURIsAllowed(tt.redirectURIs))
+		})
+	}
+}
@@ -27,8 +27,11 @@ func (p *Permissions) appendPermission(ctxID, permission string) {
 type PermissionCheck func(ctx context.Context, permission, orgID, resourceID string) (err error)
 
 const (
-	PermissionUserWrite     = "user.write"
-	PermissionSessionRead   = "session.read"
-	PermissionSessionWrite  = "session.write"
-	PermissionSessionDelete = "session.delete"
+	PermissionUserRead       = "user.read"
+	PermissionUserWrite      = "user.write"
+	PermissionUserDelete     = "user.delete"
+	PermissionUserSelfDelete = "user.self.delete"
+	PermissionSessionRead    = "session.read"
+	PermissionSessionWrite   = "session.write"
+	PermissionSessionDelete  = "session.delete"
 )
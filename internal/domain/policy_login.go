@@ -30,6 +30,8 @@ type LoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	RiskRules                  RiskRules
 }
 
 func ValidateDefaultRedirectURI(rawURL string) bool {
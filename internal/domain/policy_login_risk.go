@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+)
+
+// RiskRules configure which signals of a login attempt are considered risky
+// and therefore require the user to verify a second factor.
+type RiskRules struct {
+	Enabled bool
+	// NewDevice triggers if the user agent has never been used by the user
+	NewDevice bool
+	// NewNetwork triggers if the login comes from an unknown IP range (/24 for IPv4, /48 for IPv6)
+	NewNetwork bool
+	// NewCountry triggers if the login comes from a country the user has never logged in from
+	NewCountry bool
+	// ImpossibleTravelSpeed in km/h, travelling faster since the last successful login triggers the signal (0 disables the check)
+	ImpossibleTravelSpeed uint32
+	// MaxRecentFailures triggers if more failed password checks happened during the RecentFailuresWindow (0 disables the check)
+	MaxRecentFailures    uint32
+	RecentFailuresWindow time.Duration
+}
+
+func (r *RiskRules) IsValid() bool {
+	return r.MaxRecentFailures == 0 || r.RecentFailuresWindow > 0
+}
+
+type RiskSignal string
+
+const (
+	RiskSignalNewDevice        RiskSignal = "new_device"
+	RiskSignalNewNetwork       RiskSignal = "new_network"
+	RiskSignalNewCountry       RiskSignal = "new_country"
+	RiskSignalImpossibleTravel RiskSignal = "impossible_travel"
+	RiskSignalRecentFailures   RiskSignal = "recent_failures"
+)
+
+type RiskLevel int32
+
+const (
+	RiskLevelUnspecified RiskLevel = iota
+	RiskLevelLow
+	RiskLevelElevated
+)
+
+// RiskAssessment is the decision of the risk evaluation of a login attempt,
+// it is recorded on the auth request
+type RiskAssessment struct {
+	Level       RiskLevel
+	Signals     []RiskSignal
+	Country     string
+	EvaluatedAt time.Time
+}
+
+func (a *RiskAssessment) StepUpRequired() bool {
+	return a != nil && a.Level == RiskLevelElevated
+}
@@ -9,4 +9,8 @@ type PasswordAgePolicy struct {
 
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
+	// HistoryCount defines how many of the previous passwords can't be reused
+	HistoryCount uint64
+	// MinAgeDays defines the minimum amount of days between two password changes
+	MinAgeDays uint64
 }
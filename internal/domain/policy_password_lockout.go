@@ -9,5 +9,7 @@ type LockoutPolicy struct {
 
 	Default             bool
 	MaxPasswordAttempts uint64
+	// MaxMFAAttempts is the number of consecutive failed otp, u2f and passwordless checks after which the user gets locked
+	MaxMFAAttempts      uint64
 	ShowLockOutFailures bool
 }
@@ -14,4 +14,6 @@ type PrivacyPolicy struct {
 	PrivacyLink  string
 	HelpLink     string
 	SupportEmail EmailAddress
+	// AllowSelfDelete allows the users to delete their own account
+	AllowSelfDelete bool
 }
@@ -11,6 +11,7 @@ const (
 	SecretGeneratorTypePasswordResetCode
 	SecretGeneratorTypePasswordlessInitCode
 	SecretGeneratorTypeAppSecret
+	SecretGeneratorTypeMagicLinkCode
 
 	secretGeneratorTypeCount
 )
@@ -1,6 +1,10 @@
 package domain
 
-import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
 
 type UserGrant struct {
 	es_models.ObjectRoot
@@ -10,6 +14,10 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	// ValidFrom and ValidUntil restrict the period the grant is effective in,
+	// a zero value means the grant is not restricted on that side
+	ValidFrom  time.Time
+	ValidUntil time.Time
 }
 
 type UserGrantState int32
@@ -19,12 +27,18 @@ const (
 	UserGrantStateActive
 	UserGrantStateInactive
 	UserGrantStateRemoved
+	UserGrantStateRequested
+	UserGrantStateExpired
 )
 
 func (u *UserGrant) IsValid() bool {
 	return u.ProjectID != "" && u.UserID != ""
 }
 
+func (g *UserGrant) HasValidity() bool {
+	return !g.ValidFrom.IsZero() || !g.ValidUntil.IsZero()
+}
+
 func (g *UserGrant) HasInvalidRoles(validRoles []string) bool {
 	for _, roleKey := range g.RoleKeys {
 		if !containsRoleKey(roleKey, validRoles) {
@@ -67,6 +67,36 @@ type Aggregate struct {
 	InstanceID string `json:"-"`
 	//Version is the semver this aggregate represents
 	Version Version `json:"-"`
+
+	expectedSequence   *uint64
+	expectedEventTypes []EventType
+}
+
+//ExpectSequence ensures that the commands of the aggregate are only pushed
+// if the latest event of the aggregate still has the given sequence,
+// otherwise the push fails with an error checked by [IsSequenceConflict].
+// Use 0 if the aggregate must not exist yet.
+func (a *Aggregate) ExpectSequence(sequence uint64) *Aggregate {
+	a.expectedSequence = &sequence
+	return a
+}
+
+// expectableWriteModel is a write model embedding [WriteModel]
+type expectableWriteModel interface {
+	QueryReducer
+	writeModel() *WriteModel
+}
+
+// ExpectWriteModel ensures that the commands of the aggregate are only pushed
+// if none of the events of the aggregate queried by the write model was pushed after the write model was filtered,
+// otherwise the push fails with an error checked by [IsSequenceConflict].
+// The write model must be filtered using [Eventstore.FilterToQueryReducer] before.
+// Events of the aggregate which aren't queried by the write model don't conflict.
+func (a *Aggregate) ExpectWriteModel(wm expectableWriteModel) *Aggregate {
+	sequence := wm.writeModel().filteredSequence
+	a.expectedSequence = &sequence
+	a.expectedEventTypes = wm.Query().eventTypesOf(a.Type)
+	return a
 }
 
 func isAggreagteTypes(a Aggregate, types ...AggregateType) bool {
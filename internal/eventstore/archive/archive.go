@@ -0,0 +1,117 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	// ReportsTable contains the archived events per run, instance and event type
+	ReportsTable = "eventstore.archive_reports"
+	// SequencesTable contains the latest archived sequence per instance and aggregate type,
+	// the projections skip these sequences if they handle events before the archived events
+	SequencesTable = "eventstore.archived_sequences"
+)
+
+type Config struct {
+	// Enabled starts the background job which moves the events matching the policies into the archive
+	Enabled bool
+	// Interval defines how often the policies are applied
+	Interval time.Duration
+	// BulkLimit is the amount of events (or aggregates) archived in one statement
+	BulkLimit uint64
+	Policies  []*Policy
+}
+
+// Policy defines which events are archived.
+// If EventTypes are set, the events of the types are archived as soon as they are older than RetainFor,
+// the events can be restricted to AggregateTypes.
+// If only AggregateTypes are set, all events of an aggregate are archived as soon as its latest event is older than RetainFor.
+// Events are only archived after all projections of the aggregate type handled them on the instance
+// and, if the aggregate has snapshots, after all snapshots of the aggregate contain them.
+type Policy struct {
+	// Name identifies the policy in the archive reports
+	Name           string
+	EventTypes     []string
+	AggregateTypes []string
+	RetainFor      time.Duration
+}
+
+func (p *Policy) validate() error {
+	if p.Name == "" {
+		return errors.ThrowInvalidArgument(nil, "ARCHI-Pn1mE", "name of archive policy is missing")
+	}
+	if len(p.EventTypes) == 0 && len(p.AggregateTypes) == 0 {
+		return errors.ThrowInvalidArgument(nil, "ARCHI-Pt2yE", "archive policy must define event or aggregate types")
+	}
+	if p.RetainFor <= 0 {
+		return errors.ThrowInvalidArgument(nil, "ARCHI-Pr3tE", "retention of archive policy must be positive")
+	}
+	return nil
+}
+
+type archiver struct {
+	client    *database.DB
+	interval  time.Duration
+	bulkLimit uint64
+	policies  []*Policy
+	nowFunc   func() time.Time
+}
+
+// Start periodically moves the events matching the policies
+// from eventstore.events into eventstore.events_archive
+// and reports the archived events per instance
+func Start(ctx context.Context, config *Config, client *database.DB) {
+	if config == nil || !config.Enabled {
+		return
+	}
+	a := &archiver{
+		client:    client,
+		interval:  config.Interval,
+		bulkLimit: config.BulkLimit,
+		nowFunc:   time.Now,
+	}
+	for _, policy := range config.Policies {
+		if err := policy.validate(); err != nil {
+			logging.WithFields("policy", policy.Name).WithError(err).Warn("archive policy ignored")
+			continue
+		}
+		a.policies = append(a.policies, policy)
+	}
+	if len(a.policies) == 0 {
+		return
+	}
+	go a.run(ctx)
+}
+
+func (a *archiver) run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.archiveAll(ctx)
+		}
+	}
+}
+
+// archiveAll archives the events of all shards
+func (a *archiver) archiveAll(ctx context.Context) {
+	for _, shard := range a.client.ShardNames() {
+		shardCtx := database.WithShard(ctx, shard)
+		for _, policy := range a.policies {
+			reports, err := a.archive(shardCtx, policy)
+			logging.WithFields("policy", policy.Name, "shard", shard).OnError(err).Warn("unable to archive events")
+			// the reports of the archived bulks are stored even if a later bulk failed
+			err = a.storeReports(shardCtx, reports)
+			logging.WithFields("policy", policy.Name, "shard", shard).OnError(err).Warn("unable to store archive reports")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package archive
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func TestPolicy_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		wantErr bool
+	}{
+		{
+			name:    "missing name",
+			policy:  &Policy{EventTypes: []string{"user.token.added"}, RetainFor: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "missing types",
+			policy:  &Policy{Name: "tokens", RetainFor: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "missing retention",
+			policy:  &Policy{Name: "tokens", EventTypes: []string{"user.token.added"}},
+			wantErr: true,
+		},
+		{
+			name:   "event types",
+			policy: &Policy{Name: "tokens", EventTypes: []string{"user.token.added"}, RetainFor: time.Hour},
+		},
+		{
+			name:   "aggregate types",
+			policy: &Policy{Name: "sessions", AggregateTypes: []string{"session"}, RetainFor: time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.validate()
+			if tt.wantErr {
+				assert.True(t, errors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_archiver_archive(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	oldest := now.Add(-72 * time.Hour)
+	newest := now.Add(-48 * time.Hour)
+	policy := &Policy{Name: "tokens", EventTypes: []string{"user.token.added"}, RetainFor: 24 * time.Hour}
+	cols := []string{"instance_id", "aggregate_type", "event_type", "count", "min", "max"}
+
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer client.Close()
+
+	stmt := regexp.QuoteMeta(fmt.Sprintf(archiveStmtFormat, selectEvents))
+	args := []driver.Value{
+		database.StringArray{"user.token.added"},
+		now.Add(-24 * time.Hour),
+		now,
+		uint64(2),
+		database.StringArray(nil),
+	}
+	mock.ExpectQuery(stmt).WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow("instance", "user", "user.token.added", 2, oldest, oldest))
+	mock.ExpectQuery(stmt).WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow("instance", "user", "user.token.added", 1, newest, newest))
+	mock.ExpectQuery(stmt).WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(cols))
+
+	a := &archiver{
+		client:    &database.DB{DB: client},
+		bulkLimit: 2,
+		nowFunc:   func() time.Time { return now },
+	}
+	reports, err := a.archive(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, []*report{
+		{
+			instanceID:    "instance",
+			archivedAt:    now,
+			policy:        "tokens",
+			aggregateType: "user",
+			eventType:     "user.token.added",
+			count:         3,
+			oldest:        oldest,
+			newest:        newest,
+		},
+	}, reports)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,191 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const (
+	archiveColumns = "id, event_type, aggregate_type, aggregate_id, aggregate_version, event_sequence," +
+		" previous_aggregate_sequence, previous_aggregate_type_sequence, creation_date, event_data," +
+		" editor_user, editor_service, resource_owner, instance_id, created_at"
+
+	// archiveStmtFormat moves the selected events into the archive,
+	// records the latest archived sequence per instance and aggregate type for the projections
+	// and returns the archived events grouped by instance, aggregate type and event type
+	archiveStmtFormat = "WITH archived AS (" +
+		"DELETE FROM eventstore.events WHERE (instance_id, event_sequence) IN (%s)" +
+		" RETURNING " + archiveColumns +
+		"), recorded AS (" +
+		"INSERT INTO " + SequencesTable + " (instance_id, aggregate_type, sequence)" +
+		" SELECT instance_id, aggregate_type, MAX(event_sequence) FROM archived" +
+		" GROUP BY instance_id, aggregate_type" +
+		" ON CONFLICT (instance_id, aggregate_type) DO UPDATE SET sequence = GREATEST(" + SequencesTable + ".sequence, EXCLUDED.sequence)" +
+		"), moved AS (" +
+		"INSERT INTO eventstore.events_archive (" + archiveColumns + ", archived_at)" +
+		" SELECT " + archiveColumns + ", $3::TIMESTAMPTZ FROM archived" +
+		" RETURNING instance_id, aggregate_type, event_type, creation_date" +
+		")" +
+		" SELECT instance_id, aggregate_type, event_type, COUNT(*), MIN(creation_date), MAX(creation_date) FROM moved" +
+		" GROUP BY instance_id, aggregate_type, event_type"
+
+	// handledSequenceFormat is the sequence of the aggregate type on the instance which all projections of the aggregate type handled,
+	// a projection which did not handle the instance yet counts as 0, without any projection of the aggregate type the sequence is NULL.
+	// The formatting verbs are the columns of the instance and the aggregate type.
+	handledSequenceFormat = "(SELECT MIN(COALESCE(s.current_sequence, 0))" +
+		" FROM (SELECT DISTINCT projection_name FROM projections.current_sequences WHERE aggregate_type = %[2]s) p" +
+		" LEFT JOIN projections.current_sequences s ON s.projection_name = p.projection_name AND s.aggregate_type = %[2]s AND s.instance_id = %[1]s)"
+
+	// snapshotSequenceFormat is the sequence up to which all snapshots of the aggregate contain the events,
+	// NULL if the aggregate has no snapshots.
+	// The formatting verbs are the columns of the instance and the aggregate id.
+	snapshotSequenceFormat = "(SELECT MIN(sn.sequence) FROM eventstore.snapshots sn WHERE sn.instance_id = %[1]s AND sn.aggregate_id = %[2]s)"
+)
+
+var (
+	// selectEvents selects the events of the types older than the threshold
+	// which were handled by all projections of the aggregate type and are contained in the snapshots of the aggregate
+	selectEvents = "SELECT e.instance_id, e.event_sequence FROM eventstore.events e" +
+		" WHERE e.event_type = ANY($1::TEXT[])" +
+		" AND e.creation_date < $2" +
+		" AND (array_length($5::TEXT[], 1) IS NULL OR e.aggregate_type = ANY($5::TEXT[]))" +
+		" AND e.event_sequence <= " + fmt.Sprintf(handledSequenceFormat, "e.instance_id", "e.aggregate_type") +
+		" AND e.event_sequence <= COALESCE(" + fmt.Sprintf(snapshotSequenceFormat, "e.instance_id", "e.aggregate_id") + ", e.event_sequence)" +
+		" LIMIT $4"
+
+	// selectAggregates selects all events of the aggregates whose latest event is older than the threshold,
+	// was handled by all projections of the aggregate type and is contained in the snapshots of the aggregate
+	selectAggregates = "SELECT e.instance_id, e.event_sequence FROM eventstore.events e" +
+		" JOIN (" +
+		"SELECT a.instance_id, a.aggregate_type, a.aggregate_id FROM eventstore.events a" +
+		" WHERE a.aggregate_type = ANY($1::TEXT[])" +
+		" GROUP BY a.instance_id, a.aggregate_type, a.aggregate_id" +
+		" HAVING MAX(a.creation_date) < $2" +
+		" AND MAX(a.event_sequence) <= " + fmt.Sprintf(handledSequenceFormat, "a.instance_id", "a.aggregate_type") +
+		" AND MAX(a.event_sequence) <= COALESCE(" + fmt.Sprintf(snapshotSequenceFormat, "a.instance_id", "a.aggregate_id") + ", MAX(a.event_sequence))" +
+		" LIMIT $4" +
+		") inactive USING (instance_id, aggregate_type, aggregate_id)"
+)
+
+const (
+	insertReportStmt = "INSERT INTO " + ReportsTable +
+		" (instance_id, archived_at, policy, aggregate_type, event_type, event_count, oldest_event, newest_event)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+)
+
+// report summarises the archived events of an event type on an instance
+type report struct {
+	instanceID    string
+	archivedAt    time.Time
+	policy        string
+	aggregateType string
+	eventType     string
+	count         uint64
+	oldest        time.Time
+	newest        time.Time
+}
+
+type reportKey struct {
+	instanceID    string
+	aggregateType string
+	eventType     string
+}
+
+// archive moves the events of the policy in bulks until no more events match
+func (a *archiver) archive(ctx context.Context, policy *Policy) ([]*report, error) {
+	archivedAt := a.nowFunc()
+	threshold := archivedAt.Add(-policy.RetainFor)
+
+	stmt, args := a.archiveStmt(policy, threshold, archivedAt)
+	reports := make(map[reportKey]*report)
+	for {
+		archived, err := a.archiveBulk(ctx, stmt, args, policy.Name, archivedAt, reports)
+		if err != nil {
+			return reportList(reports), err
+		}
+		if archived == 0 {
+			return reportList(reports), nil
+		}
+	}
+}
+
+func (a *archiver) archiveStmt(policy *Policy, threshold, archivedAt time.Time) (string, []interface{}) {
+	if len(policy.EventTypes) > 0 {
+		return fmt.Sprintf(archiveStmtFormat, selectEvents), []interface{}{
+			database.StringArray(policy.EventTypes),
+			threshold,
+			archivedAt,
+			a.bulkLimit,
+			database.StringArray(policy.AggregateTypes),
+		}
+	}
+	return fmt.Sprintf(archiveStmtFormat, selectAggregates), []interface{}{
+		database.StringArray(policy.AggregateTypes),
+		threshold,
+		archivedAt,
+		a.bulkLimit,
+	}
+}
+
+func (a *archiver) archiveBulk(ctx context.Context, stmt string, args []interface{}, policy string, archivedAt time.Time, reports map[reportKey]*report) (archived uint64, err error) {
+	rows, err := a.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return 0, errors.ThrowInternal(err, "ARCHI-Ab1qE", "unable to archive events")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		bulk := &report{archivedAt: archivedAt, policy: policy}
+		if err = rows.Scan(&bulk.instanceID, &bulk.aggregateType, &bulk.eventType, &bulk.count, &bulk.oldest, &bulk.newest); err != nil {
+			return 0, errors.ThrowInternal(err, "ARCHI-Ab2sE", "unable to scan archived events")
+		}
+		archived += bulk.count
+		key := reportKey{instanceID: bulk.instanceID, aggregateType: bulk.aggregateType, eventType: bulk.eventType}
+		existing, ok := reports[key]
+		if !ok {
+			reports[key] = bulk
+			continue
+		}
+		existing.count += bulk.count
+		if bulk.oldest.Before(existing.oldest) {
+			existing.oldest = bulk.oldest
+		}
+		if bulk.newest.After(existing.newest) {
+			existing.newest = bulk.newest
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return 0, errors.ThrowInternal(err, "ARCHI-Ab3rE", "unable to scan archived events")
+	}
+	return archived, nil
+}
+
+func (a *archiver) storeReports(ctx context.Context, reports []*report) error {
+	for _, r := range reports {
+		_, err := a.client.ExecContext(ctx, insertReportStmt,
+			r.instanceID,
+			r.archivedAt,
+			r.policy,
+			r.aggregateType,
+			r.eventType,
+			r.count,
+			r.oldest,
+			r.newest,
+		)
+		if err != nil {
+			return errors.ThrowInternal(err, "ARCHI-Ar1iE", "unable to store archive report")
+		}
+	}
+	return nil
+}
+
+func reportList(reports map[reportKey]*report) []*report {
+	list := make([]*report, 0, len(reports))
+	for _, r := range reports {
+		list = append(list, r)
+	}
+	return list
+}
@@ -3,6 +3,7 @@ package eventstore
 import (
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	z_sql "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
@@ -12,8 +13,33 @@ type Config struct {
 	PushTimeout              time.Duration
 	Client                   *database.DB
 	AllowOrderByCreationDate bool
+	// PersonalData encrypts the registered personal data fields of the events
+	// it's not part of the configuration file as it requires the key storage
+	PersonalData *crypto.PersonalDataEncryption
+	// ReadArchive is set if events are moved into the archive,
+	// so that write models which opted into snapshots also filter the archived events if they have no snapshot
+	// it's not part of the configuration file as it's set by the configuration of the archive
+	ReadArchive bool
+	// Notifications wake up the projection handlers of all nodes after events were pushed
+	Notifications NotificationConfig
+	// Snapshots store the state of write models which opted in, so that less events have to be filtered
+	Snapshots SnapshotConfig
 
-	repo repository.Repository
+	repo      repository.Repository
+	notifier  repository.Notifier
+	snapshots *snapshots
+}
+
+type NotificationConfig struct {
+	Enabled bool
+	// PollInterval is used on CockroachDB and as fallback if the node cannot listen on Postgres
+	PollInterval time.Duration
+}
+
+type SnapshotConfig struct {
+	Enabled bool
+	// EventThreshold is the count of events reduced since the last snapshot before a new snapshot is stored
+	EventThreshold uint32
 }
 
 func TestConfig(repo repository.Repository) *Config {
@@ -22,5 +48,14 @@ func TestConfig(repo repository.Repository) *Config {
 
 func Start(config *Config) (*Eventstore, error) {
 	config.repo = z_sql.NewCRDB(config.Client, config.AllowOrderByCreationDate)
+	if config.Notifications.Enabled {
+		config.notifier = z_sql.NewNotifier(config.Client, config.Notifications.PollInterval, config.PushTimeout)
+	}
+	if config.Snapshots.Enabled {
+		config.snapshots = &snapshots{
+			store:     z_sql.NewSnapshots(config.Client),
+			threshold: int(config.Snapshots.EventThreshold),
+		}
+	}
 	return NewEventstore(config), nil
 }
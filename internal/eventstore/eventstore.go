@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 )
@@ -23,6 +25,13 @@ type Eventstore struct {
 	eventTypes        []string
 	aggregateTypes    []string
 	PushTimeout       time.Duration
+	personalData      *crypto.PersonalDataEncryption
+	notifier          repository.Notifier
+	nodeSubscriptions *nodeSubscriptions
+	snapshots         *snapshots
+	readArchive       bool
+
+	personalDataEviction sync.Once
 }
 
 type eventTypeInterceptors struct {
@@ -35,6 +44,11 @@ func NewEventstore(config *Config) *Eventstore {
 		eventInterceptors: map[EventType]eventTypeInterceptors{},
 		interceptorMutex:  sync.Mutex{},
 		PushTimeout:       config.PushTimeout,
+		personalData:      config.PersonalData,
+		notifier:          config.notifier,
+		nodeSubscriptions: &nodeSubscriptions{subscriptions: map[AggregateType][]*NodeSubscription{}},
+		snapshots:         config.snapshots,
+		readArchive:       config.ReadArchive,
 	}
 }
 
@@ -51,6 +65,9 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 	if err != nil {
 		return nil, err
 	}
+	if err = es.encryptPersonalData(events); err != nil {
+		return nil, err
+	}
 
 	if es.PushTimeout > 0 {
 		var cancel func()
@@ -67,8 +84,13 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 	if err != nil {
 		return nil, err
 	}
+	// the reads of the call must see the pushed events
+	for _, event := range eventReaders {
+		call.RaisePosition(ctx, event.Sequence())
+	}
 
 	go notify(eventReaders)
+	go es.notifyNodes(events)
 	return eventReaders, nil
 }
 
@@ -86,6 +108,12 @@ func (es *Eventstore) AggregateTypes() []string {
 
 func commandsToRepository(instanceID string, cmds []Command) (events []*repository.Event, constraints []*repository.UniqueConstraint, err error) {
 	events = make([]*repository.Event, len(cmds))
+	// the sequence of an aggregate is only checked before its first event is stored
+	type aggregateKey struct {
+		id  string
+		typ AggregateType
+	}
+	asserted := make(map[aggregateKey]bool)
 	for i, cmd := range cmds {
 		data, err := EventData(cmd)
 		if err != nil {
@@ -114,6 +142,14 @@ func commandsToRepository(instanceID string, cmds []Command) (events []*reposito
 			Version:       repository.Version(cmd.Aggregate().Version),
 			Data:          data,
 		}
+		if aggregate := cmd.Aggregate(); aggregate.expectedSequence != nil {
+			key := aggregateKey{id: aggregate.ID, typ: aggregate.Type}
+			if !asserted[key] {
+				events[i].ExpectedAggregateSequence = aggregate.expectedSequence
+				events[i].ExpectedEventTypes = eventTypesToRepository(aggregate.expectedEventTypes)
+				asserted[key] = true
+			}
+		}
 		if len(cmd.UniqueConstraints()) > 0 {
 			constraints = append(constraints, uniqueConstraintsToRepository(instanceID, cmd.UniqueConstraints())...)
 		}
@@ -122,6 +158,17 @@ func commandsToRepository(instanceID string, cmds []Command) (events []*reposito
 	return events, constraints, nil
 }
 
+func eventTypesToRepository(types []EventType) []repository.EventType {
+	if len(types) == 0 {
+		return nil
+	}
+	repoTypes := make([]repository.EventType, len(types))
+	for i, typ := range types {
+		repoTypes[i] = repository.EventType(typ)
+	}
+	return repoTypes
+}
+
 func uniqueConstraintsToRepository(instanceID string, constraints []*EventUniqueConstraint) (uniqueConstraints []*repository.UniqueConstraint) {
 	uniqueConstraints = make([]*repository.UniqueConstraint, len(constraints))
 	for i, constraint := range constraints {
@@ -156,6 +203,9 @@ func (es *Eventstore) Filter(ctx context.Context, queryFactory *SearchQueryBuild
 }
 
 func (es *Eventstore) mapEvents(events []*repository.Event) (mappedEvents []Event, err error) {
+	if err = es.decryptPersonalData(events); err != nil {
+		return nil, err
+	}
 	mappedEvents = make([]Event, len(events))
 
 	es.interceptorMutex.Lock()
@@ -192,7 +242,7 @@ func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQu
 	if err != nil {
 		return err
 	}
-
+	setFilteredSequence(r, events)
 	r.AppendEvents(events...)
 
 	return r.Reduce()
@@ -224,11 +274,20 @@ type QueryReducer interface {
 
 // FilterToQueryReducer filters the events based on the search query of the query function,
 // appends all events to the reducer and calls it's reduce function
+// if snapshots are enabled, [SnapshotWriteModel]s are restored from their snapshot first
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
-	events, err := es.Filter(ctx, r.Query())
+	query := r.Query()
+	if wm, ok := r.(SnapshotWriteModel); ok {
+		if es.snapshots != nil {
+			return es.filterToSnapshotWriteModel(ctx, wm)
+		}
+		query.includeArchive = es.readArchive
+	}
+	events, err := es.Filter(ctx, query)
 	if err != nil {
 		return err
 	}
+	setFilteredSequence(r, events)
 	r.AppendEvents(events...)
 
 	return r.Reduce()
@@ -252,6 +311,64 @@ func (es *Eventstore) RegisterFilterEventMapper(aggregateType AggregateType, eve
 	return es
 }
 
+// RegisterPersonalDataFields registers the (json) fields of the event type which contain personal data
+// the fields will be encrypted with a key of the aggregate, which is destroyed by [Eventstore.DestroyPersonalData]
+func (es *Eventstore) RegisterPersonalDataFields(eventType EventType, fields ...string) *Eventstore {
+	if es.personalData == nil || eventType == "" {
+		return es
+	}
+	es.personalData.RegisterFields(string(eventType), fields...)
+	es.personalDataEviction.Do(es.subscribePersonalDataEviction)
+	return es
+}
+
+// PersonalData returns the encryption of the personal data fields
+// it's used to decrypt the events of the v1 eventstore
+func (es *Eventstore) PersonalData() *crypto.PersonalDataEncryption {
+	return es.personalData
+}
+
+// DestroyPersonalData destroys the key of the aggregate,
+// so the personal data of its events can no longer be read.
+// The other nodes are informed by the pushed [PersonalDataDestroyedType] event
+// and evict the cached keys of the instance.
+func (es *Eventstore) DestroyPersonalData(ctx context.Context, aggregateID string) error {
+	if es.personalData == nil {
+		return nil
+	}
+	if err := es.personalData.DestroyKey(authz.GetInstance(ctx).InstanceID(), aggregateID); err != nil {
+		return err
+	}
+	_, err := es.Push(ctx, newPersonalDataDestroyedEvent(ctx, aggregateID))
+	return err
+}
+
+func (es *Eventstore) encryptPersonalData(events []*repository.Event) (err error) {
+	if es.personalData == nil {
+		return nil
+	}
+	for _, event := range events {
+		event.Data, err = es.personalData.EncryptPayload(event.InstanceID, event.AggregateID, string(event.Type), event.Data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (es *Eventstore) decryptPersonalData(events []*repository.Event) (err error) {
+	if es.personalData == nil {
+		return nil
+	}
+	for _, event := range events {
+		event.Data, err = es.personalData.DecryptPayload(event.InstanceID, event.AggregateID, string(event.Type), event.Data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (es *Eventstore) appendEventType(typ EventType) {
 	i := sort.SearchStrings(es.eventTypes, string(typ))
 	if i < len(es.eventTypes) && es.eventTypes[i] == string(typ) {
@@ -5,11 +5,17 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/service"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 )
@@ -36,6 +42,17 @@ func newTestEvent(id, description string, data func() interface{}, checkPrevious
 	}
 }
 
+func newTestEventWithExpectedSequence(id string, sequence uint64) *testEvent {
+	return &testEvent{
+		data: func() interface{} { return nil },
+		BaseEvent: *NewBaseEventForPush(
+			service.WithService(authz.NewMockContext("instanceID", "resourceOwner", "editorUser"), "editorService"),
+			NewAggregate(authz.NewMockContext("zitadel", "caos", "adlerhurst"), id, "test.aggregate", "v1").ExpectSequence(sequence),
+			"test.event",
+		),
+	}
+}
+
 func (e *testEvent) Data() interface{} {
 	return e.data()
 }
@@ -436,6 +453,57 @@ func TestEventstore_aggregatesToEvents(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "expected sequence on first event of aggregate",
+			args: args{
+				instanceID: "instanceID",
+				events: []Command{
+					newTestEventWithExpectedSequence("1", 5),
+					newTestEventWithExpectedSequence("1", 5),
+					newTestEventWithExpectedSequence("2", 0),
+				},
+			},
+			res: res{
+				wantErr: false,
+				events: []*repository.Event{
+					{
+						AggregateID:               "1",
+						AggregateType:             "test.aggregate",
+						Data:                      []byte(nil),
+						EditorService:             "editorService",
+						EditorUser:                "editorUser",
+						ResourceOwner:             sql.NullString{String: "caos", Valid: true},
+						InstanceID:                "instanceID",
+						Type:                      "test.event",
+						Version:                   "v1",
+						ExpectedAggregateSequence: gu.Ptr(uint64(5)),
+					},
+					{
+						AggregateID:   "1",
+						AggregateType: "test.aggregate",
+						Data:          []byte(nil),
+						EditorService: "editorService",
+						EditorUser:    "editorUser",
+						ResourceOwner: sql.NullString{String: "caos", Valid: true},
+						InstanceID:    "instanceID",
+						Type:          "test.event",
+						Version:       "v1",
+					},
+					{
+						AggregateID:               "2",
+						AggregateType:             "test.aggregate",
+						Data:                      []byte(nil),
+						EditorService:             "editorService",
+						EditorUser:                "editorUser",
+						ResourceOwner:             sql.NullString{String: "caos", Valid: true},
+						InstanceID:                "instanceID",
+						Type:                      "test.event",
+						Version:                   "v1",
+						ExpectedAggregateSequence: gu.Ptr(uint64(0)),
+					},
+				},
+			},
+		},
 		{
 			name: "invalid data",
 			args: args{
@@ -1503,6 +1571,57 @@ func compareEvents(t *testing.T, want, got *repository.Event) {
 	}
 }
 
+type testExpectedWriteModel struct {
+	WriteModel
+}
+
+// AppendEvents only appends the events of the aggregate of the write model
+func (wm *testExpectedWriteModel) AppendEvents(events ...Event) {
+	for _, event := range events {
+		if event.Aggregate().ID == wm.AggregateID {
+			wm.WriteModel.AppendEvents(event)
+		}
+	}
+}
+
+func (wm *testExpectedWriteModel) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		AddQuery().
+		AggregateTypes("test.aggregate").
+		EventTypes("test.added", "test.changed").
+		Or().
+		AggregateTypes("other.aggregate").
+		Builder()
+}
+
+func TestAggregate_ExpectWriteModel(t *testing.T) {
+	es := &Eventstore{
+		repo: &testRepo{
+			events: []*repository.Event{
+				{Sequence: 1, AggregateID: "1", AggregateType: "test.aggregate", Type: "test.added", Version: "v1"},
+				{Sequence: 3, AggregateID: "2", AggregateType: "test.aggregate", Type: "test.added", Version: "v1"},
+				{Sequence: 2, AggregateID: "1", AggregateType: "test.aggregate", Type: "test.changed", Version: "v1"},
+			},
+			t: t,
+		},
+		eventInterceptors: map[EventType]eventTypeInterceptors{},
+	}
+	wm := &testExpectedWriteModel{WriteModel: WriteModel{AggregateID: "1"}}
+	err := es.FilterToQueryReducer(context.Background(), wm)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), wm.ProcessedSequence)
+
+	agg := NewAggregate(authz.NewMockContext("instanceID", "caos", "adlerhurst"), "1", "test.aggregate", "v1").ExpectWriteModel(wm)
+	events, _, err := commandsToRepository("instanceID", []Command{&testEvent{
+		data:      func() interface{} { return nil },
+		BaseEvent: *NewBaseEventForPush(context.Background(), agg, "test.changed"),
+	}})
+	require.NoError(t, err)
+	// the filtered event of the other aggregate was pushed after the reduced events
+	assert.Equal(t, gu.Ptr(uint64(3)), events[0].ExpectedAggregateSequence)
+	assert.Equal(t, []repository.EventType{"test.added", "test.changed"}, events[0].ExpectedEventTypes)
+}
+
 func TestEventstore_mapEvents(t *testing.T) {
 	type fields struct {
 		eventMapper map[EventType]func(*repository.Event) (Event, error)
@@ -1607,3 +1726,105 @@ func TestEventstore_mapEvents(t *testing.T) {
 		})
 	}
 }
+
+type testKeyStorage struct {
+	keys crypto.Keys
+}
+
+func (s *testKeyStorage) ReadKeys() (crypto.Keys, error) {
+	return s.keys, nil
+}
+
+func (s *testKeyStorage) ReadKey(id string) (*crypto.Key, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, errors.ThrowNotFound(nil, "", "key not found")
+	}
+	return &crypto.Key{ID: id, Value: key}, nil
+}
+
+func (s *testKeyStorage) CreateKeys(keys ...*crypto.Key) error {
+	for _, key := range keys {
+		s.keys[key.ID] = key.Value
+	}
+	return nil
+}
+
+func (s *testKeyStorage) DeleteKeys(ids ...string) error {
+	for _, id := range ids {
+		delete(s.keys, id)
+	}
+	return nil
+}
+
+// personalDataRepo stores the pushed events and returns them on filter
+type personalDataRepo struct {
+	testRepo
+}
+
+func (repo *personalDataRepo) Push(ctx context.Context, events []*repository.Event, uniqueConstraints ...*repository.UniqueConstraint) error {
+	for _, event := range events {
+		stored := *event
+		stored.Data = append([]byte(nil), event.Data...)
+		repo.events = append(repo.events, &stored)
+	}
+	return nil
+}
+
+// Filter returns copies of the stored events like a database,
+// because the eventstore decrypts the data of the returned events in place
+func (repo *personalDataRepo) Filter(ctx context.Context, searchQuery *repository.SearchQuery) ([]*repository.Event, error) {
+	events := make([]*repository.Event, len(repo.events))
+	for i, event := range repo.events {
+		stored := *event
+		stored.Data = append([]byte(nil), event.Data...)
+		events[i] = &stored
+	}
+	return events, nil
+}
+
+func TestEventstore_personalData(t *testing.T) {
+	repo := &personalDataRepo{testRepo: testRepo{t: t}}
+	es := NewEventstore(&Config{
+		repo:         repo,
+		PersonalData: crypto.NewPersonalDataEncryption(&testKeyStorage{keys: make(crypto.Keys)}),
+	})
+	es.RegisterFilterEventMapper("test.aggregate", "test.event", testFilterMapper).
+		RegisterPersonalDataFields("test.event", "firstName")
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+
+	pushed, err := es.Push(ctx, newTestEvent("1", "", func() interface{} {
+		return []byte(`{"userName":"username","firstName":"Gigi"}`)
+	}, false))
+	if err != nil {
+		t.Fatalf("unexpected error on push: %v", err)
+	}
+	if strings.Contains(string(repo.events[0].Data), "Gigi") {
+		t.Errorf("personal data stored in plain text: %s", repo.events[0].Data)
+	}
+	if data := string(pushed[0].DataAsBytes()); !strings.Contains(data, `"firstName":"Gigi"`) {
+		t.Errorf("personal data of pushed event not decrypted: %s", data)
+	}
+
+	filtered, err := es.Filter(ctx, NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs("1").Builder())
+	if err != nil {
+		t.Fatalf("unexpected error on filter: %v", err)
+	}
+	if data := string(filtered[0].DataAsBytes()); !strings.Contains(data, `"firstName":"Gigi"`) {
+		t.Errorf("personal data of filtered event not decrypted: %s", data)
+	}
+
+	if err = es.DestroyPersonalData(ctx, "1"); err != nil {
+		t.Fatalf("unexpected error on destroy: %v", err)
+	}
+	if destroyed := repo.events[len(repo.events)-1]; destroyed.Type != repository.EventType(PersonalDataDestroyedType) || destroyed.AggregateID != "1" {
+		t.Errorf("other nodes not informed about the destroyed key: %v", destroyed)
+	}
+	filtered, err = es.Filter(ctx, NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs("1").Builder())
+	if err != nil {
+		t.Fatalf("unexpected error on filter: %v", err)
+	}
+	if data := string(filtered[0].DataAsBytes()); strings.Contains(data, "firstName") || !strings.Contains(data, `"userName":"username"`) {
+		t.Errorf("personal data of removed key still readable: %s", data)
+	}
+}
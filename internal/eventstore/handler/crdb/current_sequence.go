@@ -15,6 +15,8 @@ const (
 	currentSequenceStmtFormat          = `SELECT current_sequence, aggregate_type, instance_id FROM %s WHERE projection_name = $1 AND instance_id = ANY ($2) FOR UPDATE`
 	updateCurrentSequencesStmtFormat   = `INSERT INTO %s (projection_name, aggregate_type, current_sequence, instance_id, timestamp) VALUES `
 	updateCurrentSequencesConflictStmt = ` ON CONFLICT (projection_name, aggregate_type, instance_id) DO UPDATE SET current_sequence = EXCLUDED.current_sequence, timestamp = EXCLUDED.timestamp`
+	// archivedSequenceStmt returns the latest sequence of the archived events of the aggregate type
+	archivedSequenceStmt = `SELECT sequence FROM eventstore.archived_sequences WHERE instance_id = $1 AND aggregate_type = $2`
 )
 
 type currentSequences map[eventstore.AggregateType][]*instanceSequence
@@ -141,6 +141,22 @@ func expectCurrentSequence(tableName, projection string, seq uint64, aggregateTy
 	}
 }
 
+func expectArchivedSequence(instanceID, aggregateType string, seq uint64) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectQuery(`SELECT sequence FROM eventstore.archived_sequences WHERE instance_id = \$1 AND aggregate_type = \$2`).
+			WithArgs(instanceID, aggregateType).
+			WillReturnRows(sqlmock.NewRows([]string{"sequence"}).AddRow(seq))
+	}
+}
+
+func expectArchivedSequenceNoRows(instanceID, aggregateType string) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		m.ExpectQuery(`SELECT sequence FROM eventstore.archived_sequences WHERE instance_id = \$1 AND aggregate_type = \$2`).
+			WithArgs(instanceID, aggregateType).
+			WillReturnError(sql.ErrNoRows)
+	}
+}
+
 func expectCurrentSequenceErr(tableName, projection string, instanceIDs []string, err error) func(sqlmock.Sqlmock) {
 	return func(m sqlmock.Sqlmock) {
 		m.ExpectQuery(`SELECT current_sequence, aggregate_type, instance_id FROM `+tableName+` WHERE projection_name = \$1 AND instance_id = ANY \(\$2\) FOR UPDATE`).
@@ -49,6 +49,8 @@ type StatementHandler struct {
 	initialized chan bool
 
 	bulkLimit uint64
+	// config is used to create the shadow handler of a rebuild
+	config StatementHandlerConfig
 }
 
 func NewStatementHandler(
@@ -78,8 +80,11 @@ func NewStatementHandler(
 		Locker:                  NewLocker(config.Client.DB, config.LockTable, config.ProjectionName),
 		initCheck:               config.InitCheck,
 		initialized:             make(chan bool),
+		config:                  config,
 	}
 
+	// the projections of the instances are stored in the shard of the instance
+	config.ProjectionHandlerConfig.Shards = config.Client
 	h.ProjectionHandler = handler.NewProjectionHandler(ctx, config.ProjectionHandlerConfig, h.reduce, h.Update, h.SearchQuery, h.Lock, h.Unlock, h.initialized)
 
 	return h
@@ -91,6 +96,11 @@ func (h *StatementHandler) Start() {
 	h.Subscribe(h.aggregates...)
 }
 
+// Name returns the name of the projection, which is also the name of its table
+func (h *StatementHandler) Name() string {
+	return h.ProjectionName
+}
+
 func (h *StatementHandler) SearchQuery(ctx context.Context, instanceIDs []string) (*eventstore.SearchQueryBuilder, uint64, error) {
 	sequences, err := h.currentSequences(ctx, h.client.QueryContext, instanceIDs)
 	if err != nil {
@@ -231,7 +241,7 @@ stmts:
 				i--
 				continue stmts
 			}
-			if stmt.PreviousSequence > 0 && stmt.PreviousSequence != sequence.sequence && stmt.InstanceID == sequence.instanceID {
+			if stmt.PreviousSequence > 0 && stmt.PreviousSequence != sequence.sequence && stmt.InstanceID == sequence.instanceID && !h.previousArchived(tx, stmt, sequence.sequence) {
 				logging.WithFields("projection", h.ProjectionName, "aggregateType", stmt.AggregateType, "sequence", stmt.Sequence, "prevSeq", stmt.PreviousSequence, "currentSeq", sequence.sequence).Warn("sequences do not match")
 				break stmts
 			}
@@ -255,6 +265,24 @@ stmts:
 	return lastSuccessfulIdx
 }
 
+// previousArchived checks if the previous event of the statement was moved into the archive,
+// archived events cannot be reduced anymore, therefore the projection continues after them
+func (h *StatementHandler) previousArchived(tx *sql.Tx, stmt *handler.Statement, currentSequence uint64) bool {
+	if stmt.PreviousSequence < currentSequence {
+		return false
+	}
+	var archivedSequence uint64
+	err := tx.QueryRow(archivedSequenceStmt, stmt.InstanceID, stmt.AggregateType).Scan(&archivedSequence)
+	if err == sql.ErrNoRows {
+		return false
+	}
+	if err != nil {
+		logging.WithFields("projection", h.ProjectionName, "aggregateType", stmt.AggregateType).OnError(err).Warn("unable to query archived sequence")
+		return false
+	}
+	return stmt.PreviousSequence <= archivedSequence
+}
+
 // executeStmt handles sql statements
 // an error is returned if the statement could not be inserted properly
 func (h *StatementHandler) executeStmt(tx *sql.Tx, stmt *handler.Statement) error {
@@ -872,10 +872,172 @@ func TestStatementHandler_executeStmts(t *testing.T) {
 					expectSavePoint(),
 					expectCreate("my_projection", []string{"col1"}, []string{"$1"}),
 					expectSavePointRelease(),
+					expectArchivedSequenceNoRows("", "agg"),
 				},
 				idx: 0,
 			},
 		},
+		{
+			name: "previous event archived",
+			fields: fields{
+				projectionName: "my_projection",
+			},
+			args: args{
+				stmts: []*handler.Statement{
+					NewCreateStatement(
+						&testEvent{
+							aggregateType:    "agg",
+							sequence:         8,
+							previousSequence: 7,
+						},
+						[]handler.Column{
+							{
+								Name:  "col1",
+								Value: "val1",
+							},
+						}),
+				},
+				sequences: currentSequences{
+					"agg": []*instanceSequence{
+						{sequence: 2},
+					},
+				},
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectArchivedSequence("", "agg", 7),
+					expectSavePoint(),
+					expectCreate("my_projection", []string{"col1"}, []string{"$1"}),
+					expectSavePointRelease(),
+				},
+				idx: 0,
+			},
+		},
+		{
+			name: "previous event not archived",
+			fields: fields{
+				projectionName: "my_projection",
+			},
+			args: args{
+				stmts: []*handler.Statement{
+					NewCreateStatement(
+						&testEvent{
+							aggregateType:    "agg",
+							sequence:         8,
+							previousSequence: 7,
+						},
+						[]handler.Column{
+							{
+								Name:  "col1",
+								Value: "val1",
+							},
+						}),
+				},
+				sequences: currentSequences{
+					"agg": []*instanceSequence{
+						{sequence: 2},
+					},
+				},
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectArchivedSequence("", "agg", 5),
+				},
+				idx: -1,
+			},
+		},
+		{
+			// the sequences of a migrated v1 view are the latest events of each aggregate type the view handled
+			name: "migrated view sequences, events of other aggregate types",
+			fields: fields{
+				projectionName: "my_projection",
+			},
+			args: args{
+				stmts: []*handler.Statement{
+					NewCreateStatement(
+						&testEvent{
+							aggregateType:    "org",
+							sequence:         120,
+							previousSequence: 50,
+							instanceID:       "instanceID",
+						},
+						[]handler.Column{
+							{
+								Name:  "col1",
+								Value: "val",
+							},
+						}),
+					NewCreateStatement(
+						&testEvent{
+							aggregateType:    "user",
+							sequence:         130,
+							previousSequence: 100,
+							instanceID:       "instanceID",
+						},
+						[]handler.Column{
+							{
+								Name:  "col2",
+								Value: "val",
+							},
+						}),
+				},
+				sequences: currentSequences{
+					"user": []*instanceSequence{
+						{sequence: 100, instanceID: "instanceID"},
+					},
+					"org": []*instanceSequence{
+						{sequence: 50, instanceID: "instanceID"},
+					},
+				},
+			},
+			want: want{
+				expectations: []mockExpectation{
+					expectSavePoint(),
+					expectCreate("my_projection", []string{"col1"}, []string{"$1"}),
+					expectSavePointRelease(),
+					expectSavePoint(),
+					expectCreate("my_projection", []string{"col2"}, []string{"$1"}),
+					expectSavePointRelease(),
+				},
+				idx: 1,
+			},
+		},
+		{
+			// the sequence of the view is not the latest event of each aggregate type
+			name: "view sequence for all aggregate types, events of other aggregate types",
+			fields: fields{
+				projectionName: "my_projection",
+			},
+			args: args{
+				stmts: []*handler.Statement{
+					NewCreateStatement(
+						&testEvent{
+							aggregateType:    "org",
+							sequence:         120,
+							previousSequence: 50,
+							instanceID:       "instanceID",
+						},
+						[]handler.Column{
+							{
+								Name:  "col1",
+								Value: "val",
+							},
+						}),
+				},
+				sequences: currentSequences{
+					"user": []*instanceSequence{
+						{sequence: 100, instanceID: "instanceID"},
+					},
+					"org": []*instanceSequence{
+						{sequence: 100, instanceID: "instanceID"},
+					},
+				},
+			},
+			want: want{
+				expectations: []mockExpectation{},
+				idx:          -1,
+			},
+		},
 		{
 			name: "execute fails not continue",
 			fields: fields{
@@ -198,7 +198,7 @@ func (h *StatementHandler) Init(ctx context.Context) error {
 	}
 	for i, execute := range check.Executes {
 		logging.WithFields("projection", h.ProjectionName, "execute", i).Debug("executing check")
-		next, err := execute(h.client, h.ProjectionName)
+		next, err := execute(h.client.Shard(ctx), h.ProjectionName)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -0,0 +1,276 @@
+package crdb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	// rebuildInfix separates the name of the projection from the id of the rebuild in the name of the shadow tables
+	rebuildInfix = "_rebuild_"
+	// rebuildInstanceBatch is the count of instances reduced into the shadow tables at once
+	rebuildInstanceBatch = 20
+	// rebuildSwapAttempts limits the catch ups of the shadow tables
+	// if the projection is updated faster than the shadow tables
+	rebuildSwapAttempts = 10
+
+	projectionTablesStmt = "SELECT table_name, table_type FROM information_schema.tables" +
+		" WHERE table_schema = $1 AND (table_name = $2 OR table_name LIKE $3)"
+	projectionIndexesStmt = "SELECT indexname FROM pg_indexes WHERE schemaname = $1 AND indexname LIKE $2"
+)
+
+// Rebuild reduces all events into shadow tables of the projection
+// and replaces the tables of the projection as soon as the shadow tables caught up.
+// The projection stays readable and up to date during the rebuild.
+// Projections which are views cannot be rebuilt.
+func (h *StatementHandler) Rebuild(ctx context.Context) (err error) {
+	schema, table := splitTableName(h.ProjectionName)
+	tables, err := h.projectionTables(ctx, schema, table)
+	if err != nil {
+		return err
+	}
+	for _, t := range tables {
+		if t.isView {
+			return errors.ThrowPreconditionFailed(nil, "CRDB-Rb1vW", "views cannot be rebuilt")
+		}
+	}
+
+	shadowConfig := h.config
+	shadowConfig.ProjectionName = h.ProjectionName + rebuildInfix + strconv.FormatInt(time.Now().Unix(), 36)
+	// the shadow handler is never started, it's only triggered by the rebuild
+	// cancelling its context stops the handler waiting for the start
+	shadowCtx, cancelShadow := context.WithCancel(ctx)
+	defer cancelShadow()
+	shadow := NewStatementHandler(shadowCtx, shadowConfig)
+	defer func() {
+		if err != nil {
+			cleanupErr := shadow.dropShadow(ctx)
+			logging.WithFields("projection", h.ProjectionName, "shadow", shadow.ProjectionName).OnError(cleanupErr).Error("unable to drop shadow tables")
+		}
+	}()
+	if err = shadow.Init(ctx); err != nil {
+		return err
+	}
+
+	var (
+		instanceIDs []string
+		swapped     bool
+	)
+	for attempt := 0; attempt < rebuildSwapAttempts; attempt++ {
+		instanceIDs, err = h.Eventstore.InstanceIDs(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).AddQuery().ExcludedInstanceID("").Builder())
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(instanceIDs); i += rebuildInstanceBatch {
+			end := i + rebuildInstanceBatch
+			if end > len(instanceIDs) {
+				end = len(instanceIDs)
+			}
+			logging.WithFields("projection", h.ProjectionName, "instances", end, "of", len(instanceIDs)).Info("rebuild shadow tables")
+			if err = shadow.Trigger(ctx, instanceIDs[i:end]...); err != nil {
+				return err
+			}
+		}
+		swapped, err = h.swap(ctx, &shadow, instanceIDs)
+		if err != nil {
+			return err
+		}
+		if swapped {
+			return nil
+		}
+		logging.WithFields("projection", h.ProjectionName, "attempt", attempt).Info("shadow tables behind projection, catching up")
+	}
+	err = errors.ThrowInternal(nil, "CRDB-Rb2cU", "shadow tables did not catch up")
+	return err
+}
+
+type projectionTable struct {
+	name   string
+	isView bool
+}
+
+// projectionTables returns the table of the projection followed by its suffixed tables,
+// tables of other rebuilds are ignored
+func (h *StatementHandler) projectionTables(ctx context.Context, schema, table string) ([]*projectionTable, error) {
+	rows, err := h.client.QueryContext(ctx, projectionTablesStmt, schema, table, escapeLike(table+"_")+"%")
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Rb3qT", "unable to query projection tables")
+	}
+	defer rows.Close()
+	tables := make([]*projectionTable, 0, 1)
+	for rows.Next() {
+		var name, tableType string
+		if err = rows.Scan(&name, &tableType); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-Rb4sC", "unable to scan projection tables")
+		}
+		if strings.HasPrefix(name, table+rebuildInfix) {
+			continue
+		}
+		t := &projectionTable{name: name, isView: tableType == "VIEW"}
+		if name == table {
+			tables = append([]*projectionTable{t}, tables...)
+			continue
+		}
+		tables = append(tables, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-Rb5rW", "unable to scan projection tables")
+	}
+	return tables, nil
+}
+
+// swap replaces the tables of the projection by the shadow tables if the shadow tables caught up.
+// The current sequences of the projection are locked, so the projection cannot be updated during the swap.
+func (h *StatementHandler) swap(ctx context.Context, shadow *StatementHandler, instanceIDs []string) (swapped bool, err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.ThrowInternal(err, "CRDB-Rb6bT", "begin failed")
+	}
+	defer func() {
+		if !swapped || err != nil {
+			tx.Rollback()
+		}
+	}()
+	sequences, err := h.currentSequences(ctx, tx.QueryContext, instanceIDs)
+	if err != nil {
+		return false, err
+	}
+	shadowSequences, err := shadow.currentSequences(ctx, tx.QueryContext, instanceIDs)
+	if err != nil {
+		return false, err
+	}
+	if !caughtUp(sequences, shadowSequences) {
+		return false, nil
+	}
+
+	schema, table := splitTableName(h.ProjectionName)
+	_, shadowTable := splitTableName(shadow.ProjectionName)
+	shadowTables, err := shadow.projectionTables(ctx, schema, shadowTable)
+	if err != nil {
+		return false, err
+	}
+	// the suffixed tables reference the main table, therefore they are dropped first
+	for i := len(shadowTables) - 1; i >= 0; i-- {
+		target := table + strings.TrimPrefix(shadowTables[i].name, shadowTable)
+		if _, err = tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+schema+"."+target); err != nil {
+			return false, errors.ThrowInternal(err, "CRDB-Rb7dP", "unable to drop projection table")
+		}
+	}
+	for _, t := range shadowTables {
+		target := table + strings.TrimPrefix(t.name, shadowTable)
+		if _, err = tx.ExecContext(ctx, "ALTER TABLE "+schema+"."+t.name+" RENAME TO "+target); err != nil {
+			return false, errors.ThrowInternal(err, "CRDB-Rb8rN", "unable to rename shadow table")
+		}
+	}
+	if err = h.renameShadowIndexes(ctx, tx, shadow); err != nil {
+		return false, err
+	}
+	for _, stmt := range []struct {
+		query string
+		args  []interface{}
+	}{
+		{"DELETE FROM " + h.config.SequenceTable + " WHERE projection_name = $1", []interface{}{h.ProjectionName}},
+		{"UPDATE " + h.config.SequenceTable + " SET projection_name = $1 WHERE projection_name = $2", []interface{}{h.ProjectionName, shadow.ProjectionName}},
+		{"DELETE FROM " + h.config.FailedEventsTable + " WHERE projection_name = $1", []interface{}{h.ProjectionName}},
+		{"UPDATE " + h.config.FailedEventsTable + " SET projection_name = $1 WHERE projection_name = $2", []interface{}{h.ProjectionName, shadow.ProjectionName}},
+		{"DELETE FROM " + h.config.LockTable + " WHERE projection_name = $1", []interface{}{shadow.ProjectionName}},
+	} {
+		if _, err = tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return false, errors.ThrowInternal(err, "CRDB-Rb9sQ", "unable to move state of shadow tables")
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return false, errors.ThrowInternal(err, "CRDB-RbAcM", "unable to commit swap")
+	}
+	return true, nil
+}
+
+// renameShadowIndexes renames the indexes of the swapped tables,
+// otherwise the init of the projection would create them again
+func (h *StatementHandler) renameShadowIndexes(ctx context.Context, tx *sql.Tx, shadow *StatementHandler) error {
+	schema, table := splitTableName(h.ProjectionName)
+	_, shadowTable := splitTableName(shadow.ProjectionName)
+	rows, err := tx.QueryContext(ctx, projectionIndexesStmt, schema, escapeLike(shadowTable)+"%")
+	if err != nil {
+		return errors.ThrowInternal(err, "CRDB-RbDiQ", "unable to query indexes of shadow tables")
+	}
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err = rows.Scan(&index); err != nil {
+			rows.Close()
+			return errors.ThrowInternal(err, "CRDB-RbEiS", "unable to scan indexes of shadow tables")
+		}
+		indexes = append(indexes, index)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return errors.ThrowInternal(err, "CRDB-RbFiS", "unable to scan indexes of shadow tables")
+	}
+	for _, index := range indexes {
+		if _, err = tx.ExecContext(ctx, "ALTER INDEX "+schema+"."+index+" RENAME TO "+table+strings.TrimPrefix(index, shadowTable)); err != nil {
+			return errors.ThrowInternal(err, "CRDB-RbGiR", "unable to rename index of shadow table")
+		}
+	}
+	return nil
+}
+
+func (h *StatementHandler) dropShadow(ctx context.Context) error {
+	schema, table := splitTableName(h.ProjectionName)
+	tables, err := h.projectionTables(ctx, schema, table)
+	if err != nil {
+		return err
+	}
+	for i := len(tables) - 1; i >= 0; i-- {
+		if _, err = h.client.ExecContext(ctx, "DROP TABLE IF EXISTS "+schema+"."+tables[i].name); err != nil {
+			return errors.ThrowInternal(err, "CRDB-RbBdT", "unable to drop shadow table")
+		}
+	}
+	for _, table := range []string{h.config.SequenceTable, h.config.FailedEventsTable, h.config.LockTable} {
+		if _, err = h.client.ExecContext(ctx, "DELETE FROM "+table+" WHERE projection_name = $1", h.ProjectionName); err != nil {
+			return errors.ThrowInternal(err, "CRDB-RbCdS", "unable to delete state of shadow tables")
+		}
+	}
+	return nil
+}
+
+// caughtUp checks if the shadow reduced at least the events the projection reduced
+func caughtUp(sequences, shadowSequences currentSequences) bool {
+	for aggregateType, instances := range sequences {
+	instances:
+		for _, instance := range instances {
+			for _, shadowInstance := range shadowSequences[aggregateType] {
+				if shadowInstance.instanceID == instance.instanceID {
+					if shadowInstance.sequence < instance.sequence {
+						return false
+					}
+					continue instances
+				}
+			}
+			if instance.sequence > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func splitTableName(name string) (schema, table string) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "public", name
+	}
+	return name[:i], name[i+1:]
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "_", "\\_", "%", "\\%").Replace(s)
+}
@@ -0,0 +1,167 @@
+package crdb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+)
+
+func Test_caughtUp(t *testing.T) {
+	tests := []struct {
+		name            string
+		sequences       currentSequences
+		shadowSequences currentSequences
+		want            bool
+	}{
+		{
+			name:            "no sequences",
+			sequences:       currentSequences{},
+			shadowSequences: currentSequences{},
+			want:            true,
+		},
+		{
+			name: "shadow equal",
+			sequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}},
+			},
+			shadowSequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}},
+			},
+			want: true,
+		},
+		{
+			name: "shadow ahead",
+			sequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}},
+			},
+			shadowSequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 6}},
+			},
+			want: true,
+		},
+		{
+			name: "shadow behind",
+			sequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}},
+			},
+			shadowSequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 4}},
+			},
+			want: false,
+		},
+		{
+			name: "instance missing in shadow",
+			sequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}, {instanceID: "instance2", sequence: 3}},
+			},
+			shadowSequences: currentSequences{
+				"agg": {{instanceID: "instance", sequence: 5}},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, caughtUp(tt.sequences, tt.shadowSequences))
+		})
+	}
+}
+
+func TestStatementHandler_swap(t *testing.T) {
+	const (
+		sequenceTable = "projections.current_sequences"
+		failedTable   = "projections.failed_events"
+		locksTable    = "projections.locks"
+		projection    = "projections.my_projection"
+		shadowName    = "projections.my_projection_rebuild_abc"
+	)
+	currentSequenceStmt := fmt.Sprintf(currentSequenceStmtFormat, sequenceTable)
+	tests := []struct {
+		name         string
+		expectations func(sqlmock.Sqlmock)
+		want         bool
+	}{
+		{
+			name: "shadow behind",
+			expectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(currentSequenceStmt).
+					WithArgs(projection, database.StringArray{"instance"}).
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence", "aggregate_type", "instance_id"}).AddRow(5, "agg", "instance"))
+				mock.ExpectQuery(currentSequenceStmt).
+					WithArgs(shadowName, database.StringArray{"instance"}).
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence", "aggregate_type", "instance_id"}).AddRow(4, "agg", "instance"))
+				mock.ExpectRollback()
+			},
+			want: false,
+		},
+		{
+			name: "swapped",
+			expectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(currentSequenceStmt).
+					WithArgs(projection, database.StringArray{"instance"}).
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence", "aggregate_type", "instance_id"}).AddRow(5, "agg", "instance"))
+				mock.ExpectQuery(currentSequenceStmt).
+					WithArgs(shadowName, database.StringArray{"instance"}).
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence", "aggregate_type", "instance_id"}).AddRow(5, "agg", "instance"))
+				mock.ExpectQuery(projectionTablesStmt).
+					WithArgs("projections", "my_projection_rebuild_abc", `my\_projection\_rebuild\_abc\_%`).
+					WillReturnRows(sqlmock.NewRows([]string{"table_name", "table_type"}).
+						AddRow("my_projection_rebuild_abc_suffix", "BASE TABLE").
+						AddRow("my_projection_rebuild_abc", "BASE TABLE"),
+					)
+				mock.ExpectExec("DROP TABLE IF EXISTS projections.my_projection_suffix").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DROP TABLE IF EXISTS projections.my_projection").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("ALTER TABLE projections.my_projection_rebuild_abc RENAME TO my_projection").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("ALTER TABLE projections.my_projection_rebuild_abc_suffix RENAME TO my_projection_suffix").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(projectionIndexesStmt).
+					WithArgs("projections", `my\_projection\_rebuild\_abc%`).
+					WillReturnRows(sqlmock.NewRows([]string{"indexname"}).AddRow("my_projection_rebuild_abc_suffix_idx"))
+				mock.ExpectExec("ALTER INDEX projections.my_projection_rebuild_abc_suffix_idx RENAME TO my_projection_suffix_idx").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM " + sequenceTable + " WHERE projection_name = $1").WithArgs(projection).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE "+sequenceTable+" SET projection_name = $1 WHERE projection_name = $2").WithArgs(projection, shadowName).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM " + failedTable + " WHERE projection_name = $1").WithArgs(projection).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE "+failedTable+" SET projection_name = $1 WHERE projection_name = $2").WithArgs(projection, shadowName).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE FROM " + locksTable + " WHERE projection_name = $1").WithArgs(shadowName).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+			defer client.Close()
+			tt.expectations(mock)
+
+			config := StatementHandlerConfig{
+				SequenceTable:     sequenceTable,
+				FailedEventsTable: failedTable,
+				LockTable:         locksTable,
+			}
+			newHandler := func(name string) *StatementHandler {
+				return &StatementHandler{
+					ProjectionHandler:   &handler.ProjectionHandler{ProjectionName: name},
+					client:              &database.DB{DB: client},
+					sequenceTable:       sequenceTable,
+					currentSequenceStmt: currentSequenceStmt,
+					config:              config,
+				}
+			}
+
+			swapped, err := newHandler(projection).swap(context.Background(), newHandler(shadowName), []string{"instance"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, swapped)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -209,6 +209,18 @@ func NewMultiStatement(event eventstore.Event, opts ...func(eventstore.Event) Ex
 	}
 }
 
+// NewStatement creates a statement which runs the given exec
+// it's used by projections which have to read their current state to reduce an event
+func NewStatement(event eventstore.Event, e Exec) *handler.Statement {
+	return &handler.Statement{
+		AggregateType:    event.Aggregate().Type,
+		Sequence:         event.Sequence(),
+		PreviousSequence: event.PreviousAggregateTypeSequence(),
+		InstanceID:       event.Aggregate().InstanceID,
+		Execute:          e,
+	}
+}
+
 type Exec func(ex handler.Executer, projectionName string) error
 
 func AddCreateStatement(columns []handler.Column, opts ...execOption) func(eventstore.Event) Exec {
@@ -278,6 +290,17 @@ func NewArrayIntersectCol(column string, value interface{}) handler.Column {
 	}
 }
 
+// NewOneOfTextCond matches all rows where the value of the column is one of the given values
+func NewOneOfTextCond(column string, values []string) handler.Condition {
+	return handler.Condition{
+		Name:  column,
+		Value: database.StringArray(values),
+		ParameterOpt: func(placeholder string) string {
+			return column + " = ANY(" + placeholder + ")"
+		},
+	}
+}
+
 func NewCopyCol(column, from string) handler.Column {
 	return handler.Column{
 		Name:  column,
@@ -389,7 +412,11 @@ func conditionsToWhere(cols []handler.Condition, paramOffset int) (wheres []stri
 	values = make([]interface{}, len(cols))
 
 	for i, col := range cols {
-		wheres[i] = "(" + col.Name + " = $" + strconv.Itoa(i+1+paramOffset) + ")"
+		placeholder := "$" + strconv.Itoa(i+1+paramOffset)
+		wheres[i] = "(" + col.Name + " = " + placeholder + ")"
+		if col.ParameterOpt != nil {
+			wheres[i] = "(" + col.ParameterOpt(placeholder) + ")"
+		}
 		values[i] = col.Value
 	}
 
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler"
 )
@@ -708,6 +709,39 @@ func TestNewDeleteStatement(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "less than condition",
+			args: args{
+				table: "my_table",
+				event: &testEvent{
+					sequence:         1,
+					previousSequence: 0,
+					aggregateType:    "agg",
+				},
+				conditions: []handler.Condition{
+					handler.NewCond("col1", 1),
+					handler.NewLessThanCond("col2", 5),
+				},
+			},
+			want: want{
+				table:            "my_table",
+				aggregateType:    "agg",
+				sequence:         1,
+				previousSequence: 1,
+				executer: &wantExecuter{
+					params: []params{
+						{
+							query: "DELETE FROM my_table WHERE (col1 = $1) AND (col2 < $2)",
+							args:  []interface{}{1, 5},
+						},
+					},
+					shouldExecute: true,
+				},
+				isErr: func(err error) bool {
+					return err == nil
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -760,6 +794,80 @@ func TestNewNoOpStatement(t *testing.T) {
 	}
 }
 
+func TestNewStatement(t *testing.T) {
+	type args struct {
+		event *testEvent
+		exec  Exec
+	}
+	type want struct {
+		aggregateType    eventstore.AggregateType
+		sequence         uint64
+		previousSequence uint64
+		isErr            func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "exec returns no error",
+			args: args{
+				event: &testEvent{
+					aggregateType:    "agg",
+					sequence:         5,
+					previousSequence: 3,
+				},
+				exec: func(handler.Executer, string) error { return nil },
+			},
+			want: want{
+				aggregateType:    "agg",
+				sequence:         5,
+				previousSequence: 3,
+				isErr: func(err error) bool {
+					return err == nil
+				},
+			},
+		},
+		{
+			name: "exec returns error",
+			args: args{
+				event: &testEvent{
+					aggregateType:    "agg",
+					sequence:         5,
+					previousSequence: 3,
+				},
+				exec: func(handler.Executer, string) error { return errTestErr },
+			},
+			want: want{
+				aggregateType:    "agg",
+				sequence:         5,
+				previousSequence: 3,
+				isErr: func(err error) bool {
+					return errors.Is(err, errTestErr)
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := NewStatement(tt.args.event, tt.args.exec)
+			if stmt.AggregateType != tt.want.aggregateType {
+				t.Errorf("wrong aggregate type: want %q got %q", tt.want.aggregateType, stmt.AggregateType)
+			}
+			if stmt.Sequence != tt.want.sequence {
+				t.Errorf("wrong sequence: want %d got %d", tt.want.sequence, stmt.Sequence)
+			}
+			if stmt.PreviousSequence != tt.want.previousSequence {
+				t.Errorf("wrong previous sequence: want %d got %d", tt.want.previousSequence, stmt.PreviousSequence)
+			}
+			if err := stmt.Execute(nil, "my_projection"); !tt.want.isErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestNewMultiStatement(t *testing.T) {
 	type args struct {
 		table string
@@ -1525,3 +1633,13 @@ func TestParameterOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestNewOneOfTextCond(t *testing.T) {
+	cond := NewOneOfTextCond("testCol", []string{"a", "b"})
+	if param := cond.ParameterOpt("$1"); param != "testCol = ANY($1)" {
+		t.Errorf("NewOneOfTextCond() = %v, want %v", param, "testCol = ANY($1)")
+	}
+	if !reflect.DeepEqual(cond.Value, database.StringArray{"a", "b"}) {
+		t.Errorf("wrong value: %v", cond.Value)
+	}
+}
@@ -0,0 +1,77 @@
+package crdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	statusStmtFormat = "SELECT instance_id, MAX(current_sequence), MAX(timestamp) FROM %s" +
+		" WHERE projection_name = $1" +
+		" GROUP BY instance_id" +
+		" ORDER BY instance_id"
+)
+
+// ProjectionStatus is the progress of the projection on an instance
+type ProjectionStatus struct {
+	ProjectionName  string
+	InstanceID      string
+	CurrentSequence uint64
+	// LatestSequence is the sequence of the latest event of the instance the projection reduces
+	LatestSequence uint64
+	// LastRun is the time the projection was updated on the instance
+	LastRun time.Time
+}
+
+// Lag is the distance between the sequences of the latest event and the reduced event,
+// as the sequence is counted per instance it includes events the projection does not reduce
+func (s *ProjectionStatus) Lag() uint64 {
+	if s.LatestSequence < s.CurrentSequence {
+		return 0
+	}
+	return s.LatestSequence - s.CurrentSequence
+}
+
+// Status returns the progress of the projection on all instances it reduced events of
+func (h *StatementHandler) Status(ctx context.Context) ([]*ProjectionStatus, error) {
+	rows, err := h.client.QueryContext(ctx, fmt.Sprintf(statusStmtFormat, h.sequenceTable), h.ProjectionName)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-St1qS", "unable to query projection status")
+	}
+	defer rows.Close()
+	statuses := make([]*ProjectionStatus, 0)
+	for rows.Next() {
+		status := &ProjectionStatus{ProjectionName: h.ProjectionName}
+		var lastRun sql.NullTime
+		if err = rows.Scan(&status.InstanceID, &status.CurrentSequence, &lastRun); err != nil {
+			return nil, errors.ThrowInternal(err, "CRDB-St2sC", "unable to scan projection status")
+		}
+		status.LastRun = lastRun.Time
+		statuses = append(statuses, status)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-St3rE", "unable to scan projection status")
+	}
+	if err = rows.Close(); err != nil {
+		return nil, errors.ThrowInternal(err, "CRDB-St4cL", "close rows failed")
+	}
+
+	for _, status := range statuses {
+		status.LatestSequence, err = h.Eventstore.LatestSequence(ctx,
+			eventstore.NewSearchQueryBuilder(eventstore.ColumnsMaxSequence).
+				AddQuery().
+				InstanceID(status.InstanceID).
+				AggregateTypes(h.aggregates...).
+				Builder(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
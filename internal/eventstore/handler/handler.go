@@ -11,24 +11,35 @@ type Handler struct {
 	Eventstore *eventstore.Eventstore
 	Sub        *eventstore.Subscription
 	EventQueue chan eventstore.Event
+	// NodeSub receives the instances on which other nodes pushed events
+	NodeSub   *eventstore.NodeSubscription
+	NodeQueue chan string
 }
 
 func NewHandler(config HandlerConfig) Handler {
 	return Handler{
 		Eventstore: config.Eventstore,
 		EventQueue: make(chan eventstore.Event, 100),
+		NodeQueue:  make(chan string, 100),
 	}
 }
 
 func (h *Handler) Subscribe(aggregates ...eventstore.AggregateType) {
 	h.Sub = eventstore.SubscribeAggregates(h.EventQueue, aggregates...)
+	h.NodeSub = h.Eventstore.SubscribeNodes(h.NodeQueue, aggregates...)
 }
 
 func (h *Handler) SubscribeEvents(types map[eventstore.AggregateType][]eventstore.EventType) {
 	h.Sub = eventstore.SubscribeEventTypes(h.EventQueue, types)
+	aggregates := make([]eventstore.AggregateType, 0, len(types))
+	for aggregate := range types {
+		aggregates = append(aggregates, aggregate)
+	}
+	h.NodeSub = h.Eventstore.SubscribeNodes(h.NodeQueue, aggregates...)
 }
 
 func (h *Handler) Unsubscribe() {
+	h.Eventstore.UnsubscribeNodes(h.NodeSub)
 	if h.Sub == nil {
 		return
 	}
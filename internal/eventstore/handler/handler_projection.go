@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
@@ -16,8 +17,16 @@ const (
 	schedulerSucceeded = eventstore.EventType("system.projections.scheduler.succeeded")
 	aggregateType      = eventstore.AggregateType("system")
 	aggregateID        = "SYSTEM"
+	systemLock         = "system"
 )
 
+// Shards are the databases the instances are stored in,
+// the projection is stored in the database of the instance, see [database.DB]
+type Shards interface {
+	ShardNames() []string
+	ShardOf(ctx context.Context, instanceID string) (string, error)
+}
+
 type ProjectionHandlerConfig struct {
 	HandlerConfig
 	ProjectionName        string
@@ -26,6 +35,8 @@ type ProjectionHandlerConfig struct {
 	Retries               uint
 	ConcurrentInstances   uint
 	HandleActiveInstances time.Duration
+	// Shards are optional, without shards the projection is only stored in the database of the context
+	Shards Shards
 }
 
 // Update updates the projection with the given statements
@@ -47,6 +58,9 @@ type Unlock func(...string) error
 // NowFunc makes time.Now() mockable
 type NowFunc func() time.Time
 
+// Processed is called after the events were reduced into the projection
+type Processed func(ctx context.Context, events []eventstore.Event)
+
 type ProjectionHandler struct {
 	Handler
 	ProjectionName        string
@@ -62,6 +76,8 @@ type ProjectionHandler struct {
 	concurrentInstances   int
 	handleActiveInstances time.Duration
 	nowFunc               NowFunc
+	processed             []Processed
+	shards                Shards
 }
 
 func NewProjectionHandler(
@@ -93,13 +109,27 @@ func NewProjectionHandler(
 		concurrentInstances:   concurrentInstances,
 		handleActiveInstances: config.HandleActiveInstances,
 		nowFunc:               time.Now,
+		shards:                config.Shards,
 	}
 
 	go func() {
-		<-initialized
+		select {
+		case <-initialized:
+		case <-ctx.Done():
+			return
+		}
 		go h.subscribe(ctx)
 
+		go h.listenNodes(ctx)
+
 		go h.schedule(ctx)
+		// every shard schedules its instances on its own
+		for _, shard := range h.shardNames() {
+			if shard == "" {
+				continue
+			}
+			go h.scheduleShard(database.WithShard(ctx, shard), time.NewTimer(0))
+		}
 	}()
 
 	return h
@@ -130,6 +160,13 @@ func (h *ProjectionHandler) Trigger(ctx context.Context, instances ...string) er
 	}
 }
 
+// OnProcessed registers a hook which is called after events were processed,
+// e.g. to invalidate caches of the projection.
+// Hooks must be registered before the projection is started.
+func (h *ProjectionHandler) OnProcessed(hook Processed) {
+	h.processed = append(h.processed, hook)
+}
+
 // Process handles multiple events by reducing them to statements and updating the projection
 func (h *ProjectionHandler) Process(ctx context.Context, events ...eventstore.Event) (index int, err error) {
 	if len(events) == 0 {
@@ -143,6 +180,12 @@ func (h *ProjectionHandler) Process(ctx context.Context, events ...eventstore.Ev
 			return index, err
 		}
 	}
+	// the hooks are also called if not all events were processed, which is harmless for invalidations
+	defer func() {
+		for _, hook := range h.processed {
+			hook(ctx, events)
+		}
+	}()
 	for retry := 0; retry <= h.retries; retry++ {
 		index, err = h.update(ctx, statements[index+1:], h.reduce)
 		if err != nil && !errors.Is(err, ErrSomeStmtsFailed) {
@@ -182,14 +225,56 @@ func (h *ProjectionHandler) subscribe(ctx context.Context) {
 	for firstEvent := range h.EventQueue {
 		events := checkAdditionalEvents(h.EventQueue, firstEvent)
 
-		index, err := h.Process(ctx, events...)
-		if err != nil || index < len(events)-1 {
-			logging.WithFields("projection", h.ProjectionName).WithError(err).Warn("unable to process all events from subscription")
+		for _, shardEvents := range h.eventsByShard(ctx, events) {
+			index, err := h.Process(database.WithShard(ctx, shardEvents.shard), shardEvents.events...)
+			if err != nil || index < len(shardEvents.events)-1 {
+				logging.WithFields("projection", h.ProjectionName).WithError(err).Warn("unable to process all events from subscription")
+			}
+		}
+	}
+}
+
+// listenNodes triggers the projection for the instances on which other nodes pushed events
+// so that the projection is up to date without waiting for the next schedule
+func (h *ProjectionHandler) listenNodes(ctx context.Context) {
+	for instanceID := range h.NodeQueue {
+		for _, shardInstances := range h.instancesByShard(ctx, checkAdditionalInstances(h.NodeQueue, instanceID)) {
+			instances := shardInstances.instances
+			for i := 0; i < len(instances); i = i + h.concurrentInstances {
+				max := i + h.concurrentInstances
+				if max > len(instances) {
+					max = len(instances)
+				}
+				h.triggerLocked(database.WithShard(ctx, shardInstances.shard), instances[i:max]...)
+			}
 		}
 	}
 }
 
+func (h *ProjectionHandler) triggerLocked(ctx context.Context, instances ...string) {
+	lockCtx, cancelLock := context.WithCancel(ctx)
+	defer cancelLock()
+	errs := h.lock(lockCtx, h.requeueAfter, instances...)
+	// if the projection is locked, another node or the scheduler is already processing the events
+	if err, ok := <-errs; err != nil || !ok {
+		logging.WithFields("projection", h.ProjectionName).OnError(err).Debug("lock for notification failed")
+		return
+	}
+	go h.cancelOnErr(lockCtx, errs, cancelLock)
+	err := h.Trigger(lockCtx, instances...)
+	logging.WithFields("projection", h.ProjectionName, "instanceIDs", instances).OnError(err).Warn("trigger by notification failed")
+	cancelLock()
+	err = h.unlock(instances...)
+	logging.WithFields("projection", h.ProjectionName).OnError(err).Warn("unable to unlock")
+}
+
+// schedule projects the instances of the default database
 func (h *ProjectionHandler) schedule(ctx context.Context) {
+	h.scheduleShard(ctx, h.triggerProjection)
+}
+
+// scheduleShard projects the instances of the shard selected in the context
+func (h *ProjectionHandler) scheduleShard(ctx context.Context, triggerProjection *time.Timer) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
 		err := recover()
@@ -201,16 +286,21 @@ func (h *ProjectionHandler) schedule(ctx context.Context) {
 	// flag if projection has been successfully executed at least once since start
 	var succeededOnce bool
 	var err error
+	// the first schedule is locked per shard
+	lock := systemLock
+	if shard := database.ShardFromContext(ctx); shard != "" {
+		lock += "." + shard
+	}
 	// get every instance id except empty (system)
 	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).AllowTimeTravel().AddQuery().ExcludedInstanceID("")
-	for range h.triggerProjection.C {
+	for range triggerProjection.C {
 		if !succeededOnce {
 			// (re)check if it has succeeded in the meantime
 			succeededOnce, err = h.hasSucceededOnce(ctx)
 			if err != nil {
 				logging.WithFields("projection", h.ProjectionName, "err", err).
 					Error("schedule could not check if projection has already succeeded once")
-				h.triggerProjection.Reset(h.requeueAfter)
+				triggerProjection.Reset(h.requeueAfter)
 				continue
 			}
 		}
@@ -220,11 +310,11 @@ func (h *ProjectionHandler) schedule(ctx context.Context) {
 		// so that only a single scheduler does a first schedule (of every instance)
 		if !succeededOnce {
 			lockCtx, cancelLock = context.WithCancel(ctx)
-			errs := h.lock(lockCtx, h.requeueAfter, "system")
+			errs := h.lock(lockCtx, h.requeueAfter, lock)
 			if err, ok := <-errs; err != nil || !ok {
 				cancelLock()
 				logging.WithFields("projection", h.ProjectionName).OnError(err).Debug("initial lock failed for first schedule")
-				h.triggerProjection.Reset(h.requeueAfter)
+				triggerProjection.Reset(h.requeueAfter)
 				continue
 			}
 			go h.cancelOnErr(lockCtx, errs, cancelLock)
@@ -238,7 +328,7 @@ func (h *ProjectionHandler) schedule(ctx context.Context) {
 		ids, err := h.Eventstore.InstanceIDs(ctx, query.Builder())
 		if err != nil {
 			logging.WithFields("projection", h.ProjectionName).WithError(err).Error("instance ids")
-			h.triggerProjection.Reset(h.requeueAfter)
+			triggerProjection.Reset(h.requeueAfter)
 			continue
 		}
 		var failed bool
@@ -275,12 +365,12 @@ func (h *ProjectionHandler) schedule(ctx context.Context) {
 				logging.WithFields("projection", h.ProjectionName).OnError(err).Warn("unable to push first schedule succeeded")
 			}
 			cancelLock()
-			unlockErr := h.unlock("system")
+			unlockErr := h.unlock(lock)
 			logging.WithFields("projection", h.ProjectionName).OnError(unlockErr).Warn("unable to unlock first schedule")
 		}
 		// it succeeded at least once if it has succeeded before or if it has succeeded now - not failed ;-)
 		succeededOnce = succeededOnce || !failed
-		h.triggerProjection.Reset(h.requeueAfter)
+		triggerProjection.Reset(h.requeueAfter)
 	}
 }
 
@@ -338,6 +428,89 @@ func (h *ProjectionHandler) cancelOnErr(ctx context.Context, errs <-chan error,
 	}
 }
 
+func (h *ProjectionHandler) shardNames() []string {
+	if h.shards == nil {
+		return []string{""}
+	}
+	return h.shards.ShardNames()
+}
+
+func (h *ProjectionHandler) shardOf(ctx context.Context, instanceID string) (string, error) {
+	if h.shards == nil {
+		return "", nil
+	}
+	return h.shards.ShardOf(ctx, instanceID)
+}
+
+type shardEvents struct {
+	shard  string
+	events []eventstore.Event
+}
+
+// eventsByShard groups the events by the shards of their instances
+// the events of instances which cannot be routed are skipped, the scheduler will project them
+func (h *ProjectionHandler) eventsByShard(ctx context.Context, events []eventstore.Event) []*shardEvents {
+	grouped := make([]*shardEvents, 0, 1)
+	shards := make(map[string]string)
+	for _, event := range events {
+		instanceID := event.Aggregate().InstanceID
+		shard, ok := shards[instanceID]
+		if !ok {
+			var err error
+			shard, err = h.shardOf(ctx, instanceID)
+			if err != nil {
+				logging.WithFields("projection", h.ProjectionName, "instance", instanceID).WithError(err).Warn("unable to route events of subscription")
+				continue
+			}
+			shards[instanceID] = shard
+		}
+		var group *shardEvents
+		for _, g := range grouped {
+			if g.shard == shard {
+				group = g
+				break
+			}
+		}
+		if group == nil {
+			group = &shardEvents{shard: shard}
+			grouped = append(grouped, group)
+		}
+		group.events = append(group.events, event)
+	}
+	return grouped
+}
+
+type shardInstances struct {
+	shard     string
+	instances []string
+}
+
+// instancesByShard groups the instances by their shards
+// instances which cannot be routed are skipped, the scheduler will project them
+func (h *ProjectionHandler) instancesByShard(ctx context.Context, instances []string) []*shardInstances {
+	grouped := make([]*shardInstances, 0, 1)
+	for _, instanceID := range instances {
+		shard, err := h.shardOf(ctx, instanceID)
+		if err != nil {
+			logging.WithFields("projection", h.ProjectionName, "instance", instanceID).WithError(err).Warn("unable to route notification")
+			continue
+		}
+		var group *shardInstances
+		for _, g := range grouped {
+			if g.shard == shard {
+				group = g
+				break
+			}
+		}
+		if group == nil {
+			group = &shardInstances{shard: shard}
+			grouped = append(grouped, group)
+		}
+		group.instances = append(group.instances, instanceID)
+	}
+	return grouped
+}
+
 func checkAdditionalEvents(eventQueue chan eventstore.Event, event eventstore.Event) []eventstore.Event {
 	events := make([]eventstore.Event, 1)
 	events[0] = event
@@ -350,3 +523,24 @@ func checkAdditionalEvents(eventQueue chan eventstore.Event, event eventstore.Ev
 		}
 	}
 }
+
+func checkAdditionalInstances(instanceQueue chan string, instanceID string) []string {
+	instances := []string{instanceID}
+	for {
+		select {
+		case instanceID := <-instanceQueue:
+			instances = appendInstance(instances, instanceID)
+		default:
+			return instances
+		}
+	}
+}
+
+func appendInstance(instances []string, instanceID string) []string {
+	for _, instance := range instances {
+		if instance == instanceID {
+			return instances
+		}
+	}
+	return append(instances, instanceID)
+}
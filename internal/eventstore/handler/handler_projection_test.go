@@ -241,8 +241,9 @@ func TestProjectionHandler_Process(t *testing.T) {
 		events []eventstore.Event
 	}
 	type want struct {
-		isErr func(err error) bool
-		index int
+		isErr     func(err error) bool
+		index     int
+		processed int
 	}
 	tests := []struct {
 		name   string
@@ -258,7 +259,8 @@ func TestProjectionHandler_Process(t *testing.T) {
 				isErr: func(err error) bool {
 					return err == nil
 				},
-				index: 0,
+				index:     0,
+				processed: 0,
 			},
 		},
 		{
@@ -273,7 +275,8 @@ func TestProjectionHandler_Process(t *testing.T) {
 				isErr: func(err error) bool {
 					return errors.Is(err, ErrReduce)
 				},
-				index: -1,
+				index:     -1,
+				processed: 0,
 			},
 		},
 		{
@@ -289,7 +292,8 @@ func TestProjectionHandler_Process(t *testing.T) {
 				isErr: func(err error) bool {
 					return errors.Is(err, ErrSomeStmtsFailed)
 				},
-				index: -1,
+				index:     -1,
+				processed: 1,
 			},
 		},
 		{
@@ -305,7 +309,8 @@ func TestProjectionHandler_Process(t *testing.T) {
 				isErr: func(err error) bool {
 					return err.Error() == "some error"
 				},
-				index: -1,
+				index:     -1,
+				processed: 1,
 			},
 		},
 		{
@@ -321,7 +326,8 @@ func TestProjectionHandler_Process(t *testing.T) {
 				isErr: func(err error) bool {
 					return err == nil
 				},
-				index: 0,
+				index:     0,
+				processed: 1,
 			},
 		},
 	}
@@ -344,11 +350,17 @@ func TestProjectionHandler_Process(t *testing.T) {
 				nil,
 			)
 
+			var processed int
+			h.OnProcessed(func(_ context.Context, events []eventstore.Event) {
+				processed += len(events)
+			})
+
 			index, err := h.Process(tt.args.ctx, tt.args.events...)
 			if !tt.want.isErr(err) {
 				t.Errorf("unexpected error %v", err)
 			}
 			assert.Equal(t, tt.want.index, index)
+			assert.Equal(t, tt.want.processed, processed)
 		})
 	}
 }
@@ -1182,3 +1194,64 @@ func (m *cancelMocker) check(t *testing.T) {
 		}
 	}
 }
+
+// mockShards routes the instances to the shards,
+// instances which are not contained are being moved
+type mockShards map[string]string
+
+func (m mockShards) ShardNames() []string {
+	return []string{"", "eu2"}
+}
+
+func (m mockShards) ShardOf(_ context.Context, instanceID string) (string, error) {
+	shard, ok := m[instanceID]
+	if !ok {
+		return "", ErrQuery
+	}
+	return shard, nil
+}
+
+func newShardTestEvent(instanceID string) *testEvent {
+	return &testEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			authz.WithInstanceID(context.Background(), instanceID),
+			eventstore.NewAggregate(authz.WithInstanceID(context.Background(), instanceID), "id", "test.aggregate", "v1"),
+			"test.event",
+		),
+	}
+}
+
+func TestProjection_eventsByShard(t *testing.T) {
+	defaultEvent1 := newShardTestEvent("instance1")
+	shardEvent := newShardTestEvent("instance2")
+	defaultEvent2 := newShardTestEvent("instance1")
+	movingEvent := newShardTestEvent("instance3")
+
+	h := &ProjectionHandler{
+		ProjectionName: "test",
+		shards:         mockShards{"instance1": "", "instance2": "eu2"},
+	}
+	got := h.eventsByShard(context.Background(), []eventstore.Event{defaultEvent1, shardEvent, movingEvent, defaultEvent2})
+	assert.Equal(t, []*shardEvents{
+		{shard: "", events: []eventstore.Event{defaultEvent1, defaultEvent2}},
+		{shard: "eu2", events: []eventstore.Event{shardEvent}},
+	}, got)
+
+	h.shards = nil
+	got = h.eventsByShard(context.Background(), []eventstore.Event{defaultEvent1, shardEvent})
+	assert.Equal(t, []*shardEvents{
+		{shard: "", events: []eventstore.Event{defaultEvent1, shardEvent}},
+	}, got)
+}
+
+func TestProjection_instancesByShard(t *testing.T) {
+	h := &ProjectionHandler{
+		ProjectionName: "test",
+		shards:         mockShards{"instance1": "", "instance2": "eu2", "instance4": "eu2"},
+	}
+	got := h.instancesByShard(context.Background(), []string{"instance1", "instance2", "instance3", "instance4"})
+	assert.Equal(t, []*shardInstances{
+		{shard: "", instances: []string{"instance1"}},
+		{shard: "eu2", instances: []string{"instance2", "instance4"}},
+	}, got)
+}
@@ -70,3 +70,14 @@ func NewCond(name string, value interface{}) Condition {
 		Value: value,
 	}
 }
+
+// NewLessThanCond matches all rows where the value of the column is less than the given value
+func NewLessThanCond(name string, value interface{}) Condition {
+	return Condition{
+		Name:  name,
+		Value: value,
+		ParameterOpt: func(placeholder string) string {
+			return name + " < " + placeholder
+		},
+	}
+}
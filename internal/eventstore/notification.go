@@ -0,0 +1,135 @@
+package eventstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+// NodeSubscription receives the ids of the instances
+// on which other nodes pushed events of the subscribed aggregate types
+type NodeSubscription struct {
+	Instances chan string
+	types     []AggregateType
+}
+
+type nodeSubscriptions struct {
+	mutex         sync.Mutex
+	listen        sync.Once
+	subscriptions map[AggregateType][]*NodeSubscription
+}
+
+// ListenNodes distributes the notifications of other nodes to the subscriptions until the context is done
+// it's a no-op if the eventstore is not configured to notify other nodes or if it already listens
+func (es *Eventstore) ListenNodes(ctx context.Context) {
+	if es == nil || es.notifier == nil {
+		return
+	}
+	// a single listener per eventstore distributes the notifications to all subscriptions
+	es.nodeSubscriptions.listen.Do(func() {
+		notifications := make(chan *repository.Notification, 100)
+		go func() {
+			es.notifier.Listen(ctx, notifications)
+			close(notifications)
+		}()
+		go es.nodeSubscriptions.distribute(notifications)
+	})
+}
+
+// SubscribeNodes subscribes for notifications of other nodes about events pushed on the given aggregates
+// the subscription is nil if the eventstore is not configured to notify other nodes,
+// the subscription only receives notifications while the eventstore listens (see [Eventstore.ListenNodes])
+func (es *Eventstore) SubscribeNodes(instanceQueue chan string, aggregates ...AggregateType) *NodeSubscription {
+	if es == nil || es.notifier == nil {
+		return nil
+	}
+	sub := &NodeSubscription{
+		Instances: instanceQueue,
+		types:     aggregates,
+	}
+
+	es.nodeSubscriptions.mutex.Lock()
+	for _, aggregate := range aggregates {
+		es.nodeSubscriptions.subscriptions[aggregate] = append(es.nodeSubscriptions.subscriptions[aggregate], sub)
+	}
+	es.nodeSubscriptions.mutex.Unlock()
+	return sub
+}
+
+// UnsubscribeNodes removes the subscription, it's safe to call it with a nil subscription
+func (es *Eventstore) UnsubscribeNodes(sub *NodeSubscription) {
+	if es == nil || sub == nil {
+		return
+	}
+	es.nodeSubscriptions.mutex.Lock()
+	defer es.nodeSubscriptions.mutex.Unlock()
+	for _, aggregate := range sub.types {
+		subs := es.nodeSubscriptions.subscriptions[aggregate]
+		for i := len(subs) - 1; i >= 0; i-- {
+			if subs[i] == sub {
+				subs = append(subs[:i], subs[i+1:]...)
+			}
+		}
+		es.nodeSubscriptions.subscriptions[aggregate] = subs
+	}
+}
+
+func (subs *nodeSubscriptions) distribute(notifications <-chan *repository.Notification) {
+	for notification := range notifications {
+		subs.mutex.Lock()
+		notified := make(map[*NodeSubscription]bool)
+		for _, aggregateType := range notification.AggregateTypes {
+			for _, sub := range subs.subscriptions[AggregateType(aggregateType)] {
+				if notified[sub] {
+					continue
+				}
+				notified[sub] = true
+				// the notification only triggers the handler earlier,
+				// if the queue is full the handler is already busy and will catch up anyway
+				select {
+				case sub.Instances <- notification.InstanceID:
+				default:
+				}
+			}
+		}
+		subs.mutex.Unlock()
+	}
+}
+
+// notifyNodes informs the other nodes about the pushed events
+func (es *Eventstore) notifyNodes(events []*repository.Event) {
+	if es.notifier == nil || len(events) == 0 {
+		return
+	}
+	notifications := make([]*repository.Notification, 0, 1)
+	for _, event := range events {
+		var notification *repository.Notification
+		for _, n := range notifications {
+			if n.InstanceID == event.InstanceID {
+				notification = n
+				break
+			}
+		}
+		if notification == nil {
+			notification = &repository.Notification{InstanceID: event.InstanceID}
+			notifications = append(notifications, notification)
+		}
+		if !containsAggregateType(notification.AggregateTypes, event.AggregateType) {
+			notification.AggregateTypes = append(notification.AggregateTypes, event.AggregateType)
+		}
+	}
+	err := es.notifier.Notify(context.Background(), notifications)
+	logging.OnError(err).Warn("unable to notify other nodes")
+}
+
+func containsAggregateType(types []repository.AggregateType, aggregateType repository.AggregateType) bool {
+	for _, typ := range types {
+		if typ == aggregateType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package eventstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+type testNotifier struct {
+	notified      []*repository.Notification
+	notifications chan *repository.Notification
+}
+
+func (n *testNotifier) Notify(_ context.Context, notifications []*repository.Notification) error {
+	n.notified = append(n.notified, notifications...)
+	return nil
+}
+
+func (n *testNotifier) Listen(ctx context.Context, notifications chan<- *repository.Notification) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-n.notifications:
+			notifications <- notification
+		}
+	}
+}
+
+func TestEventstore_notifyNodes(t *testing.T) {
+	notifier := new(testNotifier)
+	es := &Eventstore{notifier: notifier}
+	es.notifyNodes([]*repository.Event{
+		{InstanceID: "instance1", AggregateType: "user"},
+		{InstanceID: "instance1", AggregateType: "org"},
+		{InstanceID: "instance1", AggregateType: "user"},
+		{InstanceID: "instance2", AggregateType: "user"},
+	})
+	assert.Equal(t, []*repository.Notification{
+		{
+			InstanceID:     "instance1",
+			AggregateTypes: []repository.AggregateType{"user", "org"},
+		},
+		{
+			InstanceID:     "instance2",
+			AggregateTypes: []repository.AggregateType{"user"},
+		},
+	}, notifier.notified)
+}
+
+func TestEventstore_SubscribeNodes(t *testing.T) {
+	t.Run("no notifier", func(t *testing.T) {
+		es := &Eventstore{}
+		es.ListenNodes(context.Background())
+		assert.Nil(t, es.SubscribeNodes(make(chan string), "user"))
+	})
+	t.Run("distribute", func(t *testing.T) {
+		notifier := &testNotifier{notifications: make(chan *repository.Notification)}
+		es := &Eventstore{
+			notifier:          notifier,
+			nodeSubscriptions: &nodeSubscriptions{subscriptions: map[AggregateType][]*NodeSubscription{}},
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		es.ListenNodes(ctx)
+		userQueue := make(chan string, 1)
+		userSub := es.SubscribeNodes(userQueue, "user", "org")
+		projectQueue := make(chan string, 1)
+		es.SubscribeNodes(projectQueue, "project")
+
+		notifier.notifications <- &repository.Notification{
+			InstanceID:     "instance",
+			AggregateTypes: []repository.AggregateType{"user", "org"},
+		}
+		select {
+		case instanceID := <-userQueue:
+			assert.Equal(t, "instance", instanceID)
+		case <-time.After(time.Second):
+			t.Fatal("no notification received")
+		}
+		// the subscription is only notified once per notification
+		select {
+		case instanceID := <-userQueue:
+			t.Errorf("unexpected notification for %s", instanceID)
+		case <-time.After(10 * time.Millisecond):
+		}
+		assert.Empty(t, projectQueue)
+
+		es.UnsubscribeNodes(userSub)
+		notifier.notifications <- &repository.Notification{
+			InstanceID:     "instance",
+			AggregateTypes: []repository.AggregateType{"user"},
+		}
+		select {
+		case instanceID := <-userQueue:
+			t.Errorf("unexpected notification for %s", instanceID)
+		case <-time.After(10 * time.Millisecond):
+		}
+	})
+}
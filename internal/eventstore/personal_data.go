@@ -0,0 +1,50 @@
+package eventstore
+
+import (
+	"context"
+)
+
+const (
+	personalDataAggregateType AggregateType = "personal_data"
+	// PersonalDataDestroyedType is pushed after the data key of an aggregate was destroyed
+	PersonalDataDestroyedType EventType = "personal_data.destroyed"
+
+	personalDataEvictionQueueSize = 100
+)
+
+type personalDataDestroyedEvent struct {
+	BaseEvent
+}
+
+func newPersonalDataDestroyedEvent(ctx context.Context, aggregateID string) *personalDataDestroyedEvent {
+	return &personalDataDestroyedEvent{
+		BaseEvent: *NewBaseEventForPush(
+			ctx,
+			NewAggregate(ctx, aggregateID, personalDataAggregateType, "v1"),
+			PersonalDataDestroyedType,
+		),
+	}
+}
+
+func (e *personalDataDestroyedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *personalDataDestroyedEvent) UniqueConstraints() []*EventUniqueConstraint {
+	return nil
+}
+
+// subscribePersonalDataEviction evicts the cached data keys of an instance
+// as soon as another node destroyed a data key of the instance
+// if the eventstore does not notify other nodes, the keys expire after their lifetime
+func (es *Eventstore) subscribePersonalDataEviction() {
+	sub := es.SubscribeNodes(make(chan string, personalDataEvictionQueueSize), personalDataAggregateType)
+	if sub == nil {
+		return
+	}
+	go func() {
+		for instanceID := range sub.Instances {
+			es.personalData.EvictKeys(instanceID)
+		}
+	}()
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"fmt"
+)
+
+// SequenceConflictError is the cause of the error returned by [Repository.Push]
+// if the latest event of an aggregate does not have the expected sequence
+type SequenceConflictError struct {
+	InstanceID       string
+	AggregateType    AggregateType
+	AggregateID      string
+	ExpectedSequence uint64
+}
+
+func (err *SequenceConflictError) Error() string {
+	return fmt.Sprintf("aggregate %s %s of instance %s was changed after sequence %d", err.AggregateType, err.AggregateID, err.InstanceID, err.ExpectedSequence)
+}
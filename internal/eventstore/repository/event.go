@@ -59,6 +59,13 @@ type Event struct {
 	//InstanceID is the instance where this event belongs to
 	// use the ID of the instance
 	InstanceID string
+
+	//ExpectedAggregateSequence is the sequence the latest event of the aggregate must have
+	// before the event is stored, it's only checked if set
+	ExpectedAggregateSequence *uint64
+	//ExpectedEventTypes restricts the check of ExpectedAggregateSequence to the events of these types,
+	// so the aggregate must not have an event of these types after the expected sequence
+	ExpectedEventTypes []EventType
 }
 
 //EventType is the description of the change
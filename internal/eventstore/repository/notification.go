@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+)
+
+// Notification informs the nodes that events of the aggregate types were pushed on the instance
+type Notification struct {
+	InstanceID     string          `json:"instanceID"`
+	AggregateTypes []AggregateType `json:"aggregateTypes"`
+}
+
+// Notifier distributes notifications about pushed events to all nodes of the cluster
+type Notifier interface {
+	// Notify sends the notifications to the other nodes
+	Notify(ctx context.Context, notifications []*Notification) error
+	// Listen writes the notifications of the other nodes to the channel until the context is done
+	Listen(ctx context.Context, notifications chan<- *Notification)
+}
@@ -11,6 +11,7 @@ type Repository interface {
 	// Push adds all events of the given aggregates to the event streams of the aggregates.
 	// if unique constraints are pushed, they will be added to the unique table for checking unique constraint violations
 	// This call is transaction save. The transaction will be rolled back if one event fails
+	// If the expected sequence of an aggregate does not match, the cause of the returned error is a [SequenceConflictError]
 	Push(ctx context.Context, events []*Event, uniqueConstraints ...*UniqueConstraint) error
 	// Filter returns all events matching the given search query
 	Filter(ctx context.Context, searchQuery *SearchQuery) (events []*Event, err error)
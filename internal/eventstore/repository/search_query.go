@@ -14,6 +14,8 @@ type SearchQuery struct {
 	Filters         [][]*Filter
 	Tx              *sql.Tx
 	AllowTimeTravel bool
+	// IncludeArchive also filters the events moved into the archive
+	IncludeArchive bool
 }
 
 // Columns defines which fields of the event are needed for the query
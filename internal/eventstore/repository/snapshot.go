@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Snapshot is the serialized state of a write model up to the sequence
+type Snapshot struct {
+	InstanceID    string
+	AggregateID   string
+	ResourceOwner string
+	// Type identifies the write model the state belongs to
+	Type string
+	// Version changes as soon as the state of the write model
+	// cannot be restored from the snapshot anymore
+	Version    string
+	Sequence   uint64
+	ChangeDate time.Time
+	State      []byte
+}
+
+// SnapshotStore stores the snapshots of write models
+type SnapshotStore interface {
+	// Snapshot returns the latest snapshot of the write model
+	// nil is returned if no snapshot was found
+	Snapshot(ctx context.Context, instanceID, aggregateID, snapshotType string) (*Snapshot, error)
+	// StoreSnapshot replaces the stored snapshot of the write model
+	StoreSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
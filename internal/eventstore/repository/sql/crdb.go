@@ -111,13 +111,31 @@ func (db *CRDB) Health(ctx context.Context) error { return db.Ping() }
 // Push adds all events to the eventstreams of the aggregates.
 // This call is transaction save. The transaction will be rolled back if one event fails
 func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueConstraints ...*repository.UniqueConstraint) error {
-	err := crdb.ExecuteTx(ctx, db.DB.DB, nil, func(tx *sql.Tx) error {
+	if err := db.checkRouting(ctx, events); err != nil {
+		return err
+	}
+	shard := db.Shard(ctx)
+	// global unique constraints are stored in the default database,
+	// so they are unique across the instances of all shards
+	var globalConstraints []*repository.UniqueConstraint
+	if shard != db.DB {
+		globalConstraints, uniqueConstraints = splitGlobalUniqueConstraints(uniqueConstraints)
+		if err := db.pushGlobalUniqueConstraints(ctx, globalConstraints...); err != nil {
+			return err
+		}
+	}
+	err := crdb.ExecuteTx(ctx, shard.DB, nil, func(tx *sql.Tx) error {
 
 		var (
 			previousAggregateSequence     Sequence
 			previousAggregateTypeSequence Sequence
 		)
 		for _, event := range events {
+			if event.ExpectedAggregateSequence != nil && len(event.ExpectedEventTypes) > 0 {
+				if err := db.checkExpectedEventTypes(ctx, tx, event); err != nil {
+					return err
+				}
+			}
 			err := tx.QueryRowContext(ctx, crdbInsert,
 				event.Type,
 				event.AggregateType,
@@ -133,6 +151,11 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 			event.PreviousAggregateSequence = uint64(previousAggregateSequence)
 			event.PreviousAggregateTypeSequence = uint64(previousAggregateTypeSequence)
 
+			// concurrent pushes on the same aggregate violate the unique previous sequence
+			// if the transactions are not serializable
+			if err != nil && event.ExpectedAggregateSequence != nil && db.isUniqueViolationError(err) {
+				return sequenceConflict(event)
+			}
 			if err != nil {
 				logging.WithFields(
 					"aggregate", event.AggregateType,
@@ -143,6 +166,9 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 				).WithError(err).Debug("query failed")
 				return caos_errs.ThrowInternal(err, "SQL-SBP37", "unable to create event")
 			}
+			if event.ExpectedAggregateSequence != nil && len(event.ExpectedEventTypes) == 0 && *event.ExpectedAggregateSequence != event.PreviousAggregateSequence {
+				return sequenceConflict(event)
+			}
 		}
 
 		err := db.handleUniqueConstraints(ctx, tx, uniqueConstraints...)
@@ -151,6 +177,10 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 		}
 		return nil
 	})
+	if err != nil && len(globalConstraints) > 0 {
+		revertErr := db.pushGlobalUniqueConstraints(ctx, revertUniqueConstraints(globalConstraints)...)
+		logging.OnError(revertErr).Warn("unable to revert global unique constraints")
+	}
 	if err != nil && !errors.Is(err, &caos_errs.CaosError{}) {
 		err = caos_errs.ThrowInternal(err, "SQL-DjgtG", "unable to store events")
 	}
@@ -158,6 +188,95 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 	return err
 }
 
+// checkRouting rejects the events of instances which are not routed to the shard selected in the context,
+// e.g. because they are moved to another shard
+func (db *CRDB) checkRouting(ctx context.Context, events []*repository.Event) error {
+	checked := make(map[string]bool, 1)
+	for _, event := range events {
+		if event.InstanceID == "" || checked[event.InstanceID] {
+			continue
+		}
+		if err := db.CheckRouting(ctx, event.InstanceID); err != nil {
+			return err
+		}
+		checked[event.InstanceID] = true
+	}
+	return nil
+}
+
+func (db *CRDB) pushGlobalUniqueConstraints(ctx context.Context, uniqueConstraints ...*repository.UniqueConstraint) error {
+	if len(uniqueConstraints) == 0 {
+		return nil
+	}
+	return crdb.ExecuteTx(ctx, db.DB.DB, nil, func(tx *sql.Tx) error {
+		return db.handleUniqueConstraints(ctx, tx, uniqueConstraints...)
+	})
+}
+
+// splitGlobalUniqueConstraints separates the global unique constraints from the constraints of the instance
+func splitGlobalUniqueConstraints(uniqueConstraints []*repository.UniqueConstraint) (global, instance []*repository.UniqueConstraint) {
+	for _, uniqueConstraint := range uniqueConstraints {
+		if uniqueConstraint != nil && uniqueConstraint.InstanceID == "" && uniqueConstraint.Action != repository.UniqueConstraintInstanceRemoved {
+			global = append(global, uniqueConstraint)
+			continue
+		}
+		instance = append(instance, uniqueConstraint)
+	}
+	return global, instance
+}
+
+// revertUniqueConstraints returns the constraints undoing the given constraints in reverse order
+func revertUniqueConstraints(uniqueConstraints []*repository.UniqueConstraint) []*repository.UniqueConstraint {
+	reverted := make([]*repository.UniqueConstraint, 0, len(uniqueConstraints))
+	for i := len(uniqueConstraints) - 1; i >= 0; i-- {
+		revert := *uniqueConstraints[i]
+		switch revert.Action {
+		case repository.UniqueConstraintAdd:
+			revert.Action = repository.UniqueConstraintRemoved
+		case repository.UniqueConstraintRemoved:
+			revert.Action = repository.UniqueConstraintAdd
+		}
+		reverted = append(reverted, &revert)
+	}
+	return reverted
+}
+
+// checkExpectedEventTypes ensures that the aggregate of the event has no event
+// of the expected types after the expected sequence
+func (db *CRDB) checkExpectedEventTypes(ctx context.Context, tx *sql.Tx, event *repository.Event) error {
+	eventTypes := make(database.StringArray, len(event.ExpectedEventTypes))
+	for i, eventType := range event.ExpectedEventTypes {
+		eventTypes[i] = string(eventType)
+	}
+	var sequence Sequence
+	err := query(ctx, db, &repository.SearchQuery{
+		Columns: repository.ColumnsMaxSequence,
+		Filters: [][]*repository.Filter{{
+			repository.NewFilter(repository.FieldAggregateType, event.AggregateType, repository.OperationEquals),
+			repository.NewFilter(repository.FieldAggregateID, event.AggregateID, repository.OperationEquals),
+			repository.NewFilter(repository.FieldInstanceID, event.InstanceID, repository.OperationEquals),
+			repository.NewFilter(repository.FieldEventType, eventTypes, repository.OperationIn),
+		}},
+		Tx: tx,
+	}, &sequence)
+	if err != nil {
+		return err
+	}
+	if uint64(sequence) > *event.ExpectedAggregateSequence {
+		return sequenceConflict(event)
+	}
+	return nil
+}
+
+func sequenceConflict(event *repository.Event) error {
+	return caos_errs.ThrowPreconditionFailed(&repository.SequenceConflictError{
+		InstanceID:       event.InstanceID,
+		AggregateType:    event.AggregateType,
+		AggregateID:      event.AggregateID,
+		ExpectedSequence: *event.ExpectedAggregateSequence,
+	}, "SQL-Sq3cF", "Errors.Event.SequenceConflict")
+}
+
 var instanceRegexp = regexp.MustCompile(`eventstore\.i_[0-9a-zA-Z]{1,}_seq`)
 
 func (db *CRDB) CreateInstance(ctx context.Context, instanceID string) error {
@@ -250,8 +369,8 @@ func (db *CRDB) InstanceIDs(ctx context.Context, searchQuery *repository.SearchQ
 	return ids, nil
 }
 
-func (db *CRDB) db() *sql.DB {
-	return db.DB.DB
+func (db *CRDB) db(ctx context.Context) *sql.DB {
+	return db.Shard(ctx).DB
 }
 
 func (db *CRDB) orderByEventSequence(desc bool) string {
@@ -270,22 +389,29 @@ func (db *CRDB) orderByEventSequence(desc bool) string {
 	return " ORDER BY event_sequence"
 }
 
+const eventColumns = " creation_date" +
+	", event_type" +
+	", event_sequence" +
+	", previous_aggregate_sequence" +
+	", previous_aggregate_type_sequence" +
+	", event_data" +
+	", editor_service" +
+	", editor_user" +
+	", resource_owner" +
+	", instance_id" +
+	", aggregate_type" +
+	", aggregate_id" +
+	", aggregate_version"
+
 func (db *CRDB) eventQuery() string {
-	return "SELECT" +
-		" creation_date" +
-		", event_type" +
-		", event_sequence" +
-		", previous_aggregate_sequence" +
-		", previous_aggregate_type_sequence" +
-		", event_data" +
-		", editor_service" +
-		", editor_user" +
-		", resource_owner" +
-		", instance_id" +
-		", aggregate_type" +
-		", aggregate_id" +
-		", aggregate_version" +
-		" FROM eventstore.events"
+	return "SELECT" + eventColumns + " FROM eventstore.events"
+}
+
+// eventWithArchiveQuery also returns the events moved into eventstore.events_archive
+func (db *CRDB) eventWithArchiveQuery() string {
+	return "SELECT" + eventColumns +
+		" FROM (SELECT" + eventColumns + " FROM eventstore.events" +
+		" UNION ALL SELECT" + eventColumns + " FROM eventstore.events_archive) AS events"
 }
 
 func (db *CRDB) maxSequenceQuery() string {
@@ -0,0 +1,327 @@
+package sql
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	notificationChannel = "zitadel_events"
+
+	notifyStmt = "SELECT pg_notify($1, $2)"
+	// the statement is only used by the polling, the events of other nodes are found
+	// by the creation time of the row, which is set with the clock of the database.
+	// The creation time is set on insert, so a transaction committed late adds rows older than the last poll,
+	// therefore the polling re-reads an overlap window and skips the events it already notified about
+	pollStmt = "SELECT instance_id, aggregate_type, event_sequence, created_at" +
+		" FROM eventstore.events" +
+		" WHERE created_at > $1"
+	pollStartStmt = "SELECT clock_timestamp()"
+	// defaultPollOverlap is used if pushes have no timeout
+	defaultPollOverlap = time.Minute
+
+	minListenBackoff = time.Second
+	maxListenBackoff = time.Minute
+)
+
+// Notifier sends notifications about pushed events to the other nodes.
+// On Postgres the notifications are distributed using LISTEN / NOTIFY.
+// CockroachDB does not support LISTEN / NOTIFY, therefore the events table is polled,
+// which is also the fallback while the node is not able to listen on Postgres.
+// The notifications of all shards are sent on the default database, the events tables of all shards are polled.
+type Notifier struct {
+	client       *database.DB
+	nodeID       string
+	pollInterval time.Duration
+	// pollOverlap is the longest time between the insert and the commit of an event
+	pollOverlap time.Duration
+
+	// listen and poll are replaced in tests
+	// listen calls listening as soon as the node listens on the channel
+	listen     func(ctx context.Context, notifications chan<- *repository.Notification, listening func()) error
+	poll       func(ctx context.Context, notifications chan<- *repository.Notification)
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+type notificationPayload struct {
+	repository.Notification
+	NodeID string `json:"nodeID"`
+}
+
+// NewNotifier creates the notifier, the pushTimeout limits the time the polling looks back for events committed late
+func NewNotifier(client *database.DB, pollInterval, pushTimeout time.Duration) *Notifier {
+	nodeID := make([]byte, 16)
+	_, err := rand.Read(nodeID)
+	logging.OnError(err).Warn("unable to generate node id for notifications")
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	pollOverlap := pushTimeout
+	if pollOverlap <= 0 {
+		pollOverlap = defaultPollOverlap
+	}
+	n := &Notifier{
+		client:       client,
+		nodeID:       hex.EncodeToString(nodeID),
+		pollInterval: pollInterval,
+		pollOverlap:  pollOverlap,
+		minBackoff:   minListenBackoff,
+		maxBackoff:   maxListenBackoff,
+	}
+	n.listen = n.listenChannel
+	n.poll = n.pollShards
+	return n
+}
+
+// Notify implements [repository.Notifier]
+// as the other nodes poll on CockroachDB, only Postgres has to be notified
+func (n *Notifier) Notify(ctx context.Context, notifications []*repository.Notification) error {
+	if !n.canListen() {
+		return nil
+	}
+	for _, notification := range notifications {
+		payload, err := json.Marshal(&notificationPayload{Notification: *notification, NodeID: n.nodeID})
+		if err != nil {
+			return err
+		}
+		if _, err = n.client.DB.ExecContext(ctx, notifyStmt, notificationChannel, string(payload)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Listen implements [repository.Notifier]
+// If the connection listening on Postgres fails, the node reconnects with an exponential backoff
+// and polls the events tables until it listens again.
+func (n *Notifier) Listen(ctx context.Context, notifications chan<- *repository.Notification) {
+	if !n.canListen() {
+		n.poll(ctx, notifications)
+		return
+	}
+	// stopPolling is set while the node polls
+	var stopPolling func()
+	defer func() {
+		if stopPolling != nil {
+			stopPolling()
+		}
+	}()
+	backoff := n.minBackoff
+	for {
+		err := n.listen(ctx, notifications, func() {
+			backoff = n.minBackoff
+			if stopPolling == nil {
+				return
+			}
+			logging.Info("listening for notifications again, polling stopped")
+			stopPolling()
+			stopPolling = nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logging.WithError(err).WithField("backoff", backoff).Warn("unable to listen for notifications")
+		if stopPolling == nil {
+			stopPolling = n.startPolling(ctx, notifications)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > n.maxBackoff {
+			backoff = n.maxBackoff
+		}
+	}
+}
+
+// startPolling polls in the background until the context is done or the returned function is called,
+// which returns after the polling stopped
+func (n *Notifier) startPolling(ctx context.Context, notifications chan<- *repository.Notification) (stop func()) {
+	stopped := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pollCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-stopped:
+				cancel()
+			case <-pollCtx.Done():
+			}
+		}()
+		n.poll(pollCtx, notifications)
+	}()
+	return func() {
+		close(stopped)
+		<-done
+	}
+}
+
+func (n *Notifier) canListen() bool {
+	return n.client.Type() == "postgres"
+}
+
+// listenChannel listens for notifications until the connection fails or the context is done
+func (n *Notifier) listenChannel(ctx context.Context, notifications chan<- *repository.Notification, listening func()) (err error) {
+	conn, err := n.client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var listenErr error
+	err = conn.Raw(func(driverConn interface{}) error {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+		if _, listenErr = pgxConn.Exec(ctx, "LISTEN "+notificationChannel); listenErr != nil {
+			return driver.ErrBadConn
+		}
+		listening()
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				listenErr = err
+				// the connection is still listening on the channel
+				// so it must not be returned to the pool
+				return driver.ErrBadConn
+			}
+			payload := new(notificationPayload)
+			if err = json.Unmarshal([]byte(notification.Payload), payload); err != nil {
+				logging.WithError(err).Warn("unable to unmarshal notification")
+				continue
+			}
+			if payload.NodeID == n.nodeID {
+				continue
+			}
+			notifications <- &payload.Notification
+		}
+	})
+	if listenErr != nil {
+		return listenErr
+	}
+	return err
+}
+
+// pollShards polls the events of all shards until the context is done
+func (n *Notifier) pollShards(ctx context.Context, notifications chan<- *repository.Notification) {
+	var wg sync.WaitGroup
+	for _, shard := range n.client.ShardNames() {
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			n.pollShard(ctx, notifications)
+		}(database.WithShard(ctx, shard))
+	}
+	wg.Wait()
+}
+
+// pollShard polls the events of the shard selected in the context
+func (n *Notifier) pollShard(ctx context.Context, notifications chan<- *repository.Notification) {
+	var since time.Time
+	for since.IsZero() {
+		err := n.client.QueryRowContext(ctx, pollStartStmt).Scan(&since)
+		if ctx.Err() != nil {
+			return
+		}
+		logging.OnError(err).Warn("unable to start polling for notifications")
+		if err != nil {
+			time.Sleep(n.pollInterval)
+		}
+	}
+
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+	notified := make(notifiedEvents)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var err error
+			since, err = n.pollNotifications(ctx, since, notified, notifications)
+			logging.OnError(err).Warn("unable to poll for notifications")
+		}
+	}
+}
+
+// notifiedEvents are the events found during the overlap window, by instance and sequence
+type notifiedEvents map[notifiedEvent]time.Time
+
+type notifiedEvent struct {
+	instanceID string
+	sequence   uint64
+}
+
+// pollNotifications notifies about the events created after since or during the overlap window before,
+// which were not notified yet. It returns the creation time of the latest event.
+func (n *Notifier) pollNotifications(ctx context.Context, since time.Time, notified notifiedEvents, notifications chan<- *repository.Notification) (time.Time, error) {
+	rows, err := n.client.QueryContext(ctx, pollStmt, since.Add(-n.pollOverlap))
+	if err != nil {
+		return since, err
+	}
+	defer rows.Close()
+
+	latest := since
+	instances := make(map[string]*repository.Notification)
+	for rows.Next() {
+		var (
+			event         notifiedEvent
+			aggregateType repository.AggregateType
+			createdAt     time.Time
+		)
+		if err = rows.Scan(&event.instanceID, &aggregateType, &event.sequence, &createdAt); err != nil {
+			return since, err
+		}
+		if createdAt.After(latest) {
+			latest = createdAt
+		}
+		if _, ok := notified[event]; ok {
+			continue
+		}
+		notified[event] = createdAt
+		notification, ok := instances[event.instanceID]
+		if !ok {
+			notification = &repository.Notification{InstanceID: event.instanceID}
+			instances[event.instanceID] = notification
+		}
+		if !containsAggregateType(notification.AggregateTypes, aggregateType) {
+			notification.AggregateTypes = append(notification.AggregateTypes, aggregateType)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return since, err
+	}
+	// events before the overlap window are not queried anymore
+	windowStart := latest.Add(-n.pollOverlap)
+	for event, createdAt := range notified {
+		if !createdAt.After(windowStart) {
+			delete(notified, event)
+		}
+	}
+	for _, notification := range instances {
+		notifications <- notification
+	}
+	return latest, nil
+}
+
+func containsAggregateType(types []repository.AggregateType, aggregateType repository.AggregateType) bool {
+	for _, typ := range types {
+		if typ == aggregateType {
+			return true
+		}
+	}
+	return false
+}
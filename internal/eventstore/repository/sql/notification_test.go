@@ -0,0 +1,255 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+func TestNotifier_pollNotifications(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	latest := since.Add(time.Second)
+	overlap := time.Minute
+	columns := []string{"instance_id", "aggregate_type", "event_sequence", "created_at"}
+	type args struct {
+		notified notifiedEvents
+	}
+	type res struct {
+		since         time.Time
+		notifications []*repository.Notification
+		notified      notifiedEvents
+		wantErr       bool
+	}
+	tests := []struct {
+		name   string
+		args   args
+		expect func(sqlmock.Sqlmock)
+		res    res
+	}{
+		{
+			name: "no events",
+			args: args{notified: notifiedEvents{}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(pollStmt)).
+					WithArgs(since.Add(-overlap)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			res: res{
+				since:    since,
+				notified: notifiedEvents{},
+			},
+		},
+		{
+			name: "events of multiple aggregate types",
+			args: args{notified: notifiedEvents{}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(pollStmt)).
+					WithArgs(since.Add(-overlap)).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("instance", "user", 1, since.Add(time.Millisecond)).
+						AddRow("instance", "user", 2, since.Add(time.Millisecond)).
+						AddRow("instance", "org", 3, latest),
+					)
+			},
+			res: res{
+				since: latest,
+				notifications: []*repository.Notification{
+					{
+						InstanceID:     "instance",
+						AggregateTypes: []repository.AggregateType{"user", "org"},
+					},
+				},
+				notified: notifiedEvents{
+					{instanceID: "instance", sequence: 1}: since.Add(time.Millisecond),
+					{instanceID: "instance", sequence: 2}: since.Add(time.Millisecond),
+					{instanceID: "instance", sequence: 3}: latest,
+				},
+			},
+		},
+		{
+			name: "late commit in overlap window",
+			args: args{notified: notifiedEvents{
+				{instanceID: "instance", sequence: 2}: since,
+			}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(pollStmt)).
+					WithArgs(since.Add(-overlap)).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("instance", "org", 1, since.Add(-time.Second)).
+						AddRow("instance", "user", 2, since),
+					)
+			},
+			res: res{
+				since: since,
+				notifications: []*repository.Notification{
+					{
+						InstanceID:     "instance",
+						AggregateTypes: []repository.AggregateType{"org"},
+					},
+				},
+				notified: notifiedEvents{
+					{instanceID: "instance", sequence: 1}: since.Add(-time.Second),
+					{instanceID: "instance", sequence: 2}: since,
+				},
+			},
+		},
+		{
+			name: "notified events before overlap window removed",
+			args: args{notified: notifiedEvents{
+				{instanceID: "instance", sequence: 1}: since.Add(-overlap),
+			}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(pollStmt)).
+					WithArgs(since.Add(-overlap)).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("instance", "user", 2, latest),
+					)
+			},
+			res: res{
+				since: latest,
+				notifications: []*repository.Notification{
+					{
+						InstanceID:     "instance",
+						AggregateTypes: []repository.AggregateType{"user"},
+					},
+				},
+				notified: notifiedEvents{
+					{instanceID: "instance", sequence: 2}: latest,
+				},
+			},
+		},
+		{
+			name: "query fails",
+			args: args{notified: notifiedEvents{}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(pollStmt)).
+					WithArgs(since.Add(-overlap)).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			res: res{
+				since:    since,
+				notified: notifiedEvents{},
+				wantErr:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+			n := &Notifier{
+				client: &database.DB{
+					DB:       client,
+					Database: new(testDB),
+				},
+				pollInterval: time.Second,
+				pollOverlap:  overlap,
+			}
+			notifications := make(chan *repository.Notification, 10)
+			got, err := n.pollNotifications(context.Background(), since, tt.args.notified, notifications)
+			close(notifications)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.res.since, got)
+			gotNotifications := make([]*repository.Notification, 0, len(tt.res.notifications))
+			for notification := range notifications {
+				gotNotifications = append(gotNotifications, notification)
+			}
+			assert.ElementsMatch(t, tt.res.notifications, gotNotifications)
+			assert.Equal(t, tt.res.notified, tt.args.notified)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotifier_Notify_withoutListen(t *testing.T) {
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	n := &Notifier{
+		client: &database.DB{
+			DB:       client,
+			Database: new(testDB),
+		},
+	}
+	err = n.Notify(context.Background(), []*repository.Notification{{InstanceID: "instance"}})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+type postgresTestDB struct {
+	*testDB
+}
+
+func (*postgresTestDB) Type() string { return "postgres" }
+
+func TestNotifier_Listen_connectionLost(t *testing.T) {
+	var (
+		listens     int
+		connLost    = make(chan struct{})
+		pollStarted = make(chan struct{})
+		pollStopped = make(chan struct{})
+	)
+	n := &Notifier{
+		client: &database.DB{
+			Database: &postgresTestDB{new(testDB)},
+		},
+		minBackoff: time.Millisecond,
+		maxBackoff: time.Millisecond,
+		listen: func(ctx context.Context, _ chan<- *repository.Notification, listening func()) error {
+			listens++
+			switch listens {
+			case 1:
+				return errors.New("connection refused")
+			case 2:
+				listening()
+				<-connLost
+				return errors.New("connection lost")
+			default:
+				listening()
+				<-ctx.Done()
+				return ctx.Err()
+			}
+		},
+		poll: func(ctx context.Context, _ chan<- *repository.Notification) {
+			pollStarted <- struct{}{}
+			<-ctx.Done()
+			pollStopped <- struct{}{}
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		n.Listen(ctx, make(chan *repository.Notification))
+		close(done)
+	}()
+
+	await := func(c <-chan struct{}, msg string) {
+		t.Helper()
+		select {
+		case <-c:
+		case <-time.After(5 * time.Second):
+			t.Fatal(msg)
+		}
+	}
+	await(pollStarted, "polling not started while unable to listen")
+	await(pollStopped, "polling not stopped after listening")
+	close(connLost)
+	await(pollStarted, "polling not started after the connection was lost")
+	await(pollStopped, "polling not stopped after listening again")
+	cancel()
+	await(done, "listen not stopped")
+	assert.Equal(t, 3, listens)
+}
@@ -22,9 +22,10 @@ type querier interface {
 	conditionFormat(repository.Operation) string
 	placeholder(query string) string
 	eventQuery() string
+	eventWithArchiveQuery() string
 	maxSequenceQuery() string
 	instanceIDsQuery() string
-	db() *sql.DB
+	db(ctx context.Context) *sql.DB
 	orderByEventSequence(desc bool) string
 	dialect.Database
 }
@@ -33,11 +34,15 @@ type scan func(dest ...interface{}) error
 
 func query(ctx context.Context, criteria querier, searchQuery *repository.SearchQuery, dest interface{}) error {
 	query, rowScanner := prepareColumns(criteria, searchQuery.Columns)
+	if searchQuery.IncludeArchive && searchQuery.Columns == repository.ColumnsEvent {
+		query = criteria.eventWithArchiveQuery()
+	}
 	where, values := prepareCondition(criteria, searchQuery.Filters)
 	if where == "" || query == "" {
 		return z_errors.ThrowInvalidArgument(nil, "SQL-rWeBw", "invalid query factory")
 	}
-	if searchQuery.Tx == nil {
+	// the archive is not read with time travel, because its events are moved from the events table
+	if searchQuery.Tx == nil && !searchQuery.IncludeArchive {
 		if travel := prepareTimeTravel(ctx, criteria, searchQuery.AllowTimeTravel); travel != "" {
 			query += travel
 		}
@@ -58,7 +63,7 @@ func query(ctx context.Context, criteria querier, searchQuery *repository.Search
 	var contextQuerier interface {
 		QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
 	}
-	contextQuerier = criteria.db()
+	contextQuerier = criteria.db(ctx)
 	if searchQuery.Tx != nil {
 		contextQuerier = searchQuery.Tx
 	}
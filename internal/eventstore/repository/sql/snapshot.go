@@ -0,0 +1,75 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/database"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	snapshotStmt = "SELECT resource_owner, version, sequence, change_date, state" +
+		" FROM eventstore.snapshots" +
+		" WHERE instance_id = $1 AND aggregate_id = $2 AND snapshot_type = $3"
+	storeSnapshotStmt = "INSERT INTO eventstore.snapshots" +
+		" (instance_id, aggregate_id, snapshot_type, resource_owner, version, sequence, change_date, state, created_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now())" +
+		" ON CONFLICT (instance_id, aggregate_id, snapshot_type) DO UPDATE SET" +
+		" (resource_owner, version, sequence, change_date, state, created_at) = (EXCLUDED.resource_owner, EXCLUDED.version, EXCLUDED.sequence, EXCLUDED.change_date, EXCLUDED.state, EXCLUDED.created_at)" +
+		" WHERE eventstore.snapshots.sequence < EXCLUDED.sequence OR eventstore.snapshots.version <> EXCLUDED.version"
+)
+
+// Snapshots stores the snapshots of the write models in the eventstore.snapshots table
+type Snapshots struct {
+	client *database.DB
+}
+
+func NewSnapshots(client *database.DB) *Snapshots {
+	return &Snapshots{client: client}
+}
+
+// Snapshot implements [repository.SnapshotStore]
+func (s *Snapshots) Snapshot(ctx context.Context, instanceID, aggregateID, snapshotType string) (*repository.Snapshot, error) {
+	snapshot := &repository.Snapshot{
+		InstanceID:  instanceID,
+		AggregateID: aggregateID,
+		Type:        snapshotType,
+	}
+	err := s.client.QueryRowContext(ctx, snapshotStmt, instanceID, aggregateID, snapshotType).
+		Scan(
+			&snapshot.ResourceOwner,
+			&snapshot.Version,
+			&snapshot.Sequence,
+			&snapshot.ChangeDate,
+			&snapshot.State,
+		)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, caos_errs.ThrowInternal(err, "SQL-x7Rbq", "unable to query snapshot")
+	}
+	return snapshot, nil
+}
+
+// StoreSnapshot implements [repository.SnapshotStore]
+// the stored snapshot is only replaced by newer snapshots or snapshots of another version
+func (s *Snapshots) StoreSnapshot(ctx context.Context, snapshot *repository.Snapshot) error {
+	_, err := s.client.ExecContext(ctx, storeSnapshotStmt,
+		snapshot.InstanceID,
+		snapshot.AggregateID,
+		snapshot.Type,
+		snapshot.ResourceOwner,
+		snapshot.Version,
+		snapshot.Sequence,
+		snapshot.ChangeDate,
+		snapshot.State,
+	)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "SQL-Qw9Zk", "unable to store snapshot")
+	}
+	return nil
+}
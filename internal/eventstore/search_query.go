@@ -21,6 +21,8 @@ type SearchQueryBuilder struct {
 	queries         []*SearchQuery
 	tx              *sql.Tx
 	allowTimeTravel bool
+	// includeArchive is set if write models which opted into snapshots have no snapshot
+	includeArchive bool
 }
 
 type SearchQuery struct {
@@ -215,6 +217,22 @@ func (query *SearchQuery) Builder() *SearchQueryBuilder {
 	return query.builder
 }
 
+// eventTypesOf returns the event types queried of the aggregate type,
+// nil means that all events of the aggregate type are queried
+func (builder *SearchQueryBuilder) eventTypesOf(aggregateType AggregateType) []EventType {
+	var types []EventType
+	for _, query := range builder.queries {
+		if len(query.aggregateTypes) > 0 && !isAggreagteTypes(Aggregate{Type: aggregateType}, query.aggregateTypes...) {
+			continue
+		}
+		if len(query.eventTypes) == 0 {
+			return nil
+		}
+		types = append(types, query.eventTypes...)
+	}
+	return types
+}
+
 func (query *SearchQuery) matches(event Event) bool {
 	if query.eventSequenceLess > 0 && event.Sequence() >= query.eventSequenceLess {
 		return false
@@ -278,6 +296,7 @@ func (builder *SearchQueryBuilder) build(instanceID string) (*repository.SearchQ
 		Filters:         filters,
 		Tx:              builder.tx,
 		AllowTimeTravel: builder.allowTimeTravel,
+		IncludeArchive:  builder.includeArchive,
 	}, nil
 }
 
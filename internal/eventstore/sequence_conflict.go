@@ -0,0 +1,14 @@
+package eventstore
+
+import (
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+// IsSequenceConflict checks if the push failed
+// because an aggregate was changed after the sequence expected by [Aggregate.ExpectSequence]
+func IsSequenceConflict(err error) bool {
+	var conflict *repository.SequenceConflictError
+	return errors.As(err, &conflict)
+}
@@ -0,0 +1,150 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+// SnapshotWriteModel is a write model which can be restored from a snapshot,
+// so that only the events after the snapshot have to be filtered.
+// The write model is json encoded, the fields of the embedded [WriteModel] are stored separately.
+// Snapshots are only used if the query of the write model is restricted to the aggregate id of the write model.
+type SnapshotWriteModel interface {
+	QueryReducer
+	// SnapshotType identifies the write model, the snapshots are stored per type and aggregate
+	SnapshotType() string
+	// SnapshotVersion must be increased as soon as the state of the write model,
+	// its reduce or the mapping of the filtered events changes
+	SnapshotVersion() uint16
+	writeModel() *WriteModel
+}
+
+type snapshots struct {
+	store repository.SnapshotStore
+	// threshold is the count of events which must be reduced since the last snapshot
+	// before a new snapshot is stored
+	threshold int
+}
+
+func (es *Eventstore) filterToSnapshotWriteModel(ctx context.Context, wm SnapshotWriteModel) error {
+	query := wm.Query()
+	// the archive only contains events of the aggregate which are part of its snapshots,
+	// so it's only read if the write model is not restored from a snapshot
+	query.includeArchive = es.readArchive
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	base := wm.writeModel()
+	if !query.isSnapshotable(base.AggregateID) {
+		return es.FilterToReducer(ctx, query, wm)
+	}
+	version, err := snapshotVersion(wm, query, instanceID)
+	if err != nil {
+		return err
+	}
+	snapshot, err := es.snapshots.store.Snapshot(ctx, instanceID, base.AggregateID, wm.SnapshotType())
+	// snapshots are an optimisation, the write model is still correct without them
+	logging.WithFields("type", wm.SnapshotType(), "aggregate", base.AggregateID).OnError(err).Warn("unable to read snapshot")
+	var snapshotSequence uint64
+	if snapshot != nil && snapshot.Version == version {
+		if err = restoreSnapshot(wm, snapshot); err != nil {
+			return err
+		}
+		snapshotSequence = snapshot.Sequence
+		query.sequenceGreater(snapshotSequence)
+		query.includeArchive = false
+	}
+
+	events, err := es.Filter(ctx, query)
+	if err != nil {
+		return err
+	}
+	base.filteredSequence = snapshotSequence
+	setFilteredSequence(wm, events)
+	wm.AppendEvents(events...)
+	if err = wm.Reduce(); err != nil {
+		return err
+	}
+	// the sequence does not advance if the write model does not reduce the embedded write model
+	if len(events) < es.snapshots.threshold || base.ProcessedSequence <= snapshotSequence {
+		return nil
+	}
+	err = es.storeSnapshot(ctx, wm, instanceID, version)
+	logging.WithFields("type", wm.SnapshotType(), "aggregate", base.AggregateID).OnError(err).Warn("unable to store snapshot")
+	return nil
+}
+
+func (es *Eventstore) storeSnapshot(ctx context.Context, wm SnapshotWriteModel, instanceID, version string) error {
+	state, err := json.Marshal(wm)
+	if err != nil {
+		return errors.ThrowInternal(err, "V2-Jd8sF", "unable to marshal snapshot")
+	}
+	base := wm.writeModel()
+	return es.snapshots.store.StoreSnapshot(ctx, &repository.Snapshot{
+		InstanceID:    instanceID,
+		AggregateID:   base.AggregateID,
+		ResourceOwner: base.ResourceOwner,
+		Type:          wm.SnapshotType(),
+		Version:       version,
+		Sequence:      base.ProcessedSequence,
+		ChangeDate:    base.ChangeDate,
+		State:         state,
+	})
+}
+
+func restoreSnapshot(wm SnapshotWriteModel, snapshot *repository.Snapshot) error {
+	if err := json.Unmarshal(snapshot.State, wm); err != nil {
+		return errors.ThrowInternal(err, "V2-Pq2mX", "unable to unmarshal snapshot")
+	}
+	base := wm.writeModel()
+	base.ResourceOwner = snapshot.ResourceOwner
+	base.InstanceID = snapshot.InstanceID
+	base.ProcessedSequence = snapshot.Sequence
+	base.ChangeDate = snapshot.ChangeDate
+	return nil
+}
+
+// snapshotVersion combines the version of the write model with the hash of its query,
+// so that the snapshots are invalidated if further events are filtered
+func snapshotVersion(wm SnapshotWriteModel, query *SearchQueryBuilder, instanceID string) (string, error) {
+	searchQuery, err := query.build(instanceID)
+	if err != nil {
+		return "", err
+	}
+	hash := fnv.New64a()
+	for _, filters := range searchQuery.Filters {
+		for _, filter := range filters {
+			fmt.Fprintf(hash, "%d:%d:%v;", filter.Field, filter.Operation, filter.Value)
+		}
+		hash.Write([]byte{'|'})
+	}
+	return fmt.Sprintf("%d:%x", wm.SnapshotVersion(), hash.Sum64()), nil
+}
+
+// isSnapshotable checks if the events are filtered in order of the sequence and
+// all sub queries are restricted to the aggregate, because the sequences of a single aggregate cannot interleave
+func (builder *SearchQueryBuilder) isSnapshotable(aggregateID string) bool {
+	if aggregateID == "" || builder.desc || builder.limit > 0 || builder.tx != nil {
+		return false
+	}
+	for _, query := range builder.queries {
+		if len(query.aggregateIDs) != 1 || query.aggregateIDs[0] != aggregateID || query.eventSequenceLess > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (builder *SearchQueryBuilder) sequenceGreater(sequence uint64) {
+	for _, query := range builder.queries {
+		if query.eventSequenceGreater < sequence {
+			query.eventSequenceGreater = sequence
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+type testSnapshotWriteModel struct {
+	WriteModel
+	Count int `json:"count"`
+}
+
+func (wm *testSnapshotWriteModel) Reduce() error {
+	wm.Count += len(wm.Events)
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *testSnapshotWriteModel) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		AddQuery().
+		AggregateTypes("test.aggregate").
+		AggregateIDs(wm.AggregateID).
+		Builder()
+}
+
+func (wm *testSnapshotWriteModel) SnapshotType() string {
+	return "test"
+}
+
+func (wm *testSnapshotWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
+type testSnapshotStore struct {
+	snapshot *repository.Snapshot
+	stored   *repository.Snapshot
+}
+
+func (s *testSnapshotStore) Snapshot(context.Context, string, string, string) (*repository.Snapshot, error) {
+	return s.snapshot, nil
+}
+
+func (s *testSnapshotStore) StoreSnapshot(_ context.Context, snapshot *repository.Snapshot) error {
+	s.stored = snapshot
+	return nil
+}
+
+// filterRecordingRepo records if the archive was filtered
+type filterRecordingRepo struct {
+	*testRepo
+	includedArchive bool
+}
+
+func (repo *filterRecordingRepo) Filter(ctx context.Context, searchQuery *repository.SearchQuery) ([]*repository.Event, error) {
+	repo.includedArchive = searchQuery.IncludeArchive
+	return repo.testRepo.Filter(ctx, searchQuery)
+}
+
+func testSnapshotEvents(sequences ...uint64) []*repository.Event {
+	events := make([]*repository.Event, len(sequences))
+	for i, sequence := range sequences {
+		events[i] = &repository.Event{
+			Sequence:      sequence,
+			AggregateID:   "agg",
+			AggregateType: "test.aggregate",
+			Type:          "test.event",
+			Version:       "v1",
+			InstanceID:    "instance",
+			ResourceOwner: sql.NullString{String: "ro", Valid: true},
+		}
+	}
+	return events
+}
+
+func TestEventstore_filterToSnapshotWriteModel(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	wm := &testSnapshotWriteModel{WriteModel: WriteModel{AggregateID: "agg"}}
+	version, err := snapshotVersion(wm, wm.Query(), "instance")
+	require.NoError(t, err)
+
+	type res struct {
+		count          int
+		sequence       uint64
+		storedSequence uint64
+		// includedArchive is expected if the write model is not restored from a snapshot
+		includedArchive bool
+	}
+	tests := []struct {
+		name      string
+		snapshot  *repository.Snapshot
+		events    []*repository.Event
+		threshold int
+		res       res
+	}{
+		{
+			name:      "no snapshot, below threshold",
+			events:    testSnapshotEvents(1, 2),
+			threshold: 3,
+			res: res{
+				count:           2,
+				sequence:        2,
+				includedArchive: true,
+			},
+		},
+		{
+			name:      "no snapshot, threshold reached",
+			events:    testSnapshotEvents(1, 2),
+			threshold: 2,
+			res: res{
+				count:           2,
+				sequence:        2,
+				storedSequence:  2,
+				includedArchive: true,
+			},
+		},
+		{
+			name: "restored from snapshot",
+			snapshot: &repository.Snapshot{
+				InstanceID:    "instance",
+				AggregateID:   "agg",
+				ResourceOwner: "ro",
+				Version:       version,
+				Sequence:      5,
+				State:         []byte(`{"count":5}`),
+			},
+			events:    testSnapshotEvents(6),
+			threshold: 2,
+			res: res{
+				count:    6,
+				sequence: 6,
+			},
+		},
+		{
+			name: "restored from snapshot, no new events",
+			snapshot: &repository.Snapshot{
+				InstanceID:    "instance",
+				AggregateID:   "agg",
+				ResourceOwner: "ro",
+				Version:       version,
+				Sequence:      5,
+				State:         []byte(`{"count":5}`),
+			},
+			threshold: 0,
+			res: res{
+				count:    5,
+				sequence: 5,
+			},
+		},
+		{
+			name: "outdated snapshot version",
+			snapshot: &repository.Snapshot{
+				InstanceID:    "instance",
+				AggregateID:   "agg",
+				ResourceOwner: "ro",
+				Version:       "0:outdated",
+				Sequence:      5,
+				State:         []byte(`{"count":5}`),
+			},
+			events:    testSnapshotEvents(1, 2, 3),
+			threshold: 2,
+			res: res{
+				count:           3,
+				sequence:        3,
+				storedSequence:  3,
+				includedArchive: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &testSnapshotStore{snapshot: tt.snapshot}
+			repo := &filterRecordingRepo{testRepo: &testRepo{events: tt.events, t: t}}
+			es := &Eventstore{
+				repo:              repo,
+				eventInterceptors: map[EventType]eventTypeInterceptors{},
+				snapshots: &snapshots{
+					store:     store,
+					threshold: tt.threshold,
+				},
+				readArchive: true,
+			}
+			wm := &testSnapshotWriteModel{WriteModel: WriteModel{AggregateID: "agg"}}
+			err := es.FilterToQueryReducer(ctx, wm)
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.includedArchive, repo.includedArchive)
+			assert.Equal(t, tt.res.count, wm.Count)
+			assert.Equal(t, tt.res.sequence, wm.ProcessedSequence)
+			if tt.res.storedSequence == 0 {
+				assert.Nil(t, store.stored)
+				return
+			}
+			require.NotNil(t, store.stored)
+			assert.Equal(t, tt.res.storedSequence, store.stored.Sequence)
+			assert.Equal(t, version, store.stored.Version)
+			assert.JSONEq(t, `{"count":`+strconv.Itoa(tt.res.count)+`}`, string(store.stored.State))
+		})
+	}
+}
+
+func TestSearchQueryBuilder_isSnapshotable(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *SearchQueryBuilder
+		want  bool
+	}{
+		{
+			name:  "aggregate",
+			query: NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs("agg").Builder(),
+			want:  true,
+		},
+		{
+			name:  "other aggregate",
+			query: NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs("agg").Or().AggregateIDs("other").Builder(),
+			want:  false,
+		},
+		{
+			name:  "multiple aggregates",
+			query: NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateIDs("agg", "other").Builder(),
+			want:  false,
+		},
+		{
+			name:  "descending",
+			query: NewSearchQueryBuilder(ColumnsEvent).OrderDesc().AddQuery().AggregateIDs("agg").Builder(),
+			want:  false,
+		},
+		{
+			name:  "limited",
+			query: NewSearchQueryBuilder(ColumnsEvent).Limit(1).AddQuery().AggregateIDs("agg").Builder(),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.query.isSnapshotable("agg"))
+		})
+	}
+}
@@ -3,7 +3,6 @@ package eventstore
 import (
 	"sync"
 
-	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -58,7 +57,6 @@ func SubscribeEventTypes(eventQueue chan Event, types map[AggregateType][]EventT
 }
 
 func notify(events []Event) {
-	go v1.Notify(MapEventsToV1Events(events))
 	subsMutext.Lock()
 	defer subsMutext.Unlock()
 	for _, event := range events {
@@ -106,15 +104,8 @@ func (s *Subscription) Unsubscribe() {
 	}
 }
 
-func MapEventsToV1Events(events []Event) []*models.Event {
-	v1Events := make([]*models.Event, len(events))
-	for i, event := range events {
-		v1Events[i] = mapEventToV1Event(event)
-	}
-	return v1Events
-}
-
-func mapEventToV1Event(event Event) *models.Event {
+//MapEventToV1Event maps the event to the representation used by the v1 view models
+func MapEventToV1Event(event Event) *models.Event {
 	return &models.Event{
 		Sequence:      event.Sequence(),
 		CreationDate:  event.CreationDate(),
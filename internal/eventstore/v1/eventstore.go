@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/internal/repository"
 	z_sql "github.com/zitadel/zitadel/internal/eventstore/v1/internal/repository/sql"
@@ -12,19 +13,20 @@ import (
 type Eventstore interface {
 	Health(ctx context.Context) error
 	FilterEvents(ctx context.Context, searchQuery *models.SearchQuery) (events []*models.Event, err error)
-	Subscribe(aggregates ...models.AggregateType) *Subscription
 	InstanceIDs(ctx context.Context, searchQuery *models.SearchQuery) ([]string, error)
 }
 
 var _ Eventstore = (*eventstore)(nil)
 
 type eventstore struct {
-	repo repository.Repository
+	repo         repository.Repository
+	personalData *crypto.PersonalDataEncryption
 }
 
-func Start(db *database.DB, allowOrderByCreationDate bool) (Eventstore, error) {
+func Start(db *database.DB, allowOrderByCreationDate bool, personalData *crypto.PersonalDataEncryption) (Eventstore, error) {
 	return &eventstore{
-		repo: z_sql.Start(db, allowOrderByCreationDate),
+		repo:         z_sql.Start(db, allowOrderByCreationDate),
+		personalData: personalData,
 	}, nil
 }
 
@@ -32,7 +34,17 @@ func (es *eventstore) FilterEvents(ctx context.Context, searchQuery *models.Sear
 	if err := searchQuery.Validate(); err != nil {
 		return nil, err
 	}
-	return es.repo.Filter(ctx, models.FactoryFromSearchQuery(searchQuery))
+	events, err := es.repo.Filter(ctx, models.FactoryFromSearchQuery(searchQuery))
+	if err != nil || es.personalData == nil {
+		return events, err
+	}
+	for _, event := range events {
+		event.Data, err = es.personalData.DecryptPayload(event.InstanceID, event.AggregateID, string(event.Type), event.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
 }
 
 func (es *eventstore) Health(ctx context.Context) error {
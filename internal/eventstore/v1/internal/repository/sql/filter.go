@@ -30,7 +30,7 @@ func (sql *SQL) filter(ctx context.Context, db *database.DB, searchQuery *es_mod
 		return nil, errors.ThrowInvalidArgument(nil, "SQL-rWeBw", "invalid query factory")
 	}
 
-	rows, err := db.Query(query, values...)
+	rows, err := db.QueryContext(ctx, query, values...)
 	if err != nil {
 		logging.New().WithError(err).Info("query failed")
 		return nil, errors.ThrowInternal(err, "SQL-IJuyR", "unable to filter events")
@@ -57,7 +57,7 @@ func (db *SQL) LatestSequence(ctx context.Context, queryFactory *es_models.Searc
 	if query == "" {
 		return 0, errors.ThrowInvalidArgument(nil, "SQL-rWeBw", "invalid query factory")
 	}
-	row := db.client.QueryRow(query, values...)
+	row := db.client.QueryRowContext(ctx, query, values...)
 	sequence := new(Sequence)
 	err := rowScanner(row.Scan, sequence)
 	if err != nil {
@@ -73,7 +73,7 @@ func (db *SQL) InstanceIDs(ctx context.Context, queryFactory *es_models.SearchQu
 		return nil, errors.ThrowInvalidArgument(nil, "SQL-Sfwg2", "invalid query factory")
 	}
 
-	rows, err := db.client.Query(query, values...)
+	rows, err := db.client.QueryContext(ctx, query, values...)
 	if err != nil {
 		logging.New().WithError(err).Info("query failed")
 		return nil, errors.ThrowInternal(err, "SQL-Sfg3r", "unable to filter instance ids")
@@ -8,7 +8,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	v1 "github.com/zitadel/zitadel/internal/eventstore/v1"
 	models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -64,21 +63,3 @@ func (mr *MockEventstoreMockRecorder) Health(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockEventstore)(nil).Health), arg0)
 }
-
-// Subscribe mocks base method.
-func (m *MockEventstore) Subscribe(arg0 ...models.AggregateType) *v1.Subscription {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{}
-	for _, a := range arg0 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Subscribe", varargs...)
-	ret0, _ := ret[0].(*v1.Subscription)
-	return ret0
-}
-
-// Subscribe indicates an expected call of Subscribe.
-func (mr *MockEventstoreMockRecorder) Subscribe(arg0 ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventstore)(nil).Subscribe), arg0...)
-}
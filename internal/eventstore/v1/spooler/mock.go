@@ -1,3 +0,0 @@
-package spooler
-
-//go:generate mockgen -source spooler.go -destination ./mock/spooler.go -package mock
@@ -12,6 +12,10 @@ type WriteModel struct {
 	ResourceOwner     string    `json:"-"`
 	InstanceID        string    `json:"-"`
 	ChangeDate        time.Time `json:"-"`
+
+	// filteredSequence is the latest sequence of the filtered events
+	// including the events which weren't reduced, it's expected by [Aggregate.ExpectWriteModel]
+	filteredSequence uint64
 }
 
 //AppendEvents adds all the events to the read model.
@@ -45,3 +49,21 @@ func (wm *WriteModel) Reduce() error {
 	wm.Events = []Event{}
 	return nil
 }
+
+func (wm *WriteModel) writeModel() *WriteModel {
+	return wm
+}
+
+// setFilteredSequence remembers the latest sequence of the filtered events
+// if the reducer is a write model
+func setFilteredSequence(r interface{}, events []Event) {
+	wm, ok := r.(interface{ writeModel() *WriteModel })
+	if !ok {
+		return
+	}
+	for _, event := range events {
+		if event.Sequence() > wm.writeModel().filteredSequence {
+			wm.writeModel().filteredSequence = event.Sequence()
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package ldapsync
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	locksTable   = "projections.locks"
+	syncJob      = "ldap_sync"
+	lockDuration = 10 * time.Second
+)
+
+// GroupsMetadataKey is the key of the user metadata, which holds the distinguished names
+// of the LDAP groups the user is member of as JSON array
+const GroupsMetadataKey = "ldap_groups"
+
+var (
+	errNoLDAPProvider = errors.New("provider is not an LDAP provider")
+	errEmptyDirectory = errors.New("directory search returned no users, linked users are not deactivated")
+)
+
+type Config struct {
+	// Enabled starts the background job which synchronises the users linked to LDAP providers with the directory
+	Enabled bool
+	// Interval defines how often the directories are synchronised
+	Interval time.Duration
+	// PageSize defines how many entries are requested per page of the directory search
+	PageSize uint32
+}
+
+type syncer struct {
+	commands    *command.Commands
+	queries     *query.Queries
+	client      *sql.DB
+	lockers     map[string]crdb.Locker
+	userCodeAlg crypto.EncryptionAlgorithm
+	interval    time.Duration
+	pageSize    uint32
+}
+
+// Start periodically synchronises the users of all LDAP providers of all instances with their directory.
+// Users are only created if the provider allows automatic creation
+// and only updated or deactivated if the provider allows automatic updates.
+// The result of every run is pushed as synced event of the provider.
+// The providers are locked per instance, so a directory is only synchronised by one node at a time.
+func Start(ctx context.Context, config *Config, commands *command.Commands, queries *query.Queries, client *database.DB, userCodeAlg crypto.EncryptionAlgorithm) {
+	if config == nil || !config.Enabled {
+		return
+	}
+	s := &syncer{
+		commands:    commands,
+		queries:     queries,
+		client:      client.DB,
+		lockers:     make(map[string]crdb.Locker),
+		userCodeAlg: userCodeAlg,
+		interval:    config.Interval,
+		pageSize:    config.PageSize,
+	}
+	go s.run(ctx)
+}
+
+func (s *syncer) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.syncAll(ctx)
+		}
+	}
+}
+
+func (s *syncer) syncAll(ctx context.Context) {
+	instances, err := s.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		logging.WithError(err).Warn("unable to search instances for ldap sync")
+		return
+	}
+	for _, instance := range instances.Instances {
+		s.syncInstance(database.WithShard(authz.WithInstance(ctx, instance), instance.Shard()))
+	}
+}
+
+func (s *syncer) syncInstance(ctx context.Context) {
+	typeQuery, err := query.NewIDPTemplateTypeSearchQuery(domain.IDPTypeLDAP)
+	if err != nil {
+		logging.WithError(err).Warn("unable to create ldap provider query")
+		return
+	}
+	templates, err := s.queries.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{Queries: []query.SearchQuery{typeQuery}}, false)
+	if err != nil {
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID()).WithError(err).Warn("unable to search ldap providers")
+		return
+	}
+	for _, template := range templates.Templates {
+		if !template.IsAutoCreation && !template.IsAutoUpdate {
+			continue
+		}
+		s.syncIDP(ctx, template)
+	}
+}
+
+func (s *syncer) syncIDP(ctx context.Context, template *query.IDPTemplate) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	locker := s.locker(template.ID)
+	errs := locker.Lock(ctx, lockDuration, instanceID)
+	err, ok := <-errs
+	if err != nil || !ok {
+		// another node synchronises the directory
+		if caos_errs.IsErrorAlreadyExists(err) {
+			return
+		}
+		logging.WithFields("instance", instanceID, "idp", template.ID).OnError(err).Warn("unable to lock ldap provider for sync")
+		return
+	}
+	defer func() {
+		// stop renewing the lock before it's released
+		cancel()
+		err := locker.Unlock(instanceID)
+		logging.WithFields("instance", instanceID, "idp", template.ID).OnError(err).Warn("unable to unlock ldap provider after sync")
+	}()
+	// the lock is renewed until the directory is synchronised
+	go func() {
+		for err := range errs {
+			if err != nil && ctx.Err() == nil {
+				logging.WithFields("instance", instanceID, "idp", template.ID).WithError(err).Warn("lock for ldap sync lost")
+				cancel()
+			}
+		}
+	}()
+
+	run := new(command.LDAPSyncRun)
+	if err := s.syncDirectory(ctx, template, run); err != nil {
+		run.Errors = append(run.Errors, err.Error())
+	}
+	_, err = s.commands.ReportLDAPSyncRun(ctx, template.ID, run)
+	logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "idp", template.ID).OnError(err).Warn("unable to report ldap sync run")
+}
+
+// locker returns the lock of the provider, the lock itself is held per instance
+func (s *syncer) locker(idpID string) crdb.Locker {
+	locker, ok := s.lockers[idpID]
+	if !ok {
+		locker = crdb.NewLocker(s.client, locksTable, syncJob+"_"+idpID)
+		s.lockers[idpID] = locker
+	}
+	return locker
+}
+
+func (s *syncer) syncDirectory(ctx context.Context, template *query.IDPTemplate, run *command.LDAPSyncRun) error {
+	provider, err := s.commands.GetProvider(ctx, template.ID, "")
+	if err != nil {
+		return err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return errNoLDAPProvider
+	}
+	users, err := ldapProvider.SearchUsers(ctx, s.pageSize)
+	if err != nil {
+		return err
+	}
+	links, err := s.linkedUsers(ctx, template.ID)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[string]struct{}, len(users))
+	for _, user := range users {
+		if user.GetID() == "" {
+			continue
+		}
+		found[user.GetID()] = struct{}{}
+		link, ok := links[user.GetID()]
+		if !ok {
+			if !template.IsAutoCreation {
+				continue
+			}
+			if err := s.createUser(ctx, template, user); err != nil {
+				run.Errors = append(run.Errors, fmt.Sprintf("create %s: %v", user.GetID(), err))
+				continue
+			}
+			run.Created++
+			continue
+		}
+		if !template.IsAutoUpdate {
+			continue
+		}
+		updated, err := s.updateUser(ctx, link, user)
+		if err != nil {
+			run.Errors = append(run.Errors, fmt.Sprintf("update %s: %v", user.GetID(), err))
+			continue
+		}
+		if updated {
+			run.Updated++
+		}
+	}
+
+	if !template.IsAutoUpdate {
+		return nil
+	}
+	// an empty directory is most likely caused by a misconfiguration of the base DN or the filters
+	if len(found) == 0 && len(links) > 0 {
+		return errEmptyDirectory
+	}
+	for externalID, link := range links {
+		if _, ok := found[externalID]; ok {
+			continue
+		}
+		deactivated, err := s.deactivateUser(ctx, link)
+		if err != nil {
+			run.Errors = append(run.Errors, fmt.Sprintf("deactivate %s: %v", externalID, err))
+			continue
+		}
+		if deactivated {
+			run.Deactivated++
+		}
+	}
+	return nil
+}
+
+// linkedUsers returns the links of the provider mapped by the id of the user in the directory
+func (s *syncer) linkedUsers(ctx context.Context, idpID string) (map[string]*query.IDPUserLink, error) {
+	idpQuery, err := query.NewIDPUserLinkIDPIDSearchQuery(idpID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.queries.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{idpQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	linkedUsers := make(map[string]*query.IDPUserLink, len(links.Links))
+	for _, link := range links.Links {
+		linkedUsers[link.ProvidedUserID] = link
+	}
+	return linkedUsers, nil
+}
+
+func (s *syncer) createUser(ctx context.Context, template *query.IDPTemplate, user *ldap.User) error {
+	resourceOwner := template.ResourceOwner
+	if template.OwnerType == domain.IdentityProviderTypeSystem {
+		resourceOwner = authz.GetInstance(ctx).DefaultOrganisationID()
+	}
+	username := user.GetPreferredUsername()
+	if username == "" {
+		username = string(user.GetEmail())
+	}
+	groups, err := groupsMetadataValue(user.GetGroups())
+	if err != nil {
+		return err
+	}
+	human := &command.AddHuman{
+		Username:          username,
+		FirstName:         user.GetFirstName(),
+		LastName:          user.GetLastName(),
+		NickName:          user.GetNickname(),
+		DisplayName:       user.GetDisplayName(),
+		PreferredLanguage: user.GetPreferredLanguage(),
+		Email: command.Email{
+			Address:  user.GetEmail(),
+			Verified: user.IsEmailVerified(),
+		},
+		Phone: command.Phone{
+			Number:   user.GetPhone(),
+			Verified: user.IsPhoneVerified(),
+		},
+		ExternalIDP: true,
+		Links: []*command.AddLink{
+			{
+				IDPID:         template.ID,
+				DisplayName:   username,
+				IDPExternalID: user.GetID(),
+			},
+		},
+	}
+	if groups != nil {
+		human.Metadata = []*command.AddMetadataEntry{{Key: GroupsMetadataKey, Value: groups}}
+	}
+	return s.commands.AddHuman(ctx, resourceOwner, human, false)
+}
+
+func (s *syncer) updateUser(ctx context.Context, link *query.IDPUserLink, user *ldap.User) (updated bool, err error) {
+	existing, err := s.queries.GetUserByID(ctx, false, link.UserID, false)
+	if err != nil {
+		return false, err
+	}
+	if existing.Human == nil {
+		return false, nil
+	}
+	if profile := changedProfile(existing.Human, user); profile != nil {
+		profile.ObjectRoot = es_models.ObjectRoot{AggregateID: existing.ID, ResourceOwner: existing.ResourceOwner}
+		if _, err = s.commands.ChangeHumanProfile(ctx, profile); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	if emailChanged(existing.Human, user) {
+		emailCodeGenerator, err := s.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, s.userCodeAlg)
+		if err != nil {
+			return updated, err
+		}
+		_, err = s.commands.ChangeHumanEmail(ctx, &domain.Email{
+			ObjectRoot:      es_models.ObjectRoot{AggregateID: existing.ID, ResourceOwner: existing.ResourceOwner},
+			EmailAddress:    user.GetEmail().Normalize(),
+			IsEmailVerified: user.IsEmailVerified(),
+		}, emailCodeGenerator)
+		if err != nil {
+			return updated, err
+		}
+		updated = true
+	}
+	if phone, changed := phoneChanged(existing.Human, user); changed {
+		phoneCodeGenerator, err := s.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, s.userCodeAlg)
+		if err != nil {
+			return updated, err
+		}
+		_, err = s.commands.ChangeHumanPhone(ctx, &domain.Phone{
+			ObjectRoot:      es_models.ObjectRoot{AggregateID: existing.ID},
+			PhoneNumber:     phone,
+			IsPhoneVerified: user.IsPhoneVerified(),
+		}, existing.ResourceOwner, phoneCodeGenerator)
+		if err != nil {
+			return updated, err
+		}
+		updated = true
+	}
+	groupsUpdated, err := s.setGroups(ctx, existing.ID, existing.ResourceOwner, user.GetGroups())
+	return updated || groupsUpdated, err
+}
+
+func (s *syncer) setGroups(ctx context.Context, userID, resourceOwner string, groups []string) (bool, error) {
+	value, err := groupsMetadataValue(groups)
+	if err != nil {
+		return false, err
+	}
+	existing, err := s.queries.GetUserMetadataByKey(ctx, false, userID, GroupsMetadataKey, false)
+	if err != nil && !caos_errs.IsNotFound(err) {
+		return false, err
+	}
+	var existingValue []byte
+	if existing != nil {
+		existingValue = existing.Value
+	}
+	if bytes.Equal(existingValue, value) {
+		return false, nil
+	}
+	if value == nil {
+		_, err = s.commands.RemoveUserMetadata(ctx, GroupsMetadataKey, userID, resourceOwner)
+		return err == nil, err
+	}
+	_, err = s.commands.SetUserMetadata(ctx, &domain.Metadata{Key: GroupsMetadataKey, Value: value}, userID, resourceOwner)
+	return err == nil, err
+}
+
+func (s *syncer) deactivateUser(ctx context.Context, link *query.IDPUserLink) (bool, error) {
+	user, err := s.queries.GetUserByID(ctx, false, link.UserID, false)
+	if err != nil {
+		return false, err
+	}
+	if user.State != domain.UserStateActive {
+		return false, nil
+	}
+	_, err = s.commands.DeactivateUser(ctx, user.ID, user.ResourceOwner)
+	return err == nil, err
+}
+
+// changedProfile returns the profile to be set if the directory holds different information,
+// values not provided by the directory remain unchanged
+func changedProfile(existing *query.Human, user *ldap.User) *domain.Profile {
+	profile := &domain.Profile{
+		FirstName:         existing.FirstName,
+		LastName:          existing.LastName,
+		NickName:          existing.NickName,
+		DisplayName:       existing.DisplayName,
+		PreferredLanguage: existing.PreferredLanguage,
+		Gender:            existing.Gender,
+	}
+	if user.GetFirstName() != "" {
+		profile.FirstName = user.GetFirstName()
+	}
+	if user.GetLastName() != "" {
+		profile.LastName = user.GetLastName()
+	}
+	if user.GetNickname() != "" {
+		profile.NickName = user.GetNickname()
+	}
+	if user.GetDisplayName() != "" {
+		profile.DisplayName = user.GetDisplayName()
+	}
+	if user.GetPreferredLanguage() != language.Und {
+		profile.PreferredLanguage = user.GetPreferredLanguage()
+	}
+	if profile.FirstName == existing.FirstName &&
+		profile.LastName == existing.LastName &&
+		profile.NickName == existing.NickName &&
+		profile.DisplayName == existing.DisplayName &&
+		profile.PreferredLanguage == existing.PreferredLanguage {
+		return nil
+	}
+	return profile
+}
+
+func emailChanged(existing *query.Human, user *ldap.User) bool {
+	email := user.GetEmail().Normalize()
+	if email == "" {
+		return false
+	}
+	return email != existing.Email || user.IsEmailVerified() && !existing.IsEmailVerified
+}
+
+func phoneChanged(existing *query.Human, user *ldap.User) (domain.PhoneNumber, bool) {
+	if user.GetPhone() == "" {
+		return "", false
+	}
+	phone, err := user.GetPhone().Normalize()
+	if err != nil {
+		return "", false
+	}
+	return phone, phone != existing.Phone || user.IsPhoneVerified() && !existing.IsPhoneVerified
+}
+
+// groupsMetadataValue returns the sorted groups as JSON array or nil if there are none,
+// so that the same memberships always result in the same metadata value
+func groupsMetadataValue(groups []string) ([]byte, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	sorted := make([]string, len(groups))
+	copy(sorted, groups)
+	sort.Strings(sorted)
+	return json.Marshal(sorted)
+}
@@ -0,0 +1,82 @@
+package ldapsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_changedProfile(t *testing.T) {
+	existing := &query.Human{
+		FirstName:         "first",
+		LastName:          "last",
+		NickName:          "nick",
+		DisplayName:       "display",
+		PreferredLanguage: language.English,
+		Gender:            domain.GenderDiverse,
+	}
+	tests := []struct {
+		name string
+		user *ldap.User
+		want *domain.Profile
+	}{
+		{
+			name: "no attributes, unchanged",
+			user: ldap.NewUser("id", "", "", "", "", "", "", false, "", false, language.Und, "", ""),
+			want: nil,
+		},
+		{
+			name: "same attributes, unchanged",
+			user: ldap.NewUser("id", "first", "last", "display", "nick", "", "", false, "", false, language.English, "", ""),
+			want: nil,
+		},
+		{
+			name: "changed attributes",
+			user: ldap.NewUser("id", "first2", "last", "", "", "", "", false, "", false, language.German, "", ""),
+			want: &domain.Profile{
+				FirstName:         "first2",
+				LastName:          "last",
+				NickName:          "nick",
+				DisplayName:       "display",
+				PreferredLanguage: language.German,
+				Gender:            domain.GenderDiverse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, changedProfile(existing, tt.user))
+		})
+	}
+}
+
+func Test_groupsMetadataValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []string
+		want   []byte
+	}{
+		{
+			name:   "no groups",
+			groups: nil,
+			want:   nil,
+		},
+		{
+			name:   "sorted groups",
+			groups: []string{"cn=b,dc=example", "cn=a,dc=example"},
+			want:   []byte(`["cn=a,dc=example","cn=b,dc=example"]`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := groupsMetadataValue(tt.groups)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
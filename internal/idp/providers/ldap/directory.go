@@ -0,0 +1,126 @@
+package ldap
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// DefaultPageSize is used for the paged directory search if no page size is provided
+const DefaultPageSize uint32 = 500
+
+// groupsAttribute holds the distinguished names of the groups a user is member of
+const groupsAttribute = "memberOf"
+
+// SearchUsers pages through the directory below the configured base DN
+// and returns all entries matching the configured object classes and user filters
+// including the groups the users are member of.
+func (p *Provider) SearchUsers(_ context.Context, pageSize uint32) (_ []*User, err error) {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	var entries []*ldap.Entry
+	for _, server := range p.servers {
+		entries, err = trySearchUsers(server,
+			p.startTLS,
+			p.bindDN,
+			p.bindPassword,
+			p.baseDN,
+			append(p.getNecessaryAttributes(), groupsAttribute),
+			p.userObjectClasses,
+			p.userFilters,
+			pageSize,
+			p.timeout,
+		)
+		// If the search was successful, the directory was read completely, otherwise try next server
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*User, 0, len(entries))
+	for _, entry := range entries {
+		user, err := mapLDAPEntryToUser(
+			entry,
+			p.idAttribute,
+			p.firstNameAttribute,
+			p.lastNameAttribute,
+			p.displayNameAttribute,
+			p.nickNameAttribute,
+			p.preferredUsernameAttribute,
+			p.emailAttribute,
+			p.emailVerifiedAttribute,
+			p.phoneAttribute,
+			p.phoneVerifiedAttribute,
+			p.preferredLanguageAttribute,
+			p.avatarURLAttribute,
+			p.profileAttribute,
+		)
+		if err != nil {
+			return nil, err
+		}
+		user.groups = entry.GetAttributeValues(groupsAttribute)
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func trySearchUsers(
+	server string,
+	startTLS bool,
+	bindDN string,
+	bindPassword string,
+	baseDN string,
+	attributes []string,
+	objectClasses []string,
+	userFilters []string,
+	pageSize uint32,
+	timeout time.Duration,
+) ([]*ldap.Entry, error) {
+	conn, err := getConnection(server, startTLS, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(bindDN, bindPassword); err != nil {
+		return nil, err
+	}
+
+	searchRequest := ldap.NewSearchRequest(
+		baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(timeout.Seconds()), false,
+		usersSearchQuery(objectClasses, userFilters),
+		attributes,
+		nil,
+	)
+	sr, err := conn.SearchWithPaging(searchRequest, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return sr.Entries, nil
+}
+
+// usersSearchQuery returns a query for all entries with the object classes,
+// which have at least one of the attributes of the user filters set
+func usersSearchQuery(objectClasses []string, userFilters []string) string {
+	queries := make([]string, 0, len(objectClasses)+1)
+	for _, class := range objectClasses {
+		queries = append(queries, "(objectClass="+class+")")
+	}
+	presenceQueries := make([]string, len(userFilters))
+	for i, filter := range userFilters {
+		presenceQueries[i] = "(" + filter + "=*)"
+	}
+	if len(presenceQueries) > 0 {
+		queries = append(queries, queriesOrToSearchQuery(presenceQueries...))
+	}
+	if len(queries) == 0 {
+		return "(objectClass=*)"
+	}
+	return queriesAndToSearchQuery(queries...)
+}
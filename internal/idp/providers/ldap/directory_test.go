@@ -0,0 +1,54 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_usersSearchQuery(t *testing.T) {
+	type args struct {
+		objectClasses []string
+		userFilters   []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "zero",
+			args: args{},
+			want: "(objectClass=*)",
+		},
+		{
+			name: "object class",
+			args: args{
+				objectClasses: []string{"user"},
+			},
+			want: "(objectClass=user)",
+		},
+		{
+			name: "user filter",
+			args: args{
+				userFilters: []string{"uid"},
+			},
+			want: "(uid=*)",
+		},
+		{
+			name: "multiple",
+			args: args{
+				objectClasses: []string{"user", "person"},
+				userFilters:   []string{"uid", "mail"},
+			},
+			want: "(&(objectClass=user)(objectClass=person)(|(uid=*)(mail=*)))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			a.Equal(tt.want, usersSearchQuery(tt.args.objectClasses, tt.args.userFilters))
+		})
+	}
+}
@@ -20,6 +20,7 @@ type User struct {
 	preferredLanguage language.Tag
 	avatarURL         string
 	profile           string
+	groups            []string
 }
 
 func NewUser(
@@ -51,6 +52,7 @@ func NewUser(
 		preferredLanguage,
 		avatarURL,
 		profile,
+		nil,
 	}
 }
 
@@ -93,3 +95,9 @@ func (u *User) GetAvatarURL() string {
 func (u *User) GetProfile() string {
 	return u.profile
 }
+
+// GetGroups returns the distinguished names of the groups the user is member of.
+// They are only filled when the user was found through [Provider.SearchUsers].
+func (u *User) GetGroups() []string {
+	return u.groups
+}
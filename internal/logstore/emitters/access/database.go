@@ -37,6 +37,7 @@ var _ logstore.UsageQuerier = (*databaseLogStorage)(nil)
 var _ logstore.LogCleanupper = (*databaseLogStorage)(nil)
 
 type databaseLogStorage struct {
+	// dbClient stores the logs of the instances of all shards in the default database
 	dbClient *database.DB
 }
 
@@ -88,7 +89,7 @@ func (l *databaseLogStorage) Emit(ctx context.Context, bulk []logstore.LogRecord
 		return caos_errors.ThrowInternal(err, "ACCESS-KOS7I", "Errors.Internal")
 	}
 
-	result, err := l.dbClient.ExecContext(ctx, stmt, args...)
+	result, err := l.dbClient.DB.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return caos_errors.ThrowInternal(err, "ACCESS-alnT9", "Errors.Access.StorageFailed")
 	}
@@ -136,7 +137,7 @@ func (l *databaseLogStorage) QueryUsage(ctx context.Context, instanceId string,
 	}
 
 	var count uint64
-	if err = l.dbClient.
+	if err = l.dbClient.DB.
 		QueryRowContext(ctx, stmt, args...).
 		Scan(&count); err != nil {
 		return 0, caos_errors.ThrowInternal(err, "ACCESS-pBPrM", "Errors.Logstore.Access.ScanFailed")
@@ -157,6 +158,6 @@ func (l *databaseLogStorage) Cleanup(ctx context.Context, keep time.Duration) er
 
 	execCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	_, err = l.dbClient.ExecContext(execCtx, stmt, args...)
+	_, err = l.dbClient.DB.ExecContext(execCtx, stmt, args...)
 	return err
 }
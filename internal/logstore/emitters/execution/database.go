@@ -30,6 +30,7 @@ var _ logstore.UsageQuerier = (*databaseLogStorage)(nil)
 var _ logstore.LogCleanupper = (*databaseLogStorage)(nil)
 
 type databaseLogStorage struct {
+	// dbClient stores the logs of the instances of all shards in the default database
 	dbClient *database.DB
 }
 
@@ -81,7 +82,7 @@ func (l *databaseLogStorage) Emit(ctx context.Context, bulk []logstore.LogRecord
 		return caos_errors.ThrowInternal(err, "EXEC-KOS7I", "Errors.Internal")
 	}
 
-	result, err := l.dbClient.ExecContext(ctx, stmt, args...)
+	result, err := l.dbClient.DB.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return caos_errors.ThrowInternal(err, "EXEC-0j6i5", "Errors.Access.StorageFailed")
 	}
@@ -113,7 +114,7 @@ func (l *databaseLogStorage) QueryUsage(ctx context.Context, instanceId string,
 	}
 
 	var durationSeconds uint64
-	if err = l.dbClient.
+	if err = l.dbClient.DB.
 		QueryRowContext(ctx, stmt, args...).
 		Scan(&durationSeconds); err != nil {
 		return 0, caos_errors.ThrowInternal(err, "EXEC-Ad8nP", "Errors.Logstore.Execution.ScanFailed")
@@ -133,6 +134,6 @@ func (l *databaseLogStorage) Cleanup(ctx context.Context, keep time.Duration) er
 
 	execCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	_, err = l.dbClient.ExecContext(execCtx, stmt, args...)
+	_, err = l.dbClient.DB.ExecContext(execCtx, stmt, args...)
 	return err
 }
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendMagicLink(user *query.NotifyUser, origin, code, authRequestID, sessionID, urlTmpl string) error {
+	var url string
+	if urlTmpl == "" {
+		url = domain.MagicLinkLoginLink(origin+login.HandlerPrefix+login.EndpointMagicLink, user.ID, user.ResourceOwner, authRequestID, code)
+	} else {
+		var buf strings.Builder
+		if err := domain.RenderMagicLinkURLTemplate(&buf, urlTmpl, user.ID, user.ResourceOwner, sessionID, code); err != nil {
+			return err
+		}
+		url = buf.String()
+	}
+
+	return notify(url, nil, domain.MagicLinkMessageType, false)
+}
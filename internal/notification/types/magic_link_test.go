@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	caos_errs "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func TestNotify_SendMagicLink(t *testing.T) {
+	type args struct {
+		user          *query.NotifyUser
+		origin        string
+		code          string
+		authRequestID string
+		sessionID     string
+		urlTmpl       string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *notifyResult
+		wantErr error
+	}{
+		{
+			name: "default URL",
+			args: args{
+				user: &query.NotifyUser{
+					ID:            "user1",
+					ResourceOwner: "org1",
+				},
+				origin:        "https://example.com",
+				code:          "123",
+				authRequestID: "456",
+				urlTmpl:       "",
+			},
+			want: &notifyResult{
+				url:                                "https://example.com/ui/login/login/magiclink?userID=user1&orgID=org1&authRequestID=456&code=123",
+				messageType:                        domain.MagicLinkMessageType,
+				allowUnverifiedNotificationChannel: false,
+			},
+		},
+		{
+			name: "template error",
+			args: args{
+				user: &query.NotifyUser{
+					ID:            "user1",
+					ResourceOwner: "org1",
+				},
+				origin:    "https://example.com",
+				code:      "123",
+				sessionID: "456",
+				urlTmpl:   "{{",
+			},
+			want:    &notifyResult{},
+			wantErr: caos_errs.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+		},
+		{
+			name: "template success",
+			args: args{
+				user: &query.NotifyUser{
+					ID:            "user1",
+					ResourceOwner: "org1",
+				},
+				origin:    "https://example.com",
+				code:      "123",
+				sessionID: "456",
+				urlTmpl:   "https://example.com/login/magic?userID={{.UserID}}&orgID={{.OrgID}}&sessionID={{.SessionID}}&code={{.Code}}",
+			},
+			want: &notifyResult{
+				url:                                "https://example.com/login/magic?userID=user1&orgID=org1&sessionID=456&code=123",
+				messageType:                        domain.MagicLinkMessageType,
+				allowUnverifiedNotificationChannel: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, notify := mockNotify()
+			err := notify.SendMagicLink(tt.args.user, tt.args.origin, tt.args.code, tt.args.authRequestID, tt.args.sessionID, tt.args.urlTmpl)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
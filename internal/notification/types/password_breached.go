@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendPasswordBreached(user *query.NotifyUser, origin string) error {
+	url := console.LoginHintLink(origin, user.PreferredLoginName)
+	args := make(map[string]interface{})
+	return notify(url, args, domain.PasswordBreachedMessageType, true)
+}
@@ -413,21 +413,29 @@ func (q *Queries) AppByOIDCClientID(ctx context.Context, clientID string, withOw
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	stmt, scan := prepareAppQuery(ctx, q.client)
-	eq := sq.Eq{
-		AppOIDCConfigColumnClientID.identifier(): clientID,
-		AppColumnInstanceID.identifier():         authz.GetInstance(ctx).InstanceID(),
-	}
-	if !withOwnerRemoved {
-		eq[AppColumnOwnerRemoved.identifier()] = false
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	load := func() (*App, error) {
+		stmt, scan := prepareAppQuery(ctx, q.client)
+		eq := sq.Eq{
+			AppOIDCConfigColumnClientID.identifier(): clientID,
+			AppColumnInstanceID.identifier():         instanceID,
+		}
+		if !withOwnerRemoved {
+			eq[AppColumnOwnerRemoved.identifier()] = false
+		}
+		query, args, err := stmt.Where(eq).ToSql()
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "QUERY-JgVop", "Errors.Query.SQLStatement")
+		}
+
+		row := q.client.QueryRowContext(ctx, query, args...)
+		return scan(row)
 	}
-	query, args, err := stmt.Where(eq).ToSql()
-	if err != nil {
-		return nil, errors.ThrowInternal(err, "QUERY-JgVop", "Errors.Query.SQLStatement")
+	// only the clients of active owners are cached
+	if withOwnerRemoved {
+		return load()
 	}
-
-	row := q.client.QueryRowContext(ctx, query, args...)
-	return scan(row)
+	return q.caches.oidcClient.load(instanceID, clientID, load)
 }
 
 func (q *Queries) AppByClientID(ctx context.Context, clientID string, withOwnerRemoved bool) (_ *App, err error) {
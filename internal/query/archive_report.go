@@ -0,0 +1,154 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/archive"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	archiveReportColumnInstanceID    = "instance_id"
+	archiveReportColumnArchivedAt    = "archived_at"
+	archiveReportColumnPolicy        = "policy"
+	archiveReportColumnAggregateType = "aggregate_type"
+	archiveReportColumnEventType     = "event_type"
+	archiveReportColumnEventCount    = "event_count"
+	archiveReportColumnOldestEvent   = "oldest_event"
+	archiveReportColumnNewestEvent   = "newest_event"
+)
+
+var (
+	archiveReportTable = table{
+		name:          archive.ReportsTable,
+		instanceIDCol: archiveReportColumnInstanceID,
+	}
+	ArchiveReportColInstanceID = Column{
+		name:  archiveReportColumnInstanceID,
+		table: archiveReportTable,
+	}
+	ArchiveReportColArchivedAt = Column{
+		name:  archiveReportColumnArchivedAt,
+		table: archiveReportTable,
+	}
+	ArchiveReportColPolicy = Column{
+		name:  archiveReportColumnPolicy,
+		table: archiveReportTable,
+	}
+	ArchiveReportColAggregateType = Column{
+		name:  archiveReportColumnAggregateType,
+		table: archiveReportTable,
+	}
+	ArchiveReportColEventType = Column{
+		name:  archiveReportColumnEventType,
+		table: archiveReportTable,
+	}
+	ArchiveReportColEventCount = Column{
+		name:  archiveReportColumnEventCount,
+		table: archiveReportTable,
+	}
+	ArchiveReportColOldestEvent = Column{
+		name:  archiveReportColumnOldestEvent,
+		table: archiveReportTable,
+	}
+	ArchiveReportColNewestEvent = Column{
+		name:  archiveReportColumnNewestEvent,
+		table: archiveReportTable,
+	}
+)
+
+// ArchiveReport is the amount of events of a type archived by a policy
+type ArchiveReport struct {
+	ArchivedAt    time.Time
+	Policy        string
+	AggregateType string
+	EventType     string
+	EventCount    uint64
+	OldestEvent   time.Time
+	NewestEvent   time.Time
+}
+
+type ArchiveReports struct {
+	SearchResponse
+	ArchiveReports []*ArchiveReport
+}
+
+type ArchiveReportSearchQueries struct {
+	SearchRequest
+}
+
+// SearchArchiveReports returns the events of the instance which were moved into the archive
+func (q *Queries) SearchArchiveReports(ctx context.Context, queries *ArchiveReportSearchQueries) (_ *ArchiveReports, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareArchiveReportsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			ArchiveReportColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Arp1q", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Arp2e", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func prepareArchiveReportsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ArchiveReports, error)) {
+	return sq.Select(
+			ArchiveReportColArchivedAt.identifier(),
+			ArchiveReportColPolicy.identifier(),
+			ArchiveReportColAggregateType.identifier(),
+			ArchiveReportColEventType.identifier(),
+			ArchiveReportColEventCount.identifier(),
+			ArchiveReportColOldestEvent.identifier(),
+			ArchiveReportColNewestEvent.identifier(),
+			countColumn.identifier(),
+		).
+			From(archiveReportTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ArchiveReports, error) {
+			reports := make([]*ArchiveReport, 0)
+			var count uint64
+			for rows.Next() {
+				report := new(ArchiveReport)
+				err := rows.Scan(
+					&report.ArchivedAt,
+					&report.Policy,
+					&report.AggregateType,
+					&report.EventType,
+					&report.EventCount,
+					&report.OldestEvent,
+					&report.NewestEvent,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				reports = append(reports, report)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Arp3c", "Errors.Query.CloseRows")
+			}
+
+			return &ArchiveReports{
+				ArchiveReports: reports,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareArchiveReportsStmt = `SELECT eventstore.archive_reports.archived_at,` +
+		` eventstore.archive_reports.policy,` +
+		` eventstore.archive_reports.aggregate_type,` +
+		` eventstore.archive_reports.event_type,` +
+		` eventstore.archive_reports.event_count,` +
+		` eventstore.archive_reports.oldest_event,` +
+		` eventstore.archive_reports.newest_event,` +
+		` COUNT(*) OVER ()` +
+		` FROM eventstore.archive_reports` +
+		` AS OF SYSTEM TIME '-1 ms'`
+
+	prepareArchiveReportsCols = []string{
+		"archived_at",
+		"policy",
+		"aggregate_type",
+		"event_type",
+		"event_count",
+		"oldest_event",
+		"newest_event",
+		"count",
+	}
+)
+
+func Test_ArchiveReportsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareArchiveReportsQuery no result",
+			prepare: prepareArchiveReportsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareArchiveReportsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ArchiveReports{ArchiveReports: []*ArchiveReport{}},
+		},
+		{
+			name:    "prepareArchiveReportsQuery one result",
+			prepare: prepareArchiveReportsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareArchiveReportsStmt),
+					prepareArchiveReportsCols,
+					[][]driver.Value{
+						{
+							testNow,
+							"tokens",
+							"user",
+							"user.token.added",
+							uint64(42),
+							testNow,
+							testNow,
+						},
+					},
+				),
+			},
+			object: &ArchiveReports{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				ArchiveReports: []*ArchiveReport{
+					{
+						ArchivedAt:    testNow,
+						Policy:        "tokens",
+						AggregateType: "user",
+						EventType:     "user.token.added",
+						EventCount:    42,
+						OldestEvent:   testNow,
+						NewestEvent:   testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareArchiveReportsQuery sql err",
+			prepare: prepareArchiveReportsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareArchiveReportsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
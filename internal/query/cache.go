@@ -0,0 +1,296 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/gob"
+	"encoding/hex"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+// caches of hot lookups, the lookups query the database directly if no cache is configured
+type caches struct {
+	instanceByHost *queryCache[*Instance]
+	orgByDomain    *queryCache[*Org]
+	oidcClient     *queryCache[*App]
+	publicKeys     *queryCache[*PublicKeys]
+}
+
+func newCaches(c cache.Cache) caches {
+	if c == nil {
+		return caches{}
+	}
+	return caches{
+		instanceByHost: &queryCache[*Instance]{cache: c, name: "instance_by_host"},
+		orgByDomain:    &queryCache[*Org]{cache: c, name: "org_by_domain"},
+		oidcClient:     &queryCache[*App]{cache: c, name: "oidc_client"},
+		publicKeys:     &queryCache[*PublicKeys]{cache: c, name: "public_keys"},
+	}
+}
+
+// registerInvalidation invalidates the caches as soon as the projections they read from reduced events,
+// so the caches never serve data older than the projections.
+// It must be called before the projections are started.
+func (c caches) registerInvalidation() {
+	if c.instanceByHost == nil {
+		return
+	}
+	// instances are looked up by host before the instance is known
+	projection.InstanceProjection.OnProcessed(c.instanceByHost.invalidateAll)
+	projection.InstanceDomainProjection.OnProcessed(c.instanceByHost.invalidateAll)
+	projection.SecurityPolicyProjection.OnProcessed(c.instanceByHost.invalidateAll)
+
+	projection.OrgProjection.OnProcessed(c.orgByDomain.invalidateInstances)
+	projection.OrgDomainProjection.OnProcessed(c.orgByDomain.invalidateInstances)
+	projection.AppProjection.OnProcessed(c.oidcClient.invalidateInstances)
+	projection.KeyProjection.OnProcessed(c.publicKeys.invalidateInstances)
+}
+
+// InstanceRoutingChanged invalidates the instances looked up by host,
+// because they contain the shard the instance is routed to
+func (q *Queries) InstanceRoutingChanged() {
+	q.caches.instanceByHost.invalidate("")
+}
+
+// queryCache caches the results of a lookup.
+// The entries are stored per scope (e.g. the instance) and generation.
+// Invalidation creates a new generation of the scope, so entries of lookups
+// which read the projection before the invalidation are never returned.
+type queryCache[T any] struct {
+	cache cache.Cache
+	name  string
+}
+
+// load returns the cached value or stores the result of load
+// a nil cache always loads
+func (c *queryCache[T]) load(scope, key string, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+	generation, err := c.generation(scope)
+	if err != nil {
+		logging.WithFields("cache", c.name).WithError(err).Warn("unable to read generation")
+		return load()
+	}
+	entryKey := c.entryKey(scope, generation, key)
+	value := new(T)
+	if err = c.cache.Get(entryKey, value); err == nil {
+		return *value, nil
+	}
+	if !errors.IsNotFound(err) {
+		logging.WithFields("cache", c.name).WithError(err).Warn("unable to read entry")
+	}
+
+	result, err := load()
+	if err != nil {
+		return result, err
+	}
+	err = c.cache.Set(entryKey, result)
+	logging.WithFields("cache", c.name).OnError(err).Warn("unable to write entry")
+	return result, nil
+}
+
+func (c *queryCache[T]) generation(scope string) (string, error) {
+	var generation string
+	err := c.cache.Get(c.generationKey(scope), &generation)
+	if errors.IsNotFound(err) {
+		return c.newGeneration(scope)
+	}
+	return generation, err
+}
+
+// newGeneration stores a random generation of the scope,
+// it must be unique across all nodes sharing the cache
+func (c *queryCache[T]) newGeneration(scope string) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	generation := hex.EncodeToString(random)
+	return generation, c.cache.Set(c.generationKey(scope), &generation)
+}
+
+// invalidate invalidates the entries of the scope
+// a nil cache has nothing to invalidate
+func (c *queryCache[T]) invalidate(scope string) {
+	if c == nil {
+		return
+	}
+	_, err := c.newGeneration(scope)
+	logging.WithFields("cache", c.name, "scope", scope).OnError(err).Error("unable to invalidate cache")
+}
+
+// invalidateAll invalidates the entries of all instances
+func (c *queryCache[T]) invalidateAll(_ context.Context, _ []eventstore.Event) {
+	c.invalidate("")
+}
+
+// invalidateInstances invalidates the entries of the instances of the events
+func (c *queryCache[T]) invalidateInstances(_ context.Context, events []eventstore.Event) {
+	invalidated := make(map[string]bool, 1)
+	for _, event := range events {
+		instanceID := event.Aggregate().InstanceID
+		if invalidated[instanceID] {
+			continue
+		}
+		invalidated[instanceID] = true
+		c.invalidate(instanceID)
+	}
+}
+
+func (c *queryCache[T]) generationKey(scope string) string {
+	return "query." + c.name + "." + scope + ".generation"
+}
+
+func (c *queryCache[T]) entryKey(scope, generation, key string) string {
+	return "query." + c.name + "." + scope + "." + generation + "." + key
+}
+
+// cachedInstance is the encoded form of Instance, which is stored in the cache
+type cachedInstance struct {
+	ID             string
+	ChangeDate     time.Time
+	CreationDate   time.Time
+	Sequence       uint64
+	Name           string
+	DefaultOrgID   string
+	IAMProjectID   string
+	ConsoleID      string
+	ConsoleAppID   string
+	DefaultLang    string
+	Domains        []*InstanceDomain
+	Host           string
+	CSPEnabled     bool
+	AllowedOrigins []string
+	Shard          string
+}
+
+func (i *Instance) GobEncode() ([]byte, error) {
+	var b bytes.Buffer
+	err := gob.NewEncoder(&b).Encode(&cachedInstance{
+		ID:             i.ID,
+		ChangeDate:     i.ChangeDate,
+		CreationDate:   i.CreationDate,
+		Sequence:       i.Sequence,
+		Name:           i.Name,
+		DefaultOrgID:   i.DefaultOrgID,
+		IAMProjectID:   i.IAMProjectID,
+		ConsoleID:      i.ConsoleID,
+		ConsoleAppID:   i.ConsoleAppID,
+		DefaultLang:    i.DefaultLang.String(),
+		Domains:        i.Domains,
+		Host:           i.host,
+		CSPEnabled:     i.csp.enabled,
+		AllowedOrigins: i.csp.allowedOrigins,
+		Shard:          i.shard,
+	})
+	return b.Bytes(), err
+}
+
+func (i *Instance) GobDecode(data []byte) error {
+	cached := new(cachedInstance)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(cached); err != nil {
+		return err
+	}
+	*i = Instance{
+		ID:           cached.ID,
+		ChangeDate:   cached.ChangeDate,
+		CreationDate: cached.CreationDate,
+		Sequence:     cached.Sequence,
+		Name:         cached.Name,
+		DefaultOrgID: cached.DefaultOrgID,
+		IAMProjectID: cached.IAMProjectID,
+		ConsoleID:    cached.ConsoleID,
+		ConsoleAppID: cached.ConsoleAppID,
+		DefaultLang:  language.Make(cached.DefaultLang),
+		Domains:      cached.Domains,
+		host:         cached.Host,
+		csp: csp{
+			enabled:        cached.CSPEnabled,
+			allowedOrigins: cached.AllowedOrigins,
+		},
+		shard: cached.Shard,
+	}
+	return nil
+}
+
+// cachedPublicKeys is the encoded form of PublicKeys, which is stored in the cache
+type cachedPublicKeys struct {
+	SearchResponse
+	Keys []*cachedPublicKey
+}
+
+type cachedPublicKey struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	Algorithm     string
+	Use           domain.KeyUsage
+	Expiry        time.Time
+	PublicKey     *rsa.PublicKey
+}
+
+func (k *PublicKeys) GobEncode() ([]byte, error) {
+	cached := &cachedPublicKeys{
+		SearchResponse: k.SearchResponse,
+		Keys:           make([]*cachedPublicKey, 0, len(k.Keys)),
+	}
+	for _, publicKey := range k.Keys {
+		rsaKey, ok := publicKey.(*rsaPublicKey)
+		if !ok {
+			return nil, errors.ThrowInternal(nil, "QUERY-Wd3tq", "unsupported public key")
+		}
+		cached.Keys = append(cached.Keys, &cachedPublicKey{
+			ID:            rsaKey.id,
+			CreationDate:  rsaKey.creationDate,
+			ChangeDate:    rsaKey.changeDate,
+			Sequence:      rsaKey.sequence,
+			ResourceOwner: rsaKey.resourceOwner,
+			Algorithm:     rsaKey.algorithm,
+			Use:           rsaKey.use,
+			Expiry:        rsaKey.expiry,
+			PublicKey:     rsaKey.publicKey,
+		})
+	}
+	var b bytes.Buffer
+	err := gob.NewEncoder(&b).Encode(cached)
+	return b.Bytes(), err
+}
+
+func (k *PublicKeys) GobDecode(data []byte) error {
+	cached := new(cachedPublicKeys)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(cached); err != nil {
+		return err
+	}
+	k.SearchResponse = cached.SearchResponse
+	k.Keys = make([]PublicKey, len(cached.Keys))
+	for i, cachedKey := range cached.Keys {
+		k.Keys[i] = &rsaPublicKey{
+			key: key{
+				id:            cachedKey.ID,
+				creationDate:  cachedKey.CreationDate,
+				changeDate:    cachedKey.ChangeDate,
+				sequence:      cachedKey.Sequence,
+				resourceOwner: cachedKey.ResourceOwner,
+				algorithm:     cachedKey.Algorithm,
+				use:           cachedKey.Use,
+			},
+			expiry:    cachedKey.Expiry,
+			publicKey: cachedKey.PublicKey,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package query
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/cache/fastcache"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func newTestQueryCache[T any](t *testing.T) *queryCache[T] {
+	t.Helper()
+	c, err := fastcache.NewFastcache(&fastcache.Config{MaxCacheSizeInByte: 1 << 20})
+	if err != nil {
+		t.Fatalf("unable to create cache: %v", err)
+	}
+	return &queryCache[T]{cache: c, name: "test"}
+}
+
+func TestQueryCache_load(t *testing.T) {
+	c := newTestQueryCache[*Org](t)
+	loads := 0
+	load := func() (*Org, error) {
+		loads++
+		return &Org{ID: "org"}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		org, err := c.load("instance", "key", load)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if org.ID != "org" {
+			t.Errorf("unexpected org: %v", org)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("expected 1 load, got %d", loads)
+	}
+
+	c.invalidate("other")
+	if _, err := c.load("instance", "key", load); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loads != 1 {
+		t.Errorf("invalidation of other scope must not reload, got %d loads", loads)
+	}
+
+	c.invalidate("instance")
+	if _, err := c.load("instance", "key", load); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loads != 2 {
+		t.Errorf("expected reload after invalidation, got %d loads", loads)
+	}
+}
+
+func TestQueryCache_loadError(t *testing.T) {
+	c := newTestQueryCache[*Org](t)
+	loads := 0
+	load := func() (*Org, error) {
+		loads++
+		return nil, errors.ThrowNotFound(nil, "QUERY-test", "not found")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.load("instance", "key", load); !errors.IsNotFound(err) {
+			t.Errorf("expected not found, got: %v", err)
+		}
+	}
+	if loads != 2 {
+		t.Errorf("errors must not be cached, got %d loads", loads)
+	}
+}
+
+func TestQueryCache_loadWithoutCache(t *testing.T) {
+	var c *queryCache[*Org]
+	loads := 0
+	for i := 0; i < 2; i++ {
+		_, err := c.load("instance", "key", func() (*Org, error) {
+			loads++
+			return &Org{ID: "org"}, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if loads != 2 {
+		t.Errorf("expected 2 loads, got %d", loads)
+	}
+}
+
+func TestInstance_gob(t *testing.T) {
+	c := newTestQueryCache[*Instance](t)
+	want := &Instance{
+		ID:          "instance",
+		Name:        "name",
+		DefaultLang: language.German,
+		Domains:     []*InstanceDomain{{Domain: "host", InstanceID: "instance", IsPrimary: true}},
+		host:        "host:8080",
+		csp: csp{
+			enabled:        true,
+			allowedOrigins: []string{"origin"},
+		},
+		shard: "eu2",
+	}
+	got, err := c.load("", "host:8080", func() (*Instance, error) { return want, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = c.load("", "host:8080", func() (*Instance, error) { return nil, errors.ThrowInternal(nil, "QUERY-test", "not cached") })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestPublicKeys_gob(t *testing.T) {
+	c := newTestQueryCache[*PublicKeys](t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	want := &PublicKeys{
+		SearchResponse: SearchResponse{Count: 1},
+		Keys: []PublicKey{
+			&rsaPublicKey{
+				key: key{
+					id:        "key",
+					algorithm: "RS256",
+					use:       domain.KeyUsageSigning,
+				},
+				expiry:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				publicKey: &rsaKey.PublicKey,
+			},
+		},
+	}
+	_, err = c.load("instance", "active", func() (*PublicKeys, error) { return want, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := c.load("instance", "active", func() (*PublicKeys, error) { return nil, errors.ThrowInternal(nil, "QUERY-test", "not cached") })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
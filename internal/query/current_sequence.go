@@ -101,7 +101,7 @@ func (q *Queries) ClearCurrentSequence(ctx context.Context, projectionName strin
 		return err
 	}
 
-	tx, err := q.client.Begin()
+	tx, err := q.client.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.ThrowInternal(err, "QUERY-9iOpr", "Errors.RemoveFailed")
 	}
@@ -138,8 +138,9 @@ func (q *Queries) checkAndLock(ctx context.Context, projectionName string) error
 	if err != nil {
 		return errors.ThrowInternal(err, "QUERY-DVfg3", "Errors.RemoveFailed")
 	}
+	// the locks of the projections of all shards are stored in the default database
 	//lock for twice the default duration (10s)
-	res, err := q.client.ExecContext(ctx, lock, lockerIDReset, 20*time.Second, projectionName)
+	res, err := q.client.DB.ExecContext(ctx, lock, lockerIDReset, 20*time.Second, projectionName)
 	if err != nil {
 		return errors.ThrowInternal(err, "QUERY-WEfr2", "Errors.RemoveFailed")
 	}
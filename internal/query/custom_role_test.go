@@ -0,0 +1,183 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	prepareCustomRolesStmt = `SELECT projections.custom_roles.role,` +
+		` projections.custom_roles.aggregate_id,` +
+		` projections.custom_roles.creation_date,` +
+		` projections.custom_roles.change_date,` +
+		` projections.custom_roles.sequence,` +
+		` projections.custom_roles.resource_owner,` +
+		` projections.custom_roles.display_name,` +
+		` projections.custom_roles.permissions,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.custom_roles` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareCustomRolesCols = []string{
+		"role",
+		"aggregate_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"display_name",
+		"permissions",
+		"count",
+	}
+)
+
+func Test_CustomRolesPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareCustomRolesQuery no result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &CustomRoles{CustomRoles: []*CustomRole{}},
+		},
+		{
+			name:    "prepareCustomRolesQuery one result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					prepareCustomRolesCols,
+					[][]driver.Value{
+						{
+							"ORG_AUDITOR",
+							"agg-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"ro",
+							"Auditor",
+							database.StringArray{"org.read", "user.read"},
+						},
+					},
+				),
+			},
+			object: &CustomRoles{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				CustomRoles: []*CustomRole{
+					{
+						Role:          "ORG_AUDITOR",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						ResourceOwner: "ro",
+						DisplayName:   "Auditor",
+						Permissions:   database.StringArray{"org.read", "user.read"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareCustomRolesQuery multiple result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					prepareCustomRolesCols,
+					[][]driver.Value{
+						{
+							"ORG_AUDITOR",
+							"agg-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"ro",
+							"Auditor",
+							database.StringArray{"org.read", "user.read"},
+						},
+						{
+							"PROJECT_VIEWER",
+							"agg-id",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							"",
+							database.StringArray{"project.read"},
+						},
+					},
+				),
+			},
+			object: &CustomRoles{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				CustomRoles: []*CustomRole{
+					{
+						Role:          "ORG_AUDITOR",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						ResourceOwner: "ro",
+						DisplayName:   "Auditor",
+						Permissions:   database.StringArray{"org.read", "user.read"},
+					},
+					{
+						Role:          "PROJECT_VIEWER",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						ResourceOwner: "ro",
+						DisplayName:   "",
+						Permissions:   database.StringArray{"project.read"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareCustomRolesQuery sql err",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareCustomRolesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -90,15 +90,17 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.DomainPolicyProjection.Trigger(ctx)
 	}
 	eq := sq.And{
 		sq.Eq{DomainPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		sq.Or{
-			sq.Eq{DomainPolicyColID.identifier(): orgID},
-			sq.Eq{DomainPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-		},
+		sq.Eq{DomainPolicyColID.identifier(): owners},
 	}
 	if !withOwnerRemoved {
 		eq = sq.And{
@@ -106,15 +108,12 @@ func (q *Queries) DomainPolicyByOrg(ctx context.Context, shouldTriggerBulk bool,
 				DomainPolicyColInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
 				DomainPolicyColOwnerRemoved.identifier(): false,
 			},
-			sq.Or{
-				sq.Eq{DomainPolicyColID.identifier(): orgID},
-				sq.Eq{DomainPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{DomainPolicyColID.identifier(): owners},
 		}
 	}
 
 	stmt, scan := prepareDomainPolicyQuery(ctx, q.client)
-	query, args, err := stmt.Where(eq).OrderBy(DomainPolicyColIsDefault.identifier()).
+	query, args, err := stmt.Where(eq).OrderByClause(policyInheritanceOrder(DomainPolicyColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-D3CqT", "Errors.Query.SQLStatement")
@@ -0,0 +1,249 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	eventSubscriptionBatchSize    = 100
+	eventSubscriptionPollInterval = time.Second
+	// events are only sent after the settle time, so that events of transactions
+	// which were committed later than events with a higher sequence are not skipped
+	eventSubscriptionSettleTime = time.Second
+
+	permissionEventsRead = "events.read"
+)
+
+// EventSubscription defines which events of the instance are streamed to the consumer
+type EventSubscription struct {
+	// Cursor is the position of the last event the consumer received
+	Cursor         EventCursor
+	AggregateTypes []eventstore.AggregateType
+	EventTypes     []eventstore.EventType
+	ResourceOwner  string
+	// PermittedOrgs restricts the events to the resource owners, all events are permitted if nil
+	PermittedOrgs []string
+}
+
+// NewEventSubscription creates the subscription for the caller,
+// which is restricted to the organisations the caller is permitted to read the events of,
+// if the caller has no instance wide permission
+func (q *Queries) NewEventSubscription(ctx context.Context, cursor EventCursor, aggregateTypes, eventTypes []string, resourceOwner string) (_ *EventSubscription, err error) {
+	subscription := &EventSubscription{
+		Cursor:         cursor,
+		AggregateTypes: make([]eventstore.AggregateType, len(aggregateTypes)),
+		EventTypes:     make([]eventstore.EventType, len(eventTypes)),
+		ResourceOwner:  resourceOwner,
+	}
+	for i, aggregateType := range aggregateTypes {
+		subscription.AggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	for i, eventType := range eventTypes {
+		subscription.EventTypes[i] = eventstore.EventType(eventType)
+	}
+	subscription.PermittedOrgs, err = q.eventsReadOrgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// eventsReadOrgs returns the organisations (including their descendants) the caller is member of with the permission to read events,
+// nil is returned if the caller is permitted to read the events of the whole instance.
+// The permissions of the request context can't be used, they only contain the organisation of the request.
+func (q *Queries) eventsReadOrgs(ctx context.Context) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	userIDQuery, err := NewMembershipUserIDQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := q.Memberships(ctx, &MembershipSearchQuery{Queries: []SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	roleMappings, err := q.memberRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgIDs, instanceWide := permittedOrgsOfMemberships(memberships.Memberships, roleMappings, permissionEventsRead)
+	if instanceWide {
+		return nil, nil
+	}
+	permittedOrgs := make([]string, 0, len(orgIDs))
+	for _, orgID := range orgIDs {
+		if containsID(permittedOrgs, orgID) {
+			continue
+		}
+		descendantIDs, err := q.OrgDescendantIDs(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		permittedOrgs = append(permittedOrgs, orgID)
+		for _, descendantID := range descendantIDs {
+			if !containsID(permittedOrgs, descendantID) {
+				permittedOrgs = append(permittedOrgs, descendantID)
+			}
+		}
+	}
+	return permittedOrgs, nil
+}
+
+// permittedOrgsOfMemberships returns the organisations of the memberships with a role granting the permission
+// and if an instance membership grants it, project memberships are not considered
+func permittedOrgsOfMemberships(memberships []*Membership, roleMappings []authz.RoleMapping, permission string) (orgIDs []string, instanceWide bool) {
+	orgIDs = make([]string, 0)
+	for _, membership := range memberships {
+		if !rolesGrantPermission(membership.Roles, roleMappings, permission) {
+			continue
+		}
+		if membership.IAM != nil {
+			return nil, true
+		}
+		if membership.Org != nil {
+			orgIDs = append(orgIDs, membership.Org.OrgID)
+		}
+	}
+	return orgIDs, false
+}
+
+func rolesGrantPermission(roles []string, roleMappings []authz.RoleMapping, permission string) bool {
+	for _, role := range roles {
+		for _, mapping := range roleMappings {
+			if mapping.Role != role {
+				continue
+			}
+			for _, mappedPermission := range mapping.Permissions {
+				if mappedPermission == permission {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// EventCursor is the position of an event in the stream of the instance
+// the consumer can resume the subscription after the event
+type EventCursor uint64
+
+func (c EventCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(c), 10)))
+}
+
+func ParseEventCursor(cursor string) (EventCursor, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errors.ThrowInvalidArgument(err, "QUERY-Evc1a", "Errors.Event.CursorInvalid")
+	}
+	sequence, err := strconv.ParseUint(string(decoded), 10, 64)
+	if err != nil {
+		return 0, errors.ThrowInvalidArgument(err, "QUERY-Evc2b", "Errors.Event.CursorInvalid")
+	}
+	return EventCursor(sequence), nil
+}
+
+// SubscribeEvents sends the events of the subscription in the order of their sequence until the context is done or send fails.
+// The next events are only queried after the previous were sent, so a slow consumer slows down the subscription.
+func (q *Queries) SubscribeEvents(ctx context.Context, subscription *EventSubscription, auditLogRetention time.Duration, send func(*Event, EventCursor) error) error {
+	instanceQueue := make(chan string, 1)
+	nodeSub := q.eventstore.SubscribeNodes(instanceQueue, subscription.aggregateTypes(q.eventstore)...)
+	defer q.eventstore.UnsubscribeNodes(nodeSub)
+
+	ticker := time.NewTicker(eventSubscriptionPollInterval)
+	defer ticker.Stop()
+	for {
+		hasMore, err := q.sendEvents(ctx, subscription, auditLogRetention, send)
+		if err != nil {
+			return err
+		}
+		if hasMore {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-instanceQueue:
+		}
+	}
+}
+
+func (q *Queries) sendEvents(ctx context.Context, subscription *EventSubscription, auditLogRetention time.Duration, send func(*Event, EventCursor) error) (hasMore bool, err error) {
+	eventstoreEvents, err := q.eventstore.Filter(ctx, subscription.searchQuery(ctx, auditLogRetention).AllowTimeTravel())
+	if err != nil {
+		return false, err
+	}
+	events := q.convertEvents(ctx, eventstoreEvents)
+	settled := time.Now().Add(-eventSubscriptionSettleTime)
+	for _, event := range events {
+		if event.CreationDate.After(settled) {
+			return false, nil
+		}
+		subscription.Cursor = EventCursor(event.Sequence)
+		if !subscription.isPermitted(event) {
+			continue
+		}
+		if err = send(event, subscription.Cursor); err != nil {
+			return false, err
+		}
+	}
+	return len(events) == eventSubscriptionBatchSize, nil
+}
+
+// searchQuery filters the events older than the audit log retention in the query,
+// otherwise the cursor could not pass them
+func (s *EventSubscription) searchQuery(ctx context.Context, auditLogRetention time.Duration) *eventstore.SearchQueryBuilder {
+	var retention time.Time
+	if auditLogRetention > 0 {
+		retention = time.Now().Add(-auditLogRetention)
+	}
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderAsc().
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		Limit(eventSubscriptionBatchSize).
+		ResourceOwner(s.ResourceOwner).
+		AddQuery().
+		AggregateTypes(s.AggregateTypes...).
+		EventTypes(s.EventTypes...).
+		SequenceGreater(uint64(s.Cursor)).
+		CreationDateAfter(retention).
+		Builder()
+}
+
+func (s *EventSubscription) isPermitted(event *Event) bool {
+	if s.PermittedOrgs == nil {
+		return true
+	}
+	for _, orgID := range s.PermittedOrgs {
+		if event.Aggregate.ResourceOwner == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateTypes returns the types to get notified about, which are all types if none are requested
+func (s *EventSubscription) aggregateTypes(es *eventstore.Eventstore) []eventstore.AggregateType {
+	if len(s.AggregateTypes) > 0 {
+		return s.AggregateTypes
+	}
+	types := es.AggregateTypes()
+	aggregateTypes := make([]eventstore.AggregateType, len(types))
+	for i, typ := range types {
+		aggregateTypes[i] = eventstore.AggregateType(typ)
+	}
+	return aggregateTypes
+}
@@ -0,0 +1,142 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	caos_errors "github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func TestEventCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		want    EventCursor
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			cursor: "",
+			want:   0,
+		},
+		{
+			name:   "valid",
+			cursor: EventCursor(42).String(),
+			want:   42,
+		},
+		{
+			name:    "no base64",
+			cursor:  "%%%",
+			wantErr: true,
+		},
+		{
+			name:    "no sequence",
+			cursor:  "YWJj",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEventCursor(tt.cursor)
+			if tt.wantErr {
+				assert.True(t, caos_errors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventSubscription_isPermitted(t *testing.T) {
+	event := &Event{Aggregate: eventstore.Aggregate{ResourceOwner: "org1"}}
+	tests := []struct {
+		name          string
+		permittedOrgs []string
+		want          bool
+	}{
+		{
+			name:          "instance permission",
+			permittedOrgs: nil,
+			want:          true,
+		},
+		{
+			name:          "no org permitted",
+			permittedOrgs: []string{},
+			want:          false,
+		},
+		{
+			name:          "org permitted",
+			permittedOrgs: []string{"org2", "org1"},
+			want:          true,
+		},
+		{
+			name:          "other org permitted",
+			permittedOrgs: []string{"org2"},
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &EventSubscription{PermittedOrgs: tt.permittedOrgs}
+			assert.Equal(t, tt.want, s.isPermitted(event))
+		})
+	}
+}
+
+func TestPermittedOrgsOfMemberships(t *testing.T) {
+	roleMappings := []authz.RoleMapping{
+		{Role: "IAM_OWNER", Permissions: []string{"events.read"}},
+		{Role: "ORG_OWNER", Permissions: []string{"org.read", "events.read"}},
+		{Role: "ORG_USER_MANAGER", Permissions: []string{"org.read", "user.read"}},
+		{Role: "PROJECT_OWNER", Permissions: []string{"events.read"}},
+	}
+	tests := []struct {
+		name             string
+		memberships      []*Membership
+		wantOrgIDs       []string
+		wantInstanceWide bool
+	}{
+		{
+			name: "instance member",
+			memberships: []*Membership{
+				{Roles: []string{"ORG_OWNER"}, Org: &OrgMembership{OrgID: "org1"}},
+				{Roles: []string{"IAM_OWNER"}, IAM: &IAMMembership{IAMID: "instance1"}},
+			},
+			wantInstanceWide: true,
+		},
+		{
+			name: "org scoped caller",
+			memberships: []*Membership{
+				{Roles: []string{"ORG_OWNER"}, Org: &OrgMembership{OrgID: "org1"}},
+				{Roles: []string{"ORG_USER_MANAGER"}, Org: &OrgMembership{OrgID: "org2"}},
+				{Roles: []string{"ORG_USER_MANAGER", "ORG_OWNER"}, Org: &OrgMembership{OrgID: "org3"}},
+			},
+			wantOrgIDs: []string{"org1", "org3"},
+		},
+		{
+			name: "project member",
+			memberships: []*Membership{
+				{Roles: []string{"PROJECT_OWNER"}, ResourceOwner: "org1", Project: &ProjectMembership{ProjectID: "project1"}},
+			},
+			wantOrgIDs: []string{},
+		},
+		{
+			name: "instance member without permission",
+			memberships: []*Membership{
+				{Roles: []string{"ORG_USER_MANAGER"}, IAM: &IAMMembership{IAMID: "instance1"}},
+			},
+			wantOrgIDs: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgIDs, instanceWide := permittedOrgsOfMemberships(tt.memberships, roleMappings, permissionEventsRead)
+			assert.Equal(t, tt.wantInstanceWide, instanceWide)
+			assert.Equal(t, tt.wantOrgIDs, orgIDs)
+		})
+	}
+}
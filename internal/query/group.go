@@ -0,0 +1,223 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupsTable = table{
+		name:          projection.GroupProjectionTable,
+		instanceIDCol: projection.GroupColumnInstanceID,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupColumnID,
+		table: groupsTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupColumnCreationDate,
+		table: groupsTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupColumnChangeDate,
+		table: groupsTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupColumnSequence,
+		table: groupsTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupColumnResourceOwner,
+		table: groupsTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupColumnInstanceID,
+		table: groupsTable,
+	}
+	GroupColumnName = Column{
+		name:           projection.GroupColumnName,
+		table:          groupsTable,
+		isOrderByLower: true,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupColumnDescription,
+		table: groupsTable,
+	}
+	GroupColumnOwnerRemoved = Column{
+		name:  projection.GroupColumnOwnerRemoved,
+		table: groupsTable,
+	}
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+type Group struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Name        string
+	Description string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) GroupByID(ctx context.Context, shouldTriggerBulk bool, id, resourceOwner string) (_ *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		projection.GroupProjection.Trigger(ctx)
+	}
+
+	stmt, scan := prepareGroupQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		GroupColumnID.identifier():            id,
+		GroupColumnResourceOwner.identifier(): resourceOwner,
+		GroupColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		GroupColumnOwnerRemoved.identifier():  false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gq2nf", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries) (groups *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		GroupColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gq3og", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gq4ph", "Errors.Internal")
+	}
+	groups, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	groups.LatestSequence, err = q.latestSequence(ctx, groupsTable)
+	return groups, err
+}
+
+func NewGroupNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareGroupQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier()).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			g := new(Group)
+			err := row.Scan(
+				&g.ID,
+				&g.CreationDate,
+				&g.ChangeDate,
+				&g.ResourceOwner,
+				&g.Sequence,
+				&g.Name,
+				&g.Description,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Gq5qi", "Errors.Group.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Gq6rj", "Errors.Internal")
+			}
+			return g, nil
+		}
+}
+
+func prepareGroupsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			countColumn.identifier()).
+			From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(Group)
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.ResourceOwner,
+					&g.Sequence,
+					&g.Name,
+					&g.Description,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gq7sk", "Errors.Query.CloseRows")
+			}
+
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,268 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupGrantsTable = table{
+		name:          projection.GroupGrantProjectionTable,
+		instanceIDCol: projection.GroupGrantColumnInstanceID,
+	}
+	GroupGrantColumnID = Column{
+		name:  projection.GroupGrantColumnID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantColumnGroupID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantColumnProjectID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectGrantID = Column{
+		name:  projection.GroupGrantColumnProjectGrantID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnRoleKeys = Column{
+		name:  projection.GroupGrantColumnRoleKeys,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantColumnCreationDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantColumnChangeDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantColumnSequence,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantColumnResourceOwner,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantColumnInstanceID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnOwnerRemoved = Column{
+		name:  projection.GroupGrantColumnOwnerRemoved,
+		table: groupGrantsTable,
+	}
+)
+
+type GroupGrants struct {
+	SearchResponse
+	GroupGrants []*GroupGrant
+}
+
+type GroupGrant struct {
+	ID             string
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       database.StringArray
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	ResourceOwner  string
+	Sequence       uint64
+}
+
+type GroupGrantSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantSearchQueries) (grants *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupGrantsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		GroupGrantColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupGrantColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gg2nf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gg3og", "Errors.Internal")
+	}
+	grants, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	grants.LatestSequence, err = q.latestSequence(ctx, groupGrantsTable)
+	return grants, err
+}
+
+// UserGroupGrants returns the grants the user inherits through the membership of groups
+// as user grants, so they can be handled the same way as the grants of the user itself
+func (q *Queries) UserGroupGrants(ctx context.Context, userID string, projectIDs []string) (grants []*UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserGroupGrantsQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		GroupMemberColumnUserID.identifier():       userID,
+		GroupGrantColumnProjectID.identifier():     projectIDs,
+		GroupGrantColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		GroupGrantColumnOwnerRemoved.identifier():  false,
+		GroupMemberColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gg4ph", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gg5qi", "Errors.Internal")
+	}
+	grants, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants {
+		grant.UserID = userID
+	}
+	return grants, nil
+}
+
+func NewGroupGrantGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGroupID, value, TextEquals)
+}
+
+func NewGroupGrantProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, value, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, value, TextEquals)
+}
+
+func (q *GroupGrantSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnID.identifier(),
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnProjectGrantID.identifier(),
+			GroupGrantColumnRoleKeys.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			countColumn.identifier()).
+			From(groupGrantsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				grant := new(GroupGrant)
+				err := rows.Scan(
+					&grant.ID,
+					&grant.GroupID,
+					&grant.ProjectID,
+					&grant.ProjectGrantID,
+					&grant.RoleKeys,
+					&grant.CreationDate,
+					&grant.ChangeDate,
+					&grant.ResourceOwner,
+					&grant.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grants = append(grants, grant)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gg6rj", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				GroupGrants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareUserGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*UserGrant, error)) {
+	return sq.Select(
+			GroupGrantColumnID.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnRoleKeys.identifier(),
+			GroupGrantColumnProjectGrantID.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			OrgColumnName.identifier(),
+			OrgColumnDomain.identifier(),
+			GroupGrantColumnProjectID.identifier()).
+			From(groupGrantsTable.identifier()).
+			Join(join(GroupMemberColumnGroupID, GroupGrantColumnGroupID)).
+			LeftJoin(join(OrgColumnID, GroupGrantColumnResourceOwner) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*UserGrant, error) {
+			grants := make([]*UserGrant, 0)
+			for rows.Next() {
+				grant := &UserGrant{State: domain.UserGrantStateActive}
+				var (
+					orgName   sql.NullString
+					orgDomain sql.NullString
+				)
+				err := rows.Scan(
+					&grant.ID,
+					&grant.CreationDate,
+					&grant.ChangeDate,
+					&grant.Sequence,
+					&grant.Roles,
+					&grant.GrantID,
+					&grant.ResourceOwner,
+					&orgName,
+					&orgDomain,
+					&grant.ProjectID,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grant.OrgName = orgName.String
+				grant.OrgPrimaryDomain = orgDomain.String
+				grants = append(grants, grant)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gg7sk", "Errors.Query.CloseRows")
+			}
+			return grants, nil
+		}
+}
@@ -0,0 +1,209 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareGroupGrantsStmt = `SELECT projections.group_grants.id,` +
+		` projections.group_grants.group_id,` +
+		` projections.group_grants.project_id,` +
+		` projections.group_grants.project_grant_id,` +
+		` projections.group_grants.role_keys,` +
+		` projections.group_grants.creation_date,` +
+		` projections.group_grants.change_date,` +
+		` projections.group_grants.resource_owner,` +
+		` projections.group_grants.sequence,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.group_grants` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupGrantsCols = []string{
+		"id",
+		"group_id",
+		"project_id",
+		"project_grant_id",
+		"role_keys",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"count",
+	}
+	prepareUserGroupGrantsStmt = `SELECT projections.group_grants.id,` +
+		` projections.group_grants.creation_date,` +
+		` projections.group_grants.change_date,` +
+		` projections.group_grants.sequence,` +
+		` projections.group_grants.role_keys,` +
+		` projections.group_grants.project_grant_id,` +
+		` projections.group_grants.resource_owner,` +
+		` projections.orgs1.name,` +
+		` projections.orgs1.primary_domain,` +
+		` projections.group_grants.project_id` +
+		` FROM projections.group_grants` +
+		` JOIN projections.group_members ON projections.group_grants.group_id = projections.group_members.group_id AND projections.group_grants.instance_id = projections.group_members.instance_id` +
+		` LEFT JOIN projections.orgs1 ON projections.group_grants.resource_owner = projections.orgs1.id AND projections.group_grants.instance_id = projections.orgs1.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareUserGroupGrantsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"role_keys",
+		"project_grant_id",
+		"resource_owner",
+		"name",
+		"primary_domain",
+		"project_id",
+	}
+)
+
+func Test_GroupGrantPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupGrantsQuery no result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &GroupGrants{GroupGrants: []*GroupGrant{}},
+		},
+		{
+			name:    "prepareGroupGrantsQuery one result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					prepareGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"grant-id",
+							"group-id",
+							"project-id",
+							"",
+							database.StringArray{"role-key"},
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+						},
+					},
+				),
+			},
+			object: &GroupGrants{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				GroupGrants: []*GroupGrant{
+					{
+						ID:            "grant-id",
+						GroupID:       "group-id",
+						ProjectID:     "project-id",
+						RoleKeys:      database.StringArray{"role-key"},
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211108,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery sql err",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserGroupGrantsQuery one result",
+			prepare: prepareUserGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareUserGroupGrantsStmt),
+					prepareUserGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"grant-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							database.StringArray{"role-key"},
+							"",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+						},
+					},
+				),
+			},
+			object: []*UserGrant{
+				{
+					ID:               "grant-id",
+					CreationDate:     testNow,
+					ChangeDate:       testNow,
+					Sequence:         20211108,
+					Roles:            database.StringArray{"role-key"},
+					State:            domain.UserGrantStateActive,
+					ResourceOwner:    "ro",
+					OrgName:          "org-name",
+					OrgPrimaryDomain: "primary-domain",
+					ProjectID:        "project-id",
+				},
+			},
+		},
+		{
+			name:    "prepareUserGroupGrantsQuery sql err",
+			prepare: prepareUserGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareUserGroupGrantsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
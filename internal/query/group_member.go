@@ -0,0 +1,188 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	groupMembersTable = table{
+		name:          projection.GroupMemberProjectionTable,
+		instanceIDCol: projection.GroupMemberColumnInstanceID,
+	}
+	GroupMemberColumnGroupID = Column{
+		name:  projection.GroupMemberColumnGroupID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnUserID = Column{
+		name:  projection.GroupMemberColumnUserID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnCreationDate = Column{
+		name:  projection.GroupMemberColumnCreationDate,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnChangeDate = Column{
+		name:  projection.GroupMemberColumnChangeDate,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnSequence = Column{
+		name:  projection.GroupMemberColumnSequence,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnResourceOwner = Column{
+		name:  projection.GroupMemberColumnResourceOwner,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnInstanceID = Column{
+		name:  projection.GroupMemberColumnInstanceID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnOwnerRemoved = Column{
+		name:  projection.GroupMemberColumnOwnerRemoved,
+		table: groupMembersTable,
+	}
+)
+
+type GroupMembers struct {
+	SearchResponse
+	GroupMembers []*GroupMember
+}
+
+type GroupMember struct {
+	GroupID       string
+	GroupName     string
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+}
+
+type GroupMemberSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) SearchGroupMembers(ctx context.Context, queries *GroupMemberSearchQueries) (members *GroupMembers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupMembersQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		GroupMemberColumnInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		GroupMemberColumnOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Gm2nf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Gm3og", "Errors.Internal")
+	}
+	members, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	members.LatestSequence, err = q.latestSequence(ctx, groupMembersTable)
+	return members, err
+}
+
+// UserGroupNames returns the names of all groups the user is a member of
+func (q *Queries) UserGroupNames(ctx context.Context, userID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	userIDQuery, err := NewGroupMemberUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := q.SearchGroupMembers(ctx, &GroupMemberSearchQueries{Queries: []SearchQuery{userIDQuery}})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(members.GroupMembers))
+	for i, member := range members.GroupMembers {
+		names[i] = member.GroupName
+	}
+	return names, nil
+}
+
+func NewGroupMemberGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnGroupID, value, TextEquals)
+}
+
+func NewGroupMemberUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnUserID, value, TextEquals)
+}
+
+func NewGroupMemberResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnResourceOwner, value, TextEquals)
+}
+
+func (q *GroupMemberSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareGroupMembersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupMembers, error)) {
+	return sq.Select(
+			GroupMemberColumnGroupID.identifier(),
+			GroupColumnName.identifier(),
+			GroupMemberColumnUserID.identifier(),
+			GroupMemberColumnCreationDate.identifier(),
+			GroupMemberColumnChangeDate.identifier(),
+			GroupMemberColumnResourceOwner.identifier(),
+			GroupMemberColumnSequence.identifier(),
+			countColumn.identifier()).
+			From(groupMembersTable.identifier()).
+			LeftJoin(join(GroupColumnID, GroupMemberColumnGroupID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMembers, error) {
+			members := make([]*GroupMember, 0)
+			var count uint64
+			for rows.Next() {
+				member := new(GroupMember)
+				var groupName sql.NullString
+				err := rows.Scan(
+					&member.GroupID,
+					&groupName,
+					&member.UserID,
+					&member.CreationDate,
+					&member.ChangeDate,
+					&member.ResourceOwner,
+					&member.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				member.GroupName = groupName.String
+				members = append(members, member)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Gm4ph", "Errors.Query.CloseRows")
+			}
+
+			return &GroupMembers{
+				GroupMembers: members,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareGroupMembersStmt = `SELECT projections.group_members.group_id,` +
+		` projections.groups.name,` +
+		` projections.group_members.user_id,` +
+		` projections.group_members.creation_date,` +
+		` projections.group_members.change_date,` +
+		` projections.group_members.resource_owner,` +
+		` projections.group_members.sequence,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.group_members` +
+		` LEFT JOIN projections.groups ON projections.group_members.group_id = projections.groups.id AND projections.group_members.instance_id = projections.groups.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupMembersCols = []string{
+		"group_id",
+		"name",
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"count",
+	}
+)
+
+func Test_GroupMemberPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupMembersQuery no result",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &GroupMembers{GroupMembers: []*GroupMember{}},
+		},
+		{
+			name:    "prepareGroupMembersQuery one result",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					prepareGroupMembersCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							"group-name",
+							"user-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+						},
+					},
+				),
+			},
+			object: &GroupMembers{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				GroupMembers: []*GroupMember{
+					{
+						GroupID:       "group-id",
+						GroupName:     "group-name",
+						UserID:        "user-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211108,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupMembersQuery sql err",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	prepareGroupStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.sequence,` +
+		` projections.groups.name,` +
+		` projections.groups.description` +
+		` FROM projections.groups` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"name",
+		"description",
+	}
+	prepareGroupsStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.sequence,` +
+		` projections.groups.name,` +
+		` projections.groups.description,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareGroupsCols = append(prepareGroupCols, "count")
+)
+
+func Test_GroupPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupQuery no result",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupQuery found",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareGroupStmt),
+					prepareGroupCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211108),
+						"group-name",
+						"group-description",
+					},
+				),
+			},
+			object: &Group{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211108,
+				Name:          "group-name",
+				Description:   "group-description",
+			},
+		},
+		{
+			name:    "prepareGroupQuery sql err",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareGroupsQuery no result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &Groups{Groups: []*Group{}},
+		},
+		{
+			name:    "prepareGroupsQuery one result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					prepareGroupsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+							"group-name",
+							"group-description",
+						},
+					},
+				),
+			},
+			object: &Groups{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Groups: []*Group{
+					{
+						ID:            "id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211108,
+						Name:          "group-name",
+						Description:   "group-description",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupsQuery sql err",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -678,6 +678,10 @@ func NewIDPTemplateOwnerTypeSearchQuery(ownerType domain.IdentityProviderType) (
 	return NewNumberQuery(IDPTemplateOwnerTypeCol, ownerType, NumberEquals)
 }
 
+func NewIDPTemplateTypeSearchQuery(idpType domain.IDPType) (SearchQuery, error) {
+	return NewNumberQuery(IDPTemplateTypeCol, idpType, NumberEquals)
+}
+
 func NewIDPTemplateNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
 	return NewTextQuery(IDPTemplateNameCol, value, method)
 }
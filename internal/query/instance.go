@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	errs "errors"
+	"sort"
 	"strings"
 	"time"
 
@@ -84,6 +85,8 @@ type Instance struct {
 	Domains      []*InstanceDomain
 	host         string
 	csp          csp
+	// shard is the name of the database shard the instance is stored in
+	shard string
 }
 
 type csp struct {
@@ -128,6 +131,10 @@ func (i *Instance) DefaultOrganisationID() string {
 	return i.DefaultOrgID
 }
 
+func (i *Instance) Shard() string {
+	return i.shard
+}
+
 func (i *Instance) SecurityPolicyAllowedOrigins() []string {
 	if !i.csp.enabled {
 		return nil
@@ -160,6 +167,14 @@ func (q *Queries) SearchInstances(ctx context.Context, queries *InstanceSearchQu
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	shards := q.client.ShardNames()
+	if len(shards) == 1 {
+		return q.searchInstances(ctx, queries)
+	}
+	return q.searchInstancesOfShards(ctx, shards, queries)
+}
+
+func (q *Queries) searchInstances(ctx context.Context, queries *InstanceSearchQueries) (instances *Instances, err error) {
 	filter, query, scan := prepareInstancesQuery(ctx, q.client)
 	stmt, args, err := query(queries.toQuery(filter)).ToSql()
 	if err != nil {
@@ -177,6 +192,62 @@ func (q *Queries) SearchInstances(ctx context.Context, queries *InstanceSearchQu
 	return instances, err
 }
 
+// searchInstancesOfShards merges the instances of all shards.
+// Moved instances remain in their previous shard until they are removed,
+// so only the instances of the shard they are routed to are returned.
+// Sorting and paging are applied to the merged instances.
+func (q *Queries) searchInstancesOfShards(ctx context.Context, shards []string, queries *InstanceSearchQueries) (*Instances, error) {
+	routing, err := q.client.InstanceShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shardQueries := &InstanceSearchQueries{Queries: queries.Queries}
+	merged := &Instances{Instances: make([]*Instance, 0)}
+	for _, shard := range shards {
+		instances, err := q.searchInstances(database.WithShard(ctx, shard), shardQueries)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances.Instances {
+			// moving instances are unavailable until they are routed to their new shard
+			if routing[instance.ID].Shard != shard || routing[instance.ID].Moving {
+				continue
+			}
+			instance.shard = shard
+			merged.Instances = append(merged.Instances, instance)
+		}
+	}
+	sortInstances(merged.Instances, queries.SortingColumn, queries.Asc)
+	merged.Count = uint64(len(merged.Instances))
+
+	offset := queries.Offset
+	if offset > merged.Count {
+		offset = merged.Count
+	}
+	merged.Instances = merged.Instances[offset:]
+	if queries.Limit > 0 && queries.Limit < uint64(len(merged.Instances)) {
+		merged.Instances = merged.Instances[:queries.Limit]
+	}
+	return merged, nil
+}
+
+// sortInstances sorts the instances by the sorting column, the id is used by default
+func sortInstances(instances []*Instance, column Column, asc bool) {
+	less := func(a, b *Instance) bool { return a.ID < b.ID }
+	switch column.identifier() {
+	case InstanceColumnName.identifier():
+		less = func(a, b *Instance) bool { return a.Name < b.Name }
+	case InstanceColumnCreationDate.identifier():
+		less = func(a, b *Instance) bool { return a.CreationDate.Before(b.CreationDate) }
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		if column.isZero() || asc {
+			return less(instances[i], instances[j])
+		}
+		return less(instances[j], instances[i])
+	})
+}
+
 func (q *Queries) Instance(ctx context.Context, shouldTriggerBulk bool) (_ *Instance, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -204,10 +275,42 @@ func (q *Queries) InstanceByHost(ctx context.Context, host string) (_ authz.Inst
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	return q.caches.instanceByHost.load("", host, func() (*Instance, error) {
+		return q.instanceByHost(ctx, host)
+	})
+}
+
+// instanceByHost searches the instance of the host in all shards.
+// Moved instances remain in their previous shard until they are removed,
+// so the instance is only returned by the shard it is routed to.
+func (q *Queries) instanceByHost(ctx context.Context, host string) (instance *Instance, err error) {
+	var routed string
+	for _, shard := range q.client.ShardNames() {
+		instance, err = q.instanceByHostOfShard(database.WithShard(ctx, shard), host)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		routed, err = q.client.ShardOf(ctx, instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		if routed != shard {
+			err = errors.ThrowNotFound(nil, "QUERY-Ns8vq", "Errors.IAM.NotFound")
+			continue
+		}
+		instance.shard = shard
+		return instance, nil
+	}
+	return nil, err
+}
+
+func (q *Queries) instanceByHostOfShard(ctx context.Context, host string) (*Instance, error) {
 	stmt, scan := prepareAuthzInstanceQuery(ctx, q.client, host)
-	host = strings.Split(host, ":")[0] //remove possible port
 	query, args, err := stmt.Where(sq.Eq{
-		InstanceDomainDomainCol.identifier(): host,
+		InstanceDomainDomainCol.identifier(): strings.Split(host, ":")[0], //remove possible port
 	}).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-SAfg2", "Errors.Query.SQLStatement")
@@ -220,8 +323,18 @@ func (q *Queries) InstanceByHost(ctx context.Context, host string) (_ authz.Inst
 	return scan(row)
 }
 
+// InstanceByID returns the instance of the context from the shard it is routed to
 func (q *Queries) InstanceByID(ctx context.Context) (_ authz.Instance, err error) {
-	return q.Instance(ctx, true)
+	shard, err := q.client.ShardOf(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	instance, err := q.Instance(database.WithShard(ctx, shard), true)
+	if err != nil {
+		return nil, err
+	}
+	instance.shard = shard
+	return instance, nil
 }
 
 func (q *Queries) GetDefaultLanguage(ctx context.Context) language.Tag {
@@ -181,13 +181,32 @@ func (q *Queries) ActivePublicKeys(ctx context.Context, t time.Time) (_ *PublicK
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	query, scan := preparePublicKeysQuery(ctx, q.client)
 	if t.IsZero() {
 		t = time.Now()
 	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	keys, err := q.caches.publicKeys.load(instanceID, "active", func() (*PublicKeys, error) {
+		return q.activePublicKeys(ctx, instanceID, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the cached keys were active when they were loaded
+	active := &PublicKeys{SearchResponse: keys.SearchResponse}
+	for _, publicKey := range keys.Keys {
+		if publicKey.Expiry().After(t) {
+			active.Keys = append(active.Keys, publicKey)
+		}
+	}
+	active.Count = uint64(len(active.Keys))
+	return active, nil
+}
+
+func (q *Queries) activePublicKeys(ctx context.Context, instanceID string, t time.Time) (*PublicKeys, error) {
+	query, scan := preparePublicKeysQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
-			sq.Eq{KeyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			sq.Eq{KeyColInstanceID.identifier(): instanceID},
 			sq.Gt{KeyPublicColExpiry.identifier(): t},
 		}).ToSql()
 	if err != nil {
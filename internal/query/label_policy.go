@@ -46,6 +46,11 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery(ctx, q.client)
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -56,13 +61,10 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	}
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{LabelPolicyColID.identifier(): orgID},
-				sq.Eq{LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LabelPolicyColID.identifier(): owners},
 			eq,
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(LabelPolicyColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-V22un", "unable to create sql stmt")
@@ -76,23 +78,21 @@ func (q *Queries) PreviewLabelPolicyByOrg(ctx context.Context, orgID string) (_
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	stmt, scan := prepareLabelPolicyQuery(ctx, q.client)
 	query, args, err := stmt.Where(
 		sq.And{
-			sq.Or{
-				sq.Eq{
-					LabelPolicyColID.identifier(): orgID,
-				},
-				sq.Eq{
-					LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LabelPolicyColID.identifier(): owners},
 			sq.Eq{
 				LabelPolicyColState.identifier():      domain.LabelPolicyStatePreview,
 				LabelPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(LabelPolicyColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-AG5eq", "unable to create sql stmt")
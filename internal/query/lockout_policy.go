@@ -25,6 +25,7 @@ type LockoutPolicy struct {
 	State         domain.PolicyState
 
 	MaxPasswordAttempts uint64
+	MaxMFAAttempts      uint64
 	ShowFailures        bool
 
 	IsDefault bool
@@ -67,6 +68,10 @@ var (
 		name:  projection.LockoutPolicyMaxPasswordAttemptsCol,
 		table: lockoutTable,
 	}
+	LockoutColMaxMFAAttempts = Column{
+		name:  projection.LockoutPolicyMaxMFAAttemptsCol,
+		table: lockoutTable,
+	}
 	LockoutColIsDefault = Column{
 		name:  projection.LockoutPolicyIsDefaultCol,
 		table: lockoutTable,
@@ -85,6 +90,11 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.LockoutPolicyProjection.Trigger(ctx)
 	}
@@ -99,12 +109,9 @@ func (q *Queries) LockoutPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LockoutColID.identifier(): orgID},
-				sq.Eq{LockoutColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{LockoutColID.identifier(): owners},
 		}).
-		OrderBy(LockoutColIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(LockoutColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -142,6 +149,7 @@ func prepareLockoutPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 			LockoutColResourceOwner.identifier(),
 			LockoutColShowFailures.identifier(),
 			LockoutColMaxPasswordAttempts.identifier(),
+			LockoutColMaxMFAAttempts.identifier(),
 			LockoutColIsDefault.identifier(),
 			LockoutColState.identifier(),
 		).
@@ -157,6 +165,7 @@ func prepareLockoutPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 				&policy.ResourceOwner,
 				&policy.ShowFailures,
 				&policy.MaxPasswordAttempts,
+				&policy.MaxMFAAttempts,
 				&policy.IsDefault,
 				&policy.State,
 			)
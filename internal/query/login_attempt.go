@@ -0,0 +1,198 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// LoginAttempts are the failed login attempts of an IP address or a user
+type LoginAttempts struct {
+	KeyType     domain.LoginBlockKeyType
+	Key         string
+	Failures    uint64
+	LastFailure time.Time
+}
+
+type LoginBlocks struct {
+	SearchResponse
+	LoginBlocks []*LoginAttempts
+}
+
+type LoginBlockSearchQueries struct {
+	SearchRequest
+	// Since ignores all failed attempts before
+	Since time.Time
+	// MinFailures only returns keys with at least the amount of failed attempts
+	MinFailures uint64
+}
+
+var (
+	loginAttemptTable = table{
+		name:          projection.LoginAttemptTable,
+		instanceIDCol: projection.LoginAttemptInstanceIDCol,
+	}
+	LoginAttemptColInstanceID = Column{
+		name:  projection.LoginAttemptInstanceIDCol,
+		table: loginAttemptTable,
+	}
+	LoginAttemptColKeyType = Column{
+		name:  projection.LoginAttemptKeyTypeCol,
+		table: loginAttemptTable,
+	}
+	LoginAttemptColKey = Column{
+		name:  projection.LoginAttemptKeyCol,
+		table: loginAttemptTable,
+	}
+	LoginAttemptColSequence = Column{
+		name:  projection.LoginAttemptSequenceCol,
+		table: loginAttemptTable,
+	}
+	LoginAttemptColCreationDate = Column{
+		name:  projection.LoginAttemptCreationDateCol,
+		table: loginAttemptTable,
+	}
+)
+
+// LoginAttempts returns the amount of failed login attempts of the key since the provided time
+// and the time of the last one
+func (q *Queries) LoginAttempts(ctx context.Context, shouldTriggerBulk bool, keyType domain.LoginBlockKeyType, key string, since time.Time) (_ *LoginAttempts, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		projection.LoginAttemptProjection.Trigger(ctx)
+	}
+
+	stmt, scan := prepareLoginAttemptsQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{
+				LoginAttemptColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+				LoginAttemptColKeyType.identifier():    keyType,
+				LoginAttemptColKey.identifier():        key,
+			},
+			sq.GtOrEq{
+				LoginAttemptColCreationDate.identifier(): since,
+			},
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lat1a", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	attempts, err := scan(row)
+	if err != nil {
+		return nil, err
+	}
+	attempts.KeyType = keyType
+	attempts.Key = key
+	return attempts, nil
+}
+
+// SearchLoginBlocks returns all IP addresses and users with at least the minimum of failed login attempts
+func (q *Queries) SearchLoginBlocks(ctx context.Context, queries *LoginBlockSearchQueries) (_ *LoginBlocks, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareLoginBlocksQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(
+			sq.And{
+				sq.Eq{
+					LoginAttemptColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+				},
+				sq.GtOrEq{
+					LoginAttemptColCreationDate.identifier(): queries.Since,
+				},
+			}).
+		Having(sq.GtOrEq{"COUNT(" + LoginAttemptColSequence.identifier() + ")": queries.MinFailures}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Lat2b", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lat3c", "Errors.Internal")
+	}
+	blocks, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	blocks.LatestSequence, err = q.latestSequence(ctx, loginAttemptTable)
+	return blocks, err
+}
+
+func prepareLoginAttemptsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*LoginAttempts, error)) {
+	return sq.Select(
+			"COUNT("+LoginAttemptColSequence.identifier()+")",
+			"MAX("+LoginAttemptColCreationDate.identifier()+")",
+		).
+			From(loginAttemptTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*LoginAttempts, error) {
+			attempts := new(LoginAttempts)
+			var lastFailure sql.NullTime
+			err := row.Scan(
+				&attempts.Failures,
+				&lastFailure,
+			)
+			if err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Lat4d", "Errors.Internal")
+			}
+			attempts.LastFailure = lastFailure.Time
+			return attempts, nil
+		}
+}
+
+func prepareLoginBlocksQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*LoginBlocks, error)) {
+	return sq.Select(
+			LoginAttemptColKeyType.identifier(),
+			LoginAttemptColKey.identifier(),
+			"COUNT("+LoginAttemptColSequence.identifier()+")",
+			"MAX("+LoginAttemptColCreationDate.identifier()+")",
+			countColumn.identifier(),
+		).
+			From(loginAttemptTable.identifier()+db.Timetravel(call.Took(ctx))).
+			GroupBy(LoginAttemptColKeyType.identifier(), LoginAttemptColKey.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*LoginBlocks, error) {
+			blocks := make([]*LoginAttempts, 0)
+			var count uint64
+			for rows.Next() {
+				block := new(LoginAttempts)
+				err := rows.Scan(
+					&block.KeyType,
+					&block.Key,
+					&block.Failures,
+					&block.LastFailure,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				blocks = append(blocks, block)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Lat5e", "Errors.Query.CloseRows")
+			}
+
+			return &LoginBlocks{
+				LoginBlocks: blocks,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,184 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareLoginAttemptsStmt = `SELECT COUNT(projections.login_attempts.sequence),` +
+		` MAX(projections.login_attempts.creation_date)` +
+		` FROM projections.login_attempts` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareLoginAttemptsCols = []string{
+		"count",
+		"max",
+	}
+
+	prepareLoginBlocksStmt = `SELECT projections.login_attempts.key_type,` +
+		` projections.login_attempts.key,` +
+		` COUNT(projections.login_attempts.sequence),` +
+		` MAX(projections.login_attempts.creation_date),` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.login_attempts` +
+		` AS OF SYSTEM TIME '-1 ms' ` +
+		` GROUP BY projections.login_attempts.key_type, projections.login_attempts.key`
+	prepareLoginBlocksCols = []string{
+		"key_type",
+		"key",
+		"count",
+		"max",
+		"count",
+	}
+)
+
+func Test_LoginAttemptPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLoginAttemptsQuery no attempts",
+			prepare: prepareLoginAttemptsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareLoginAttemptsStmt),
+					prepareLoginAttemptsCols,
+					[]driver.Value{
+						0,
+						nil,
+					},
+				),
+			},
+			object: &LoginAttempts{
+				Failures:    0,
+				LastFailure: time.Time{},
+			},
+		},
+		{
+			name:    "prepareLoginAttemptsQuery found",
+			prepare: prepareLoginAttemptsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareLoginAttemptsStmt),
+					prepareLoginAttemptsCols,
+					[]driver.Value{
+						3,
+						testNow,
+					},
+				),
+			},
+			object: &LoginAttempts{
+				Failures:    3,
+				LastFailure: testNow,
+			},
+		},
+		{
+			name:    "prepareLoginAttemptsQuery sql err",
+			prepare: prepareLoginAttemptsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareLoginAttemptsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareLoginBlocksQuery no result",
+			prepare: prepareLoginBlocksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareLoginBlocksStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &LoginBlocks{LoginBlocks: []*LoginAttempts{}},
+		},
+		{
+			name:    "prepareLoginBlocksQuery multiple result",
+			prepare: prepareLoginBlocksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareLoginBlocksStmt),
+					prepareLoginBlocksCols,
+					[][]driver.Value{
+						{
+							domain.LoginBlockKeyTypeIP,
+							"192.168.0.1",
+							10,
+							testNow,
+						},
+						{
+							domain.LoginBlockKeyTypeUser,
+							"user-id",
+							5,
+							testNow,
+						},
+					},
+				),
+			},
+			object: &LoginBlocks{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				LoginBlocks: []*LoginAttempts{
+					{
+						KeyType:     domain.LoginBlockKeyTypeIP,
+						Key:         "192.168.0.1",
+						Failures:    10,
+						LastFailure: testNow,
+					},
+					{
+						KeyType:     domain.LoginBlockKeyTypeUser,
+						Key:         "user-id",
+						Failures:    5,
+						LastFailure: testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareLoginBlocksQuery sql err",
+			prepare: prepareLoginBlocksQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareLoginBlocksStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
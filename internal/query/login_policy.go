@@ -35,12 +35,14 @@ type LoginPolicy struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      time.Duration
 	ExternalLoginCheckLifetime time.Duration
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	RiskRules                  domain.RiskRules
 	IDPLinks                   []*IDPLoginPolicyLink
 }
 
@@ -131,6 +133,10 @@ var (
 		name:  projection.DisableLoginWithPhone,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnAllowMagicLink = Column{
+		name:  projection.AllowMagicLinkCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnDefaultRedirectURI = Column{
 		name:  projection.DefaultRedirectURI,
 		table: loginPolicyTable,
@@ -159,12 +165,45 @@ var (
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnRiskEnabled = Column{
+		name:  projection.RiskRulesEnabledCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskNewDevice = Column{
+		name:  projection.RiskRulesNewDeviceCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskNewNetwork = Column{
+		name:  projection.RiskRulesNewNetworkCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskNewCountry = Column{
+		name:  projection.RiskRulesNewCountryCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskImpossibleTravelSpeed = Column{
+		name:  projection.RiskRulesImpossibleTravelSpeedCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskMaxRecentFailures = Column{
+		name:  projection.RiskRulesMaxRecentFailuresCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskRecentFailuresWindow = Column{
+		name:  projection.RiskRulesRecentFailuresWindowCol,
+		table: loginPolicyTable,
+	}
 )
 
 func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (_ *LoginPolicy, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.LoginPolicyProjection.Trigger(ctx)
 	}
@@ -177,11 +216,8 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	stmt, args, err := query.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): owners},
+		}).Limit(1).OrderByClause(policyInheritanceOrder(LoginPolicyColumnOrgID, owners)).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -233,22 +269,20 @@ func (q *Queries) SecondFactorsByOrg(ctx context.Context, orgID string) (_ *Seco
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicy2FAsQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): owners},
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(LoginPolicyColumnOrgID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
@@ -289,22 +323,20 @@ func (q *Queries) MultiFactorsByOrg(ctx context.Context, orgID string) (_ *Multi
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	query, scan := prepareLoginPolicyMFAsQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
 			sq.Eq{
 				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): owners},
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(LoginPolicyColumnOrgID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-B4o7h", "Errors.Query.SQLStatement")
@@ -360,12 +392,20 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LoginPolicyColumnAllowDomainDiscovery.identifier(),
 			LoginPolicyColumnDisableLoginWithEmail.identifier(),
 			LoginPolicyColumnDisableLoginWithPhone.identifier(),
+			LoginPolicyColumnAllowMagicLink.identifier(),
 			LoginPolicyColumnDefaultRedirectURI.identifier(),
 			LoginPolicyColumnPasswordCheckLifetime.identifier(),
 			LoginPolicyColumnExternalLoginCheckLifetime.identifier(),
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnRiskEnabled.identifier(),
+			LoginPolicyColumnRiskNewDevice.identifier(),
+			LoginPolicyColumnRiskNewNetwork.identifier(),
+			LoginPolicyColumnRiskNewCountry.identifier(),
+			LoginPolicyColumnRiskImpossibleTravelSpeed.identifier(),
+			LoginPolicyColumnRiskMaxRecentFailures.identifier(),
+			LoginPolicyColumnRiskRecentFailuresWindow.identifier(),
 		).From(loginPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -390,12 +430,20 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&p.AllowDomainDiscovery,
 					&p.DisableLoginWithEmail,
 					&p.DisableLoginWithPhone,
+					&p.AllowMagicLink,
 					&defaultRedirectURI,
 					&p.PasswordCheckLifetime,
 					&p.ExternalLoginCheckLifetime,
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.RiskRules.Enabled,
+					&p.RiskRules.NewDevice,
+					&p.RiskRules.NewNetwork,
+					&p.RiskRules.NewCountry,
+					&p.RiskRules.ImpossibleTravelSpeed,
+					&p.RiskRules.MaxRecentFailures,
+					&p.RiskRules.RecentFailuresWindow,
 				)
 				if err != nil {
 					return nil, errors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
@@ -15,30 +15,38 @@ import (
 )
 
 var (
-	loginPolicyQuery = `SELECT projections.login_policies4.aggregate_id,` +
-		` projections.login_policies4.creation_date,` +
-		` projections.login_policies4.change_date,` +
-		` projections.login_policies4.sequence,` +
-		` projections.login_policies4.allow_register,` +
-		` projections.login_policies4.allow_username_password,` +
-		` projections.login_policies4.allow_external_idps,` +
-		` projections.login_policies4.force_mfa,` +
-		` projections.login_policies4.second_factors,` +
-		` projections.login_policies4.multi_factors,` +
-		` projections.login_policies4.passwordless_type,` +
-		` projections.login_policies4.is_default,` +
-		` projections.login_policies4.hide_password_reset,` +
-		` projections.login_policies4.ignore_unknown_usernames,` +
-		` projections.login_policies4.allow_domain_discovery,` +
-		` projections.login_policies4.disable_login_with_email,` +
-		` projections.login_policies4.disable_login_with_phone,` +
-		` projections.login_policies4.default_redirect_uri,` +
-		` projections.login_policies4.password_check_lifetime,` +
-		` projections.login_policies4.external_login_check_lifetime,` +
-		` projections.login_policies4.mfa_init_skip_lifetime,` +
-		` projections.login_policies4.second_factor_check_lifetime,` +
-		` projections.login_policies4.multi_factor_check_lifetime` +
-		` FROM projections.login_policies4` +
+	loginPolicyQuery = `SELECT projections.login_policies6.aggregate_id,` +
+		` projections.login_policies6.creation_date,` +
+		` projections.login_policies6.change_date,` +
+		` projections.login_policies6.sequence,` +
+		` projections.login_policies6.allow_register,` +
+		` projections.login_policies6.allow_username_password,` +
+		` projections.login_policies6.allow_external_idps,` +
+		` projections.login_policies6.force_mfa,` +
+		` projections.login_policies6.second_factors,` +
+		` projections.login_policies6.multi_factors,` +
+		` projections.login_policies6.passwordless_type,` +
+		` projections.login_policies6.is_default,` +
+		` projections.login_policies6.hide_password_reset,` +
+		` projections.login_policies6.ignore_unknown_usernames,` +
+		` projections.login_policies6.allow_domain_discovery,` +
+		` projections.login_policies6.disable_login_with_email,` +
+		` projections.login_policies6.disable_login_with_phone,` +
+		` projections.login_policies6.allow_magic_link,` +
+		` projections.login_policies6.default_redirect_uri,` +
+		` projections.login_policies6.password_check_lifetime,` +
+		` projections.login_policies6.external_login_check_lifetime,` +
+		` projections.login_policies6.mfa_init_skip_lifetime,` +
+		` projections.login_policies6.second_factor_check_lifetime,` +
+		` projections.login_policies6.multi_factor_check_lifetime,` +
+		` projections.login_policies6.risk_enabled,` +
+		` projections.login_policies6.risk_new_device,` +
+		` projections.login_policies6.risk_new_network,` +
+		` projections.login_policies6.risk_new_country,` +
+		` projections.login_policies6.risk_impossible_travel_speed,` +
+		` projections.login_policies6.risk_max_recent_failures,` +
+		` projections.login_policies6.risk_recent_failures_window` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	loginPolicyCols = []string{
 		"aggregate_id",
@@ -58,23 +66,31 @@ var (
 		"allow_domain_discovery",
 		"disable_login_with_email",
 		"disable_login_with_phone",
+		"allow_magic_link",
 		"default_redirect_uri",
 		"password_check_lifetime",
 		"external_login_check_lifetime",
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"risk_enabled",
+		"risk_new_device",
+		"risk_new_network",
+		"risk_new_country",
+		"risk_impossible_travel_speed",
+		"risk_max_recent_failures",
+		"risk_recent_failures_window",
 	}
 
-	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies4.second_factors` +
-		` FROM projections.login_policies4` +
+	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies6.second_factors` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicy2FAsCols = []string{
 		"second_factors",
 	}
 
-	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies4.multi_factors` +
-		` FROM projections.login_policies4` +
+	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies6.multi_factors` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicyMFAsCols = []string{
 		"multi_factors",
@@ -135,12 +151,20 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						true,
 						true,
 						true,
+						true,
 						"https://example.com/redirect",
 						time.Hour * 2,
 						time.Hour * 2,
 						time.Hour * 2,
 						time.Hour * 2,
 						time.Hour * 2,
+						true,
+						true,
+						true,
+						true,
+						uint32(1000),
+						uint32(5),
+						time.Hour,
 					},
 				),
 			},
@@ -162,12 +186,22 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				AllowDomainDiscovery:       true,
 				DisableLoginWithEmail:      true,
 				DisableLoginWithPhone:      true,
+				AllowMagicLink:             true,
 				DefaultRedirectURI:         "https://example.com/redirect",
 				PasswordCheckLifetime:      time.Hour * 2,
 				ExternalLoginCheckLifetime: time.Hour * 2,
 				MFAInitSkipLifetime:        time.Hour * 2,
 				SecondFactorCheckLifetime:  time.Hour * 2,
 				MultiFactorCheckLifetime:   time.Hour * 2,
+				RiskRules: domain.RiskRules{
+					Enabled:               true,
+					NewDevice:             true,
+					NewNetwork:            true,
+					NewCountry:            true,
+					ImpossibleTravelSpeed: 1000,
+					MaxRecentFailures:     5,
+					RecentFailuresWindow:  time.Hour,
+				},
 			},
 		},
 		{
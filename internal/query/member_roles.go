@@ -8,19 +8,27 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
-func (q *Queries) GetIAMMemberRoles() []string {
+func (q *Queries) GetIAMMemberRoles(ctx context.Context) ([]string, error) {
+	roleMappings, err := q.memberRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range roleMappings {
 		if strings.HasPrefix(roleMap.Role, "IAM") {
 			roles = append(roles, roleMap.Role)
 		}
 	}
-	return roles
+	return roles, nil
 }
 
-func (q *Queries) GetOrgMemberRoles(isGlobal bool) []string {
+func (q *Queries) GetOrgMemberRoles(ctx context.Context, isGlobal bool) ([]string, error) {
+	roleMappings, err := q.memberRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range roleMappings {
 		if strings.HasPrefix(roleMap.Role, "ORG") {
 			roles = append(roles, roleMap.Role)
 		}
@@ -28,7 +36,7 @@ func (q *Queries) GetOrgMemberRoles(isGlobal bool) []string {
 	if isGlobal {
 		roles = append(roles, domain.RoleSelfManagementGlobal)
 	}
-	return roles
+	return roles, nil
 }
 
 func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
@@ -36,9 +44,13 @@ func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	roleMappings, err := q.memberRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
 	roles := make([]string, 0)
 	defaultOrg := authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range roleMappings {
 		if strings.HasPrefix(roleMap.Role, "PROJECT") && !strings.HasPrefix(roleMap.Role, "PROJECT_GRANT") {
 			if defaultOrg && !strings.HasSuffix(roleMap.Role, "GLOBAL") {
 				continue
@@ -49,12 +61,27 @@ func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
 	return roles, nil
 }
 
-func (q *Queries) GetProjectGrantMemberRoles() []string {
+func (q *Queries) GetProjectGrantMemberRoles(ctx context.Context) ([]string, error) {
+	roleMappings, err := q.memberRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range roleMappings {
 		if strings.HasPrefix(roleMap.Role, "PROJECT_GRANT") {
 			roles = append(roles, roleMap.Role)
 		}
 	}
-	return roles
+	return roles, nil
+}
+
+// memberRoleMappings returns the roles defined in the configuration followed by the custom roles of the instance
+func (q *Queries) memberRoleMappings(ctx context.Context) ([]authz.RoleMapping, error) {
+	customRoles, err := q.CustomRoleMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roleMappings := make([]authz.RoleMapping, 0, len(q.zitadelRoles)+len(customRoles))
+	roleMappings = append(roleMappings, q.zitadelRoles...)
+	return append(roleMappings, customRoles...), nil
 }
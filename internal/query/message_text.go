@@ -31,6 +31,8 @@ type MessageTexts struct {
 	DomainClaimed            MessageText
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
+	PasswordBreached         MessageText
+	MagicLink                MessageText
 }
 
 type MessageText struct {
@@ -335,6 +337,10 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordlessRegistration
 	case domain.PasswordChangeMessageType:
 		return &m.PasswordChange
+	case domain.PasswordBreachedMessageType:
+		return &m.PasswordBreached
+	case domain.MagicLinkMessageType:
+		return &m.MagicLink
 	}
 	return nil
 }
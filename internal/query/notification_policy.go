@@ -80,6 +80,11 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		if err := projection.NotificationPolicyProjection.Trigger(ctx); err != nil {
 			return nil, err
@@ -93,12 +98,9 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{NotificationPolicyColID.identifier(): orgID},
-				sq.Eq{NotificationPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{NotificationPolicyColID.identifier(): owners},
 		}).
-		OrderBy(NotificationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyInheritanceOrder(NotificationPolicyColID, owners)).Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-Xuoapqm", "Errors.Query.SQLStatement")
 	}
@@ -0,0 +1,117 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var (
+	oidcClientRegistrationSettingsTable = table{
+		name:          projection.OIDCClientRegistrationSettingsProjectionTable,
+		instanceIDCol: projection.OIDCClientRegistrationSettingsColumnInstanceID,
+	}
+	OIDCClientRegistrationSettingsColumnAggregateID = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnAggregateID,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnCreationDate = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnCreationDate,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnChangeDate = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnChangeDate,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnResourceOwner = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnResourceOwner,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnInstanceID = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnInstanceID,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnSequence = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnSequence,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnAllowedGrantTypes = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnAllowedGrantTypes,
+		table: oidcClientRegistrationSettingsTable,
+	}
+	OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes = Column{
+		name:  projection.OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes,
+		table: oidcClientRegistrationSettingsTable,
+	}
+)
+
+type OIDCClientRegistrationSettings struct {
+	AggregateID   string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	AllowedGrantTypes          database.EnumArray[domain.OIDCGrantType]
+	AllowedRedirectURIPrefixes database.StringArray
+}
+
+func (q *Queries) OIDCClientRegistrationSettingsByAggID(ctx context.Context, aggregateID string) (_ *OIDCClientRegistrationSettings, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareOIDCClientRegistrationSettingsQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		OIDCClientRegistrationSettingsColumnAggregateID.identifier(): aggregateID,
+		OIDCClientRegistrationSettingsColumnInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lm2fq", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func prepareOIDCClientRegistrationSettingsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*OIDCClientRegistrationSettings, error)) {
+	return sq.Select(
+			OIDCClientRegistrationSettingsColumnAggregateID.identifier(),
+			OIDCClientRegistrationSettingsColumnCreationDate.identifier(),
+			OIDCClientRegistrationSettingsColumnChangeDate.identifier(),
+			OIDCClientRegistrationSettingsColumnResourceOwner.identifier(),
+			OIDCClientRegistrationSettingsColumnSequence.identifier(),
+			OIDCClientRegistrationSettingsColumnAllowedGrantTypes.identifier(),
+			OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes.identifier()).
+			From(oidcClientRegistrationSettingsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*OIDCClientRegistrationSettings, error) {
+			settings := new(OIDCClientRegistrationSettings)
+			err := row.Scan(
+				&settings.AggregateID,
+				&settings.CreationDate,
+				&settings.ChangeDate,
+				&settings.ResourceOwner,
+				&settings.Sequence,
+				&settings.AllowedGrantTypes,
+				&settings.AllowedRedirectURIPrefixes,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Wn2ls", "Errors.OIDCClientRegistration.Settings.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-02nSk", "Errors.Internal")
+			}
+			return settings, nil
+		}
+}
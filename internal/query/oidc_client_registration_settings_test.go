@@ -0,0 +1,117 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	errs "github.com/zitadel/zitadel/internal/errors"
+)
+
+var (
+	prepareOIDCClientRegistrationSettingsStmt = `SELECT projections.oidc_client_registration_settings.aggregate_id,` +
+		` projections.oidc_client_registration_settings.creation_date,` +
+		` projections.oidc_client_registration_settings.change_date,` +
+		` projections.oidc_client_registration_settings.resource_owner,` +
+		` projections.oidc_client_registration_settings.sequence,` +
+		` projections.oidc_client_registration_settings.allowed_grant_types,` +
+		` projections.oidc_client_registration_settings.allowed_redirect_uri_prefixes` +
+		` FROM projections.oidc_client_registration_settings` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareOIDCClientRegistrationSettingsCols = []string{
+		"aggregate_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"allowed_grant_types",
+		"allowed_redirect_uri_prefixes",
+	}
+)
+
+func Test_OIDCClientRegistrationSettingsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOIDCClientRegistrationSettingsQuery no result",
+			prepare: prepareOIDCClientRegistrationSettingsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					prepareOIDCClientRegistrationSettingsStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*OIDCClientRegistrationSettings)(nil),
+		},
+		{
+			name:    "prepareOIDCClientRegistrationSettingsQuery found",
+			prepare: prepareOIDCClientRegistrationSettingsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareOIDCClientRegistrationSettingsStmt),
+					prepareOIDCClientRegistrationSettingsCols,
+					[]driver.Value{
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211108),
+						database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeAuthorizationCode},
+						database.StringArray{"https://partner.example.com/"},
+					},
+				),
+			},
+			object: &OIDCClientRegistrationSettings{
+				AggregateID:                "agg-id",
+				CreationDate:               testNow,
+				ChangeDate:                 testNow,
+				ResourceOwner:              "ro",
+				Sequence:                   20211108,
+				AllowedGrantTypes:          database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeAuthorizationCode},
+				AllowedRedirectURIPrefixes: database.StringArray{"https://partner.example.com/"},
+			},
+		},
+		{
+			name:    "prepareOIDCClientRegistrationSettingsQuery sql err",
+			prepare: prepareOIDCClientRegistrationSettingsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOIDCClientRegistrationSettingsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -57,6 +57,10 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: orgsTable,
 	}
+	OrgColumnParentID = Column{
+		name:  projection.OrgColumnParentID,
+		table: orgsTable,
+	}
 )
 
 type Orgs struct {
@@ -74,6 +78,8 @@ type Org struct {
 
 	Name   string
 	Domain string
+	// ParentOrgID is empty if the organisation is on the top level of the instance
+	ParentOrgID string
 }
 
 type OrgSearchQueries struct {
@@ -97,6 +103,7 @@ func (q *Queries) OrgByID(ctx context.Context, shouldTriggerBulk bool, id string
 		projection.OrgProjection.Trigger(ctx)
 	}
 
+	client := q.readClient(ctx, shouldTriggerBulk, orgsTable)
 	stmt, scan := prepareOrgQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
 		OrgColumnID.identifier():         id,
@@ -106,7 +113,7 @@ func (q *Queries) OrgByID(ctx context.Context, shouldTriggerBulk bool, id string
 		return nil, errors.ThrowInternal(err, "QUERY-AWx52", "Errors.Query.SQLStatement")
 	}
 
-	row := q.client.QueryRowContext(ctx, query, args...)
+	row := client.QueryRowContext(ctx, query, args...)
 	return scan(row)
 }
 
@@ -114,36 +121,42 @@ func (q *Queries) OrgByPrimaryDomain(ctx context.Context, domain string) (_ *Org
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	stmt, scan := prepareOrgQuery(ctx, q.client)
-	query, args, err := stmt.Where(sq.Eq{
-		OrgColumnDomain.identifier():     domain,
-		OrgColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
-		OrgColumnState.identifier():      domain_pkg.OrgStateActive,
-	}).ToSql()
-	if err != nil {
-		return nil, errors.ThrowInternal(err, "QUERY-TYUCE", "Errors.Query.SQLStatement")
-	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return q.caches.orgByDomain.load(instanceID, "primary:"+domain, func() (*Org, error) {
+		stmt, scan := prepareOrgQuery(ctx, q.client)
+		query, args, err := stmt.Where(sq.Eq{
+			OrgColumnDomain.identifier():     domain,
+			OrgColumnInstanceID.identifier(): instanceID,
+			OrgColumnState.identifier():      domain_pkg.OrgStateActive,
+		}).ToSql()
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "QUERY-TYUCE", "Errors.Query.SQLStatement")
+		}
 
-	row := q.client.QueryRowContext(ctx, query, args...)
-	return scan(row)
+		row := q.client.QueryRowContext(ctx, query, args...)
+		return scan(row)
+	})
 }
 
 func (q *Queries) OrgByVerifiedDomain(ctx context.Context, domain string) (_ *Org, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	stmt, scan := prepareOrgWithDomainsQuery(ctx, q.client)
-	query, args, err := stmt.Where(sq.Eq{
-		OrgDomainDomainCol.identifier():     domain,
-		OrgDomainIsVerifiedCol.identifier(): true,
-		OrgColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
-	}).ToSql()
-	if err != nil {
-		return nil, errors.ThrowInternal(err, "QUERY-TYUCE", "Errors.Query.SQLStatement")
-	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return q.caches.orgByDomain.load(instanceID, "verified:"+domain, func() (*Org, error) {
+		stmt, scan := prepareOrgWithDomainsQuery(ctx, q.client)
+		query, args, err := stmt.Where(sq.Eq{
+			OrgDomainDomainCol.identifier():     domain,
+			OrgDomainIsVerifiedCol.identifier(): true,
+			OrgColumnInstanceID.identifier():    instanceID,
+		}).ToSql()
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "QUERY-TYUCE", "Errors.Query.SQLStatement")
+		}
 
-	row := q.client.QueryRowContext(ctx, query, args...)
-	return scan(row)
+		row := q.client.QueryRowContext(ctx, query, args...)
+		return scan(row)
+	})
 }
 
 func (q *Queries) IsOrgUnique(ctx context.Context, name, domain string) (isUnique bool, err error) {
@@ -200,6 +213,7 @@ func (q *Queries) SearchOrgs(ctx context.Context, queries *OrgSearchQueries) (or
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	client := q.readClient(ctx, false, orgsTable)
 	query, scan := prepareOrgsQuery(ctx, q.client)
 	stmt, args, err := queries.toQuery(query).
 		Where(sq.Eq{
@@ -209,7 +223,7 @@ func (q *Queries) SearchOrgs(ctx context.Context, queries *OrgSearchQueries) (or
 		return nil, errors.ThrowInvalidArgument(err, "QUERY-wQ3by", "Errors.Query.InvalidRequest")
 	}
 
-	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	rows, err := client.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-M6mYN", "Errors.Internal")
 	}
@@ -233,6 +247,10 @@ func NewOrgStateSearchQuery(value domain_pkg.OrgState) (SearchQuery, error) {
 	return NewNumberQuery(OrgColumnState, value, NumberEquals)
 }
 
+func NewOrgParentIDSearchQuery(parentOrgID string) (SearchQuery, error) {
+	return NewTextQuery(OrgColumnParentID, parentOrgID, TextEquals)
+}
+
 func NewOrgIDsSearchQuery(ids ...string) (SearchQuery, error) {
 	list := make([]interface{}, len(ids))
 	for i, value := range ids {
@@ -251,6 +269,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 			countColumn.identifier()).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -268,6 +287,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&org.Sequence,
 					&org.Name,
 					&org.Domain,
+					&org.ParentOrgID,
 					&count,
 				)
 				if err != nil {
@@ -299,6 +319,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -313,6 +334,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentOrgID,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -334,6 +356,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier()).
 			LeftJoin(join(OrgDomainOrgIDCol, OrgColumnID) + db.Timetravel(call.Took(ctx))).
@@ -349,6 +372,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentOrgID,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,200 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	domain_pkg "github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// maxOrgHierarchyDepth bounds the walk through the organisation hierarchy
+const maxOrgHierarchyDepth = 32
+
+// OrgAncestorIDs returns the ids of all parent organisations of the organisation,
+// ordered from the direct parent up to the top level organisation.
+// The hierarchy is resolved in a single recursive query.
+func (q *Queries) OrgAncestorIDs(ctx context.Context, orgID string) (ancestorIDs []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareOrgAncestorsQuery(ctx, q.client, orgID, authz.GetInstance(ctx).InstanceID())
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ps9wd", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ps8vc", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+// OrgDescendantIDs returns the ids of all organisations below the organisation,
+// ordered by their distance to it
+func (q *Queries) OrgDescendantIDs(ctx context.Context, orgID string) (descendantIDs []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	parentIDs := []string{orgID}
+	for depth := 0; depth < maxOrgHierarchyDepth && len(parentIDs) > 0; depth++ {
+		childIDs, err := q.orgChildIDs(ctx, parentIDs)
+		if err != nil {
+			return nil, err
+		}
+		parentIDs = make([]string, 0, len(childIDs))
+		for _, childID := range childIDs {
+			if childID == orgID || containsID(descendantIDs, childID) {
+				continue
+			}
+			descendantIDs = append(descendantIDs, childID)
+			parentIDs = append(parentIDs, childID)
+		}
+	}
+	return descendantIDs, nil
+}
+
+// SearchOrgDescendants searches the organisations below the organisation
+func (q *Queries) SearchOrgDescendants(ctx context.Context, orgID string, queries *OrgSearchQueries) (orgs *Orgs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	descendantIDs, err := q.OrgDescendantIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(descendantIDs) == 0 {
+		return &Orgs{Orgs: []*Org{}}, nil
+	}
+	idQuery, err := NewOrgIDsSearchQuery(descendantIDs...)
+	if err != nil {
+		return nil, err
+	}
+	return q.SearchOrgs(ctx, &OrgSearchQueries{
+		SearchRequest: queries.SearchRequest,
+		Queries:       append([]SearchQuery{idQuery}, queries.Queries...),
+	})
+}
+
+func (q *Queries) orgChildIDs(ctx context.Context, parentIDs []string) ([]string, error) {
+	stmt, scan := prepareOrgChildrenQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.And{
+		sq.Eq{
+			OrgColumnParentID.identifier():   parentIDs,
+			OrgColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		},
+		sq.NotEq{
+			OrgColumnState.identifier(): domain_pkg.OrgStateRemoved,
+		},
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cq2nf", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Cq3mg", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+// policyOwnerIDs returns the possible owners of a policy valid for the organisation:
+// the organisation itself, its ancestors and the instance, ordered by precedence
+func (q *Queries) policyOwnerIDs(ctx context.Context, orgID string) ([]string, error) {
+	ancestorIDs, err := q.OrgAncestorIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(ancestorIDs)+2)
+	owners = append(owners, orgID)
+	owners = append(owners, ancestorIDs...)
+	return append(owners, authz.GetInstance(ctx).InstanceID()), nil
+}
+
+// policyInheritanceOrder sorts the policies by the position of their owner in owners,
+// so the first row is the most specific policy
+func policyInheritanceOrder(col Column, owners []string) (string, interface{}) {
+	return "array_position(?::TEXT[], " + col.identifier() + ")", database.StringArray(owners)
+}
+
+func containsID(ids []string, id string) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// prepareOrgAncestorsQuery walks up the hierarchy starting at the parent of the organisation,
+// removed organisations end the walk
+func prepareOrgAncestorsQuery(ctx context.Context, db prepareDatabase, orgID, instanceID string) (sq.SelectBuilder, func(*sql.Rows) ([]string, error)) {
+	return sq.Select(
+			"ancestors.id",
+		).
+			Prefix("WITH RECURSIVE ancestors (id, depth) AS ("+
+				"SELECT "+OrgColumnParentID.identifier()+", 1 FROM "+orgsTable.identifier()+
+				" WHERE "+OrgColumnInstanceID.identifier()+" = ? AND "+OrgColumnID.identifier()+" = ?"+
+				" AND "+OrgColumnState.identifier()+" <> ? AND "+OrgColumnParentID.identifier()+" <> ''"+
+				" UNION ALL "+
+				"SELECT "+OrgColumnParentID.identifier()+", ancestors.depth + 1 FROM "+orgsTable.identifier()+
+				" JOIN ancestors ON "+OrgColumnID.identifier()+" = ancestors.id"+
+				" WHERE "+OrgColumnInstanceID.identifier()+" = ?"+
+				" AND "+OrgColumnState.identifier()+" <> ? AND "+OrgColumnParentID.identifier()+" <> ''"+
+				" AND ancestors.depth < ?"+
+				")",
+				instanceID, orgID, domain_pkg.OrgStateRemoved, instanceID, domain_pkg.OrgStateRemoved, maxOrgHierarchyDepth,
+			).
+			From("ancestors").
+			OrderBy("ancestors.depth").
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]string, error) {
+			ancestorIDs := make([]string, 0)
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id); err != nil {
+					return nil, err
+				}
+				// a cycle in the hierarchy ends the walk
+				if id == orgID || containsID(ancestorIDs, id) {
+					break
+				}
+				ancestorIDs = append(ancestorIDs, id)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ps0fe", "Errors.Query.CloseRows")
+			}
+			return ancestorIDs, nil
+		}
+}
+
+func prepareOrgChildrenQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]string, error)) {
+	return sq.Select(
+			OrgColumnID.identifier(),
+		).
+			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]string, error) {
+			ids := make([]string, 0)
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id); err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Cq4ph", "Errors.Query.CloseRows")
+			}
+			return ids, nil
+		}
+}
@@ -0,0 +1,177 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	errs "errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareOrgAncestorsStmt = `WITH RECURSIVE ancestors (id, depth) AS (` +
+		`SELECT projections.orgs1.parent_org_id, 1 FROM projections.orgs1` +
+		` WHERE projections.orgs1.instance_id = $1 AND projections.orgs1.id = $2` +
+		` AND projections.orgs1.org_state <> $3 AND projections.orgs1.parent_org_id <> ''` +
+		` UNION ALL ` +
+		`SELECT projections.orgs1.parent_org_id, ancestors.depth + 1 FROM projections.orgs1` +
+		` JOIN ancestors ON projections.orgs1.id = ancestors.id` +
+		` WHERE projections.orgs1.instance_id = $4` +
+		` AND projections.orgs1.org_state <> $5 AND projections.orgs1.parent_org_id <> ''` +
+		` AND ancestors.depth < $6` +
+		`) SELECT ancestors.id FROM ancestors ORDER BY ancestors.depth`
+	prepareOrgAncestorsCols = []string{
+		"id",
+	}
+
+	prepareOrgChildrenStmt = `SELECT projections.orgs1.id` +
+		` FROM projections.orgs1` +
+		` AS OF SYSTEM TIME '-1 ms' `
+	prepareOrgChildrenCols = []string{
+		"id",
+	}
+)
+
+func Test_OrgHierarchyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name           string
+		prepare        interface{}
+		additionalArgs []reflect.Value
+		want           want
+		object         interface{}
+	}{
+		{
+			name:           "prepareOrgAncestorsQuery no result",
+			prepare:        prepareOrgAncestorsQuery,
+			additionalArgs: []reflect.Value{reflect.ValueOf("org-id"), reflect.ValueOf("instance-id")},
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgAncestorsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []string{},
+		},
+		{
+			name:           "prepareOrgAncestorsQuery multiple result",
+			prepare:        prepareOrgAncestorsQuery,
+			additionalArgs: []reflect.Value{reflect.ValueOf("org-id"), reflect.ValueOf("instance-id")},
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgAncestorsStmt),
+					prepareOrgAncestorsCols,
+					[][]driver.Value{
+						{
+							"parent-id",
+						},
+						{
+							"grand-parent-id",
+						},
+					},
+				),
+			},
+			object: []string{"parent-id", "grand-parent-id"},
+		},
+		{
+			name:           "prepareOrgAncestorsQuery cycle",
+			prepare:        prepareOrgAncestorsQuery,
+			additionalArgs: []reflect.Value{reflect.ValueOf("org-id"), reflect.ValueOf("instance-id")},
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgAncestorsStmt),
+					prepareOrgAncestorsCols,
+					[][]driver.Value{
+						{
+							"parent-id",
+						},
+						{
+							"org-id",
+						},
+						{
+							"parent-id",
+						},
+					},
+				),
+			},
+			object: []string{"parent-id"},
+		},
+		{
+			name:           "prepareOrgAncestorsQuery sql err",
+			prepare:        prepareOrgAncestorsQuery,
+			additionalArgs: []reflect.Value{reflect.ValueOf("org-id"), reflect.ValueOf("instance-id")},
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgAncestorsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareOrgChildrenQuery no result",
+			prepare: prepareOrgChildrenQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgChildrenStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []string{},
+		},
+		{
+			name:    "prepareOrgChildrenQuery multiple result",
+			prepare: prepareOrgChildrenQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgChildrenStmt),
+					prepareOrgChildrenCols,
+					[][]driver.Value{
+						{
+							"child-1",
+						},
+						{
+							"child-2",
+						},
+					},
+				),
+			},
+			object: []string{"child-1", "child-2"},
+		},
+		{
+			name:    "prepareOrgChildrenQuery sql err",
+			prepare: prepareOrgChildrenQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgChildrenStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, append(defaultPrepareArgs, tt.additionalArgs...)...)
+		})
+	}
+}
@@ -26,6 +26,8 @@ type PasswordAgePolicy struct {
 
 	ExpireWarnDays uint64
 	MaxAgeDays     uint64
+	HistoryCount   uint64
+	MinAgeDays     uint64
 
 	IsDefault bool
 }
@@ -67,6 +69,14 @@ var (
 		name:  projection.AgePolicyMaxAgeDaysCol,
 		table: passwordAgeTable,
 	}
+	PasswordAgeColHistoryCount = Column{
+		name:  projection.AgePolicyHistoryCountCol,
+		table: passwordAgeTable,
+	}
+	PasswordAgeColMinAge = Column{
+		name:  projection.AgePolicyMinAgeDaysCol,
+		table: passwordAgeTable,
+	}
 	PasswordAgeColIsDefault = Column{
 		name:  projection.AgePolicyIsDefaultCol,
 		table: passwordAgeTable,
@@ -85,6 +95,11 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.PasswordAgeProjection.Trigger(ctx)
 	}
@@ -96,12 +111,9 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordAgeColID.identifier(): orgID},
-				sq.Eq{PasswordAgeColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PasswordAgeColID.identifier(): owners},
 		}).
-		OrderBy(PasswordAgeColIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(PasswordAgeColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -142,6 +154,8 @@ func preparePasswordAgePolicyQuery(ctx context.Context, db prepareDatabase) (sq.
 			PasswordAgeColResourceOwner.identifier(),
 			PasswordAgeColWarnDays.identifier(),
 			PasswordAgeColMaxAge.identifier(),
+			PasswordAgeColHistoryCount.identifier(),
+			PasswordAgeColMinAge.identifier(),
 			PasswordAgeColIsDefault.identifier(),
 			PasswordAgeColState.identifier(),
 		).
@@ -157,6 +171,8 @@ func preparePasswordAgePolicyQuery(ctx context.Context, db prepareDatabase) (sq.
 				&policy.ResourceOwner,
 				&policy.ExpireWarnDays,
 				&policy.MaxAgeDays,
+				&policy.HistoryCount,
+				&policy.MinAgeDays,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -29,6 +29,8 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	// CheckBreached rejects passwords found in known data breaches
+	CheckBreached bool
 
 	IsDefault bool
 }
@@ -37,6 +39,11 @@ func (q *Queries) PasswordComplexityPolicyByOrg(ctx context.Context, shouldTrigg
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.PasswordComplexityProjection.Trigger(ctx)
 	}
@@ -48,12 +55,9 @@ func (q *Queries) PasswordComplexityPolicyByOrg(ctx context.Context, shouldTrigg
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordComplexityColID.identifier(): orgID},
-				sq.Eq{PasswordComplexityColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PasswordComplexityColID.identifier(): owners},
 		}).
-		OrderBy(PasswordComplexityColIsDefault.identifier()).
+		OrderByClause(policyInheritanceOrder(PasswordComplexityColID, owners)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-lDnrk", "Errors.Query.SQLStatement")
@@ -135,6 +139,10 @@ var (
 		name:  projection.ComplexityPolicyHasSymbolCol,
 		table: passwordComplexityTable,
 	}
+	PasswordComplexityColCheckBreached = Column{
+		name:  projection.ComplexityPolicyCheckBreachedCol,
+		table: passwordComplexityTable,
+	}
 	PasswordComplexityColIsDefault = Column{
 		name:  projection.ComplexityPolicyIsDefaultCol,
 		table: passwordComplexityTable,
@@ -161,6 +169,7 @@ func preparePasswordComplexityPolicyQuery(ctx context.Context, db prepareDatabas
 			PasswordComplexityColHasUpperCase.identifier(),
 			PasswordComplexityColHasNumber.identifier(),
 			PasswordComplexityColHasSymbol.identifier(),
+			PasswordComplexityColCheckBreached.identifier(),
 			PasswordComplexityColIsDefault.identifier(),
 			PasswordComplexityColState.identifier(),
 		).
@@ -179,6 +188,7 @@ func preparePasswordComplexityPolicyQuery(ctx context.Context, db prepareDatabas
 				&policy.HasUppercase,
 				&policy.HasNumber,
 				&policy.HasSymbol,
+				&policy.CheckBreached,
 				&policy.IsDefault,
 				&policy.State,
 			)
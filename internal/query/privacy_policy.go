@@ -24,10 +24,11 @@ type PrivacyPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	TOSLink      string
-	PrivacyLink  string
-	HelpLink     string
-	SupportEmail domain.EmailAddress
+	TOSLink         string
+	PrivacyLink     string
+	HelpLink        string
+	SupportEmail    domain.EmailAddress
+	AllowSelfDelete bool
 
 	IsDefault bool
 }
@@ -77,6 +78,10 @@ var (
 		name:  projection.PrivacyPolicySupportEmailCol,
 		table: privacyTable,
 	}
+	PrivacyColAllowSelfDelete = Column{
+		name:  projection.PrivacyPolicyAllowSelfDeleteCol,
+		table: privacyTable,
+	}
 	PrivacyColIsDefault = Column{
 		name:  projection.PrivacyPolicyIsDefaultCol,
 		table: privacyTable,
@@ -95,6 +100,11 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	owners, err := q.policyOwnerIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldTriggerBulk {
 		projection.PrivacyPolicyProjection.Trigger(ctx)
 	}
@@ -106,12 +116,9 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PrivacyColID.identifier(): orgID},
-				sq.Eq{PrivacyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PrivacyColID.identifier(): owners},
 		}).
-		OrderBy(PrivacyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyInheritanceOrder(PrivacyColID, owners)).Limit(1).ToSql()
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-UXuPI", "Errors.Query.SQLStatement")
 	}
@@ -154,6 +161,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 			PrivacyColTOSLink.identifier(),
 			PrivacyColHelpLink.identifier(),
 			PrivacyColSupportEmail.identifier(),
+			PrivacyColAllowSelfDelete.identifier(),
 			PrivacyColIsDefault.identifier(),
 			PrivacyColState.identifier(),
 		).
@@ -171,6 +179,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 				&policy.TOSLink,
 				&policy.HelpLink,
 				&policy.SupportEmail,
+				&policy.AllowSelfDelete,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -186,10 +195,11 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 
 func (p *PrivacyPolicy) ToDomain() *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		TOSLink:      p.TOSLink,
-		PrivacyLink:  p.PrivacyLink,
-		HelpLink:     p.HelpLink,
-		SupportEmail: p.SupportEmail,
-		Default:      p.IsDefault,
+		TOSLink:         p.TOSLink,
+		PrivacyLink:     p.PrivacyLink,
+		HelpLink:        p.HelpLink,
+		SupportEmail:    p.SupportEmail,
+		AllowSelfDelete: p.AllowSelfDelete,
+		Default:         p.IsDefault,
 	}
 }
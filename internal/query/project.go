@@ -108,6 +108,7 @@ func (q *Queries) ProjectByID(ctx context.Context, shouldTriggerBulk bool, id st
 		projection.ProjectProjection.Trigger(ctx)
 	}
 
+	client := q.readClient(ctx, shouldTriggerBulk, projectsTable)
 	stmt, scan := prepareProjectQuery(ctx, q.client)
 	eq := sq.Eq{
 		ProjectColumnID.identifier():         id,
@@ -121,7 +122,7 @@ func (q *Queries) ProjectByID(ctx context.Context, shouldTriggerBulk bool, id st
 		return nil, errors.ThrowInternal(err, "QUERY-2m00Q", "Errors.Query.SQLStatment")
 	}
 
-	row := q.client.QueryRowContext(ctx, query, args...)
+	row := client.QueryRowContext(ctx, query, args...)
 	return scan(row)
 }
 
@@ -129,6 +130,7 @@ func (q *Queries) SearchProjects(ctx context.Context, queries *ProjectSearchQuer
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	client := q.readClient(ctx, false, projectsTable)
 	query, scan := prepareProjectsQuery(ctx, q.client)
 	eq := sq.Eq{ProjectColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
@@ -139,7 +141,7 @@ func (q *Queries) SearchProjects(ctx context.Context, queries *ProjectSearchQuer
 		return nil, errors.ThrowInvalidArgument(err, "QUERY-fn9ew", "Errors.Query.InvalidRequest")
 	}
 
-	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	rows, err := client.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-2j00f", "Errors.Internal")
 	}
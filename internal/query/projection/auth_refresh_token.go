@@ -0,0 +1,215 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AuthRefreshTokenProjectionTable = "projections.auth_refresh_tokens"
+
+	AuthRefreshTokenColumnID                    = "id"
+	AuthRefreshTokenColumnCreationDate          = "creation_date"
+	AuthRefreshTokenColumnChangeDate            = "change_date"
+	AuthRefreshTokenColumnSequence              = "sequence"
+	AuthRefreshTokenColumnResourceOwner         = "resource_owner"
+	AuthRefreshTokenColumnInstanceID            = "instance_id"
+	AuthRefreshTokenColumnToken                 = "token"
+	AuthRefreshTokenColumnUserID                = "user_id"
+	AuthRefreshTokenColumnClientID              = "client_id"
+	AuthRefreshTokenColumnUserAgentID           = "user_agent_id"
+	AuthRefreshTokenColumnAudience              = "audience"
+	AuthRefreshTokenColumnScopes                = "scopes"
+	AuthRefreshTokenColumnAuthMethodsReferences = "amr"
+	AuthRefreshTokenColumnAuthTime              = "auth_time"
+	AuthRefreshTokenColumnIdleExpiration        = "idle_expiration"
+	AuthRefreshTokenColumnExpiration            = "expiration"
+)
+
+type authRefreshTokenProjection struct {
+	crdb.StatementHandler
+}
+
+func newAuthRefreshTokenProjection(ctx context.Context, config crdb.StatementHandlerConfig) *authRefreshTokenProjection {
+	p := new(authRefreshTokenProjection)
+	config.ProjectionName = AuthRefreshTokenProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(AuthRefreshTokenColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthRefreshTokenColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthRefreshTokenColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(AuthRefreshTokenColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnToken, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnClientID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthRefreshTokenColumnUserAgentID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthRefreshTokenColumnAudience, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthRefreshTokenColumnScopes, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthRefreshTokenColumnAuthMethodsReferences, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthRefreshTokenColumnAuthTime, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthRefreshTokenColumnIdleExpiration, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthRefreshTokenColumnExpiration, crdb.ColumnTypeTimestamp),
+		},
+			crdb.NewPrimaryKey(AuthRefreshTokenColumnInstanceID, AuthRefreshTokenColumnID),
+			crdb.WithIndex(crdb.NewIndex("user_agent", []string{AuthRefreshTokenColumnUserID, AuthRefreshTokenColumnUserAgentID})),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{AuthRefreshTokenColumnResourceOwner})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *authRefreshTokenProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanRefreshTokenAddedType,
+					Reduce: p.reduceRefreshTokenAdded,
+				},
+				{
+					Event:  user.HumanRefreshTokenRenewedType,
+					Reduce: p.reduceRefreshTokenRenewed,
+				},
+				{
+					Event:  user.HumanRefreshTokenRemovedType,
+					Reduce: p.reduceRefreshTokenRemoved,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: p.reduceUserRefreshTokensRemoved,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceUserRefreshTokensRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRefreshTokensRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AuthRefreshTokenColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *authRefreshTokenProjection) reduceRefreshTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Jq5rS", "reduce.wrong.event.type %s", user.HumanRefreshTokenAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthRefreshTokenColumnID, e.TokenID),
+			handler.NewCol(AuthRefreshTokenColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AuthRefreshTokenColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthRefreshTokenColumnSequence, e.Sequence()),
+			handler.NewCol(AuthRefreshTokenColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AuthRefreshTokenColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AuthRefreshTokenColumnToken, e.TokenID),
+			handler.NewCol(AuthRefreshTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCol(AuthRefreshTokenColumnClientID, e.ClientID),
+			handler.NewCol(AuthRefreshTokenColumnUserAgentID, e.UserAgentID),
+			handler.NewCol(AuthRefreshTokenColumnAudience, database.StringArray(e.Audience)),
+			handler.NewCol(AuthRefreshTokenColumnScopes, database.StringArray(e.Scopes)),
+			handler.NewCol(AuthRefreshTokenColumnAuthMethodsReferences, database.StringArray(e.AuthMethodsReferences)),
+			handler.NewCol(AuthRefreshTokenColumnAuthTime, e.AuthTime),
+			handler.NewCol(AuthRefreshTokenColumnIdleExpiration, e.CreationDate().Add(e.IdleExpiration)),
+			handler.NewCol(AuthRefreshTokenColumnExpiration, e.CreationDate().Add(e.Expiration)),
+		},
+	), nil
+}
+
+func (p *authRefreshTokenProjection) reduceRefreshTokenRenewed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenRenewedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Vr8mK", "reduce.wrong.event.type %s", user.HumanRefreshTokenRenewedType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthRefreshTokenColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthRefreshTokenColumnSequence, e.Sequence()),
+			handler.NewCol(AuthRefreshTokenColumnToken, e.RefreshToken),
+			handler.NewCol(AuthRefreshTokenColumnIdleExpiration, e.CreationDate().Add(e.IdleExpiration)),
+		},
+		[]handler.Condition{
+			handler.NewCond(AuthRefreshTokenColumnID, e.TokenID),
+			handler.NewCond(AuthRefreshTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authRefreshTokenProjection) reduceRefreshTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Bz6eP", "reduce.wrong.event.type %s", user.HumanRefreshTokenRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthRefreshTokenColumnID, e.TokenID),
+			handler.NewCond(AuthRefreshTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authRefreshTokenProjection) reduceUserRefreshTokensRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.UserLockedEvent, *user.UserDeactivatedEvent, *user.UserRemovedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Yw3nF", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserLockedType, user.UserDeactivatedType, user.UserRemovedType})
+	}
+	return crdb.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(AuthRefreshTokenColumnUserID, event.Aggregate().ID),
+			handler.NewCond(AuthRefreshTokenColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authRefreshTokenProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Qa4xM", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthRefreshTokenColumnResourceOwner, e.Aggregate().ID),
+			handler.NewCond(AuthRefreshTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
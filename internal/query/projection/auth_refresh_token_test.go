@@ -0,0 +1,220 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestAuthRefreshTokenProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRefreshTokenAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanRefreshTokenAddedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id", "clientId": "client-id", "userAgentId": "agent-id", "audience": ["client-id"], "scopes": ["openid"], "authMethodReferences": ["password"], "authTime": "2022-01-01T00:00:00Z", "idleExpiration": 3600000000000, "expiration": 86400000000000}`),
+				), user.HumanRefreshTokenAddedEventMapper),
+			},
+			reduce: (&authRefreshTokenProjection{}).reduceRefreshTokenAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_refresh_tokens (id, creation_date, change_date, sequence, resource_owner, instance_id, token, user_id, client_id, user_agent_id, audience, scopes, amr, auth_time, idle_expiration, expiration) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
+							expectedArgs: []interface{}{
+								"token-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"token-id",
+								"agg-id",
+								"client-id",
+								"agent-id",
+								database.StringArray{"client-id"},
+								database.StringArray{"openid"},
+								database.StringArray{"password"},
+								time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+								anyArg{},
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRefreshTokenRenewed",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanRefreshTokenRenewedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id", "refreshToken": "refresh-token", "idleExpiration": 3600000000000}`),
+				), user.HumanRefreshTokenRenewedEventEventMapper),
+			},
+			reduce: (&authRefreshTokenProjection{}).reduceRefreshTokenRenewed,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.auth_refresh_tokens SET (change_date, sequence, token, idle_expiration) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"refresh-token",
+								anyArg{},
+								"token-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRefreshTokenRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanRefreshTokenRemovedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id"}`),
+				), user.HumanRefreshTokenRemovedEventEventMapper),
+			},
+			reduce: (&authRefreshTokenProjection{}).reduceRefreshTokenRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_refresh_tokens WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"token-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRefreshTokensRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserLockedType),
+					user.AggregateType,
+					nil,
+				), user.UserLockedEventMapper),
+			},
+			reduce: (&authRefreshTokenProjection{}).reduceUserRefreshTokensRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_refresh_tokens WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&authRefreshTokenProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_refresh_tokens WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(AuthRefreshTokenColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_refresh_tokens WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AuthRefreshTokenProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AuthTokenProjectionTable = "projections.auth_tokens"
+
+	AuthTokenColumnID                = "id"
+	AuthTokenColumnCreationDate      = "creation_date"
+	AuthTokenColumnChangeDate        = "change_date"
+	AuthTokenColumnSequence          = "sequence"
+	AuthTokenColumnResourceOwner     = "resource_owner"
+	AuthTokenColumnInstanceID        = "instance_id"
+	AuthTokenColumnUserID            = "user_id"
+	AuthTokenColumnApplicationID     = "application_id"
+	AuthTokenColumnUserAgentID       = "user_agent_id"
+	AuthTokenColumnExpiration        = "expiration"
+	AuthTokenColumnScopes            = "scopes"
+	AuthTokenColumnAudience          = "audience"
+	AuthTokenColumnPreferredLanguage = "preferred_language"
+	AuthTokenColumnRefreshTokenID    = "refresh_token_id"
+	AuthTokenColumnIsPAT             = "is_pat"
+)
+
+type authTokenProjection struct {
+	crdb.StatementHandler
+}
+
+func newAuthTokenProjection(ctx context.Context, config crdb.StatementHandlerConfig) *authTokenProjection {
+	p := new(authTokenProjection)
+	config.ProjectionName = AuthTokenProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(AuthTokenColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthTokenColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthTokenColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthTokenColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(AuthTokenColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthTokenColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthTokenColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthTokenColumnApplicationID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnUserAgentID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnExpiration, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(AuthTokenColumnScopes, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnAudience, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnPreferredLanguage, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnRefreshTokenID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthTokenColumnIsPAT, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(AuthTokenColumnInstanceID, AuthTokenColumnID),
+			crdb.WithIndex(crdb.NewIndex("user_agent", []string{AuthTokenColumnUserID, AuthTokenColumnUserAgentID})),
+			crdb.WithIndex(crdb.NewIndex("refresh_token", []string{AuthTokenColumnRefreshTokenID})),
+			crdb.WithIndex(crdb.NewIndex("application", []string{AuthTokenColumnApplicationID})),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{AuthTokenColumnResourceOwner})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *authTokenProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserTokenAddedType,
+					Reduce: p.reduceTokenAdded,
+				},
+				{
+					Event:  user.PersonalAccessTokenAddedType,
+					Reduce: p.reducePersonalAccessTokenAdded,
+				},
+				{
+					Event:  user.UserV1ProfileChangedType,
+					Reduce: p.reduceProfileChanged,
+				},
+				{
+					Event:  user.HumanProfileChangedType,
+					Reduce: p.reduceProfileChanged,
+				},
+				{
+					Event:  user.UserV1SignedOutType,
+					Reduce: p.reduceSignedOut,
+				},
+				{
+					Event:  user.HumanSignedOutType,
+					Reduce: p.reduceSignedOut,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: p.reduceUserTokensRemoved,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceUserTokensRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserTokensRemoved,
+				},
+				{
+					Event:  user.UserTokenRemovedType,
+					Reduce: p.reduceTokenRemoved,
+				},
+				{
+					Event:  user.PersonalAccessTokenRemovedType,
+					Reduce: p.reduceTokenRemoved,
+				},
+				{
+					Event:  user.HumanRefreshTokenRemovedType,
+					Reduce: p.reduceRefreshTokenRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationDeactivatedType,
+					Reduce: p.reduceApplicationTokensRemoved,
+				},
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceApplicationTokensRemoved,
+				},
+				{
+					Event:  project.ProjectDeactivatedType,
+					Reduce: p.reduceApplicationTokensRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceApplicationTokensRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AuthTokenColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *authTokenProjection) reduceTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserTokenAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Pq2vL", "reduce.wrong.event.type %s", user.UserTokenAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthTokenColumnID, e.TokenID),
+			handler.NewCol(AuthTokenColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AuthTokenColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthTokenColumnSequence, e.Sequence()),
+			handler.NewCol(AuthTokenColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AuthTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCol(AuthTokenColumnApplicationID, e.ApplicationID),
+			handler.NewCol(AuthTokenColumnUserAgentID, e.UserAgentID),
+			handler.NewCol(AuthTokenColumnExpiration, e.Expiration),
+			handler.NewCol(AuthTokenColumnScopes, database.StringArray(e.Scopes)),
+			handler.NewCol(AuthTokenColumnAudience, database.StringArray(e.Audience)),
+			handler.NewCol(AuthTokenColumnPreferredLanguage, e.PreferredLanguage),
+			handler.NewCol(AuthTokenColumnRefreshTokenID, e.RefreshTokenID),
+			handler.NewCol(AuthTokenColumnIsPAT, false),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reducePersonalAccessTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.PersonalAccessTokenAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Xk8qT", "reduce.wrong.event.type %s", user.PersonalAccessTokenAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthTokenColumnID, e.TokenID),
+			handler.NewCol(AuthTokenColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AuthTokenColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthTokenColumnSequence, e.Sequence()),
+			handler.NewCol(AuthTokenColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AuthTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCol(AuthTokenColumnExpiration, e.Expiration),
+			handler.NewCol(AuthTokenColumnScopes, database.StringArray(e.Scopes)),
+			handler.NewCol(AuthTokenColumnIsPAT, true),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceProfileChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanProfileChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ld9zR", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserV1ProfileChangedType, user.HumanProfileChangedType})
+	}
+	if e.PreferredLanguage == nil {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthTokenColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthTokenColumnSequence, e.Sequence()),
+			handler.NewCol(AuthTokenColumnPreferredLanguage, e.PreferredLanguage.String()),
+		},
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCond(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceSignedOut(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wm4sC", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserV1SignedOutType, user.HumanSignedOutType})
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnUserAgentID, e.UserAgentID),
+			handler.NewCond(AuthTokenColumnUserID, e.Aggregate().ID),
+			handler.NewCond(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceUserTokensRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.UserLockedEvent, *user.UserDeactivatedEvent, *user.UserRemovedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Hs7yV", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserLockedType, user.UserDeactivatedType, user.UserRemovedType})
+	}
+	return crdb.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnUserID, event.Aggregate().ID),
+			handler.NewCond(AuthTokenColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var tokenID string
+	switch e := event.(type) {
+	case *user.UserTokenRemovedEvent:
+		tokenID = e.TokenID
+	case *user.PersonalAccessTokenRemovedEvent:
+		tokenID = e.TokenID
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Nb5tE", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserTokenRemovedType, user.PersonalAccessTokenRemovedType})
+	}
+	return crdb.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnID, tokenID),
+			handler.NewCond(AuthTokenColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceRefreshTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gv3jD", "reduce.wrong.event.type %s", user.HumanRefreshTokenRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnRefreshTokenID, e.TokenID),
+			handler.NewCond(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceApplicationTokensRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var appID string
+	switch e := event.(type) {
+	case *project.ApplicationDeactivatedEvent:
+		appID = e.AppID
+	case *project.ApplicationRemovedEvent:
+		appID = e.AppID
+	case *project.ProjectDeactivatedEvent, *project.ProjectRemovedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Fc2kU", "reduce.wrong.event.type %v", []eventstore.EventType{project.ApplicationDeactivatedType, project.ApplicationRemovedType, project.ProjectDeactivatedType, project.ProjectRemovedType})
+	}
+	clientIDs, err := getClientIDsOfProject(authz.WithInstanceID(context.Background(), event.Aggregate().InstanceID), p.Eventstore, event.Aggregate().InstanceID, event.Aggregate().ID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if len(clientIDs) == 0 {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	return crdb.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			crdb.NewOneOfTextCond(AuthTokenColumnApplicationID, clientIDs),
+			handler.NewCond(AuthTokenColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authTokenProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ut6wA", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	// deletes all tokens including PATs, which is expected for now
+	// if there is an undo of the org deletion in the future,
+	// we will need to have a look on how to handle the deleted PATs
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthTokenColumnResourceOwner, e.Aggregate().ID),
+			handler.NewCond(AuthTokenColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// getClientIDsOfProject returns the client ids of the applications of the project
+// if appID is not empty only the client id of this application is returned
+func getClientIDsOfProject(ctx context.Context, es *eventstore.Eventstore, instanceID, projectID, appID string) ([]string, error) {
+	events, err := es.Filter(
+		ctx,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(instanceID).
+			AddQuery().
+			AggregateTypes(project.AggregateType).
+			AggregateIDs(projectID).
+			EventTypes(project.OIDCConfigAddedType, project.APIConfigAddedType).
+			Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	clientIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.OIDCConfigAddedEvent:
+			if appID == "" || e.AppID == appID {
+				clientIDs = append(clientIDs, e.ClientID)
+			}
+		case *project.APIConfigAddedEvent:
+			if appID == "" || e.AppID == appID {
+				clientIDs = append(clientIDs, e.ClientID)
+			}
+		}
+	}
+	return clientIDs, nil
+}
@@ -0,0 +1,327 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestAuthTokenProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceTokenAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserTokenAddedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id", "applicationId": "client-id", "userAgentId": "agent-id", "refreshTokenID": "refresh-id", "audience": ["client-id"], "scopes": ["openid"], "expiration": "9999-12-31T23:59:59Z", "preferredLanguage": "de"}`),
+				), user.UserTokenAddedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceTokenAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_tokens (id, creation_date, change_date, sequence, resource_owner, instance_id, user_id, application_id, user_agent_id, expiration, scopes, audience, preferred_language, refresh_token_id, is_pat) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedArgs: []interface{}{
+								"token-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								"client-id",
+								"agent-id",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+								database.StringArray{"openid"},
+								database.StringArray{"client-id"},
+								"de",
+								"refresh-id",
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePersonalAccessTokenAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.PersonalAccessTokenAddedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id", "expiration": "9999-12-31T23:59:59Z", "scopes": ["openid"]}`),
+				), user.PersonalAccessTokenAddedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reducePersonalAccessTokenAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_tokens (id, creation_date, change_date, sequence, resource_owner, instance_id, user_id, expiration, scopes, is_pat) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"token-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+								database.StringArray{"openid"},
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProfileChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanProfileChangedType),
+					user.AggregateType,
+					[]byte(`{"preferredLanguage": "de"}`),
+				), user.HumanProfileChangedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceProfileChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.auth_tokens SET (change_date, sequence, preferred_language) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"de",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProfileChanged no language",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanProfileChangedType),
+					user.AggregateType,
+					[]byte(`{"firstName": "first-name"}`),
+				), user.HumanProfileChangedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceProfileChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceSignedOut",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanSignedOutType),
+					user.AggregateType,
+					[]byte(`{"userAgentID": "agent-id"}`),
+				), user.HumanSignedOutEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceSignedOut,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (user_agent_id = $1) AND (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agent-id",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserTokensRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserRemovedType),
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceUserTokensRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTokenRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserTokenRemovedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "token-id"}`),
+				), user.UserTokenRemovedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceTokenRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"token-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRefreshTokenRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanRefreshTokenRemovedType),
+					user.AggregateType,
+					[]byte(`{"tokenId": "refresh-id"}`),
+				), user.HumanRefreshTokenRemovedEventEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceRefreshTokenRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("user"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (refresh_token_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"refresh-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&authTokenProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(AuthTokenColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.auth_tokens WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AuthTokenProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,508 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	org_model "github.com/zitadel/zitadel/internal/org/model"
+	org_es_model "github.com/zitadel/zitadel/internal/org/repository/eventsourcing/model"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	usr_view "github.com/zitadel/zitadel/internal/user/repository/view"
+	view_model "github.com/zitadel/zitadel/internal/user/repository/view/model"
+)
+
+const (
+	AuthUserProjectionTable = "projections.auth_users"
+
+	AuthUserColumnID                       = "id"
+	AuthUserColumnCreationDate             = "creation_date"
+	AuthUserColumnChangeDate               = "change_date"
+	AuthUserColumnResourceOwner            = "resource_owner"
+	AuthUserColumnState                    = "user_state"
+	AuthUserColumnPasswordSet              = "password_set"
+	AuthUserColumnPasswordChangeRequired   = "password_change_required"
+	AuthUserColumnPasswordChange           = "password_change"
+	AuthUserColumnLastLogin                = "last_login"
+	AuthUserColumnUserName                 = "user_name"
+	AuthUserColumnLoginNames               = "login_names"
+	AuthUserColumnPreferredLoginName       = "preferred_login_name"
+	AuthUserColumnFirstName                = "first_name"
+	AuthUserColumnLastName                 = "last_name"
+	AuthUserColumnNickName                 = "nick_name"
+	AuthUserColumnDisplayName              = "display_name"
+	AuthUserColumnPreferredLanguage        = "preferred_language"
+	AuthUserColumnGender                   = "gender"
+	AuthUserColumnEmail                    = "email"
+	AuthUserColumnIsEmailVerified          = "is_email_verified"
+	AuthUserColumnPhone                    = "phone"
+	AuthUserColumnIsPhoneVerified          = "is_phone_verified"
+	AuthUserColumnCountry                  = "country"
+	AuthUserColumnLocality                 = "locality"
+	AuthUserColumnPostalCode               = "postal_code"
+	AuthUserColumnRegion                   = "region"
+	AuthUserColumnStreetAddress            = "street_address"
+	AuthUserColumnOTPState                 = "otp_state"
+	AuthUserColumnMFAMaxSetUp              = "mfa_max_set_up"
+	AuthUserColumnMFAInitSkipped           = "mfa_init_skipped"
+	AuthUserColumnSequence                 = "sequence"
+	AuthUserColumnInitRequired             = "init_required"
+	AuthUserColumnUsernameChangeRequired   = "username_change_required"
+	AuthUserColumnMachineName              = "machine_name"
+	AuthUserColumnMachineDescription       = "machine_description"
+	AuthUserColumnUserType                 = "user_type"
+	AuthUserColumnU2FTokens                = "u2f_tokens"
+	AuthUserColumnPasswordlessTokens       = "passwordless_tokens"
+	AuthUserColumnAvatarKey                = "avatar_key"
+	AuthUserColumnPasswordlessInitRequired = "passwordless_init_required"
+	AuthUserColumnPasswordInitRequired     = "password_init_required"
+	AuthUserColumnInstanceID               = "instance_id"
+	AuthUserColumnOwnerRemoved             = "owner_removed"
+)
+
+// authUserProjection replaces the former auth.users2 view of the auth api
+// the user is reduced using the v1 view model, therefore the current state is read inside the projection transaction
+type authUserProjection struct {
+	crdb.StatementHandler
+}
+
+func newAuthUserProjection(ctx context.Context, config crdb.StatementHandlerConfig) *authUserProjection {
+	p := new(authUserProjection)
+	config.ProjectionName = AuthUserProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(AuthUserColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthUserColumnCreationDate, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnChangeDate, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnResourceOwner, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnState, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordSet, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordChangeRequired, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordChange, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnLastLogin, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnUserName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnLoginNames, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPreferredLoginName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnFirstName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnLastName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnNickName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnDisplayName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPreferredLanguage, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnGender, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnEmail, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnIsEmailVerified, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPhone, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnIsPhoneVerified, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnCountry, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnLocality, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPostalCode, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnRegion, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnStreetAddress, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnOTPState, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnMFAMaxSetUp, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnMFAInitSkipped, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnSequence, crdb.ColumnTypeInt64, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnInitRequired, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnUsernameChangeRequired, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnMachineName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnMachineDescription, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnUserType, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnU2FTokens, crdb.ColumnTypeBytes, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordlessTokens, crdb.ColumnTypeBytes, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnAvatarKey, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordlessInitRequired, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnPasswordInitRequired, crdb.ColumnTypeBool, crdb.Nullable()),
+			crdb.NewColumn(AuthUserColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthUserColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(AuthUserColumnInstanceID, AuthUserColumnID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{AuthUserColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{AuthUserColumnOwnerRemoved})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+var (
+	authUserAddedTypes = []eventstore.EventType{
+		user.UserV1AddedType,
+		user.MachineAddedEventType,
+		user.HumanAddedType,
+		user.UserV1RegisteredType,
+		user.HumanRegisteredType,
+	}
+	authUserChangedTypes = []eventstore.EventType{
+		user.UserV1ProfileChangedType,
+		user.UserV1EmailChangedType,
+		user.UserV1EmailVerifiedType,
+		user.UserV1PhoneChangedType,
+		user.UserV1PhoneVerifiedType,
+		user.UserV1PhoneRemovedType,
+		user.UserV1AddressChangedType,
+		user.UserDeactivatedType,
+		user.UserReactivatedType,
+		user.UserLockedType,
+		user.UserUnlockedType,
+		user.UserV1MFAOTPAddedType,
+		user.UserV1MFAOTPVerifiedType,
+		user.UserV1MFAOTPRemovedType,
+		user.UserV1MFAInitSkippedType,
+		user.UserV1PasswordChangedType,
+		user.HumanProfileChangedType,
+		user.HumanEmailChangedType,
+		user.HumanEmailVerifiedType,
+		user.HumanAvatarAddedType,
+		user.HumanAvatarRemovedType,
+		user.HumanPhoneChangedType,
+		user.HumanPhoneVerifiedType,
+		user.HumanPhoneRemovedType,
+		user.HumanAddressChangedType,
+		user.HumanMFAOTPAddedType,
+		user.HumanMFAOTPVerifiedType,
+		user.HumanMFAOTPRemovedType,
+		user.HumanU2FTokenAddedType,
+		user.HumanU2FTokenVerifiedType,
+		user.HumanU2FTokenRemovedType,
+		user.HumanPasswordlessTokenAddedType,
+		user.HumanPasswordlessTokenVerifiedType,
+		user.HumanPasswordlessTokenRemovedType,
+		user.HumanMFAInitSkippedType,
+		user.MachineChangedEventType,
+		user.HumanPasswordChangedType,
+		user.HumanInitialCodeAddedType,
+		user.UserV1InitialCodeAddedType,
+		user.UserV1InitializedCheckSucceededType,
+		user.HumanInitializedCheckSucceededType,
+		user.HumanPasswordlessInitCodeAddedType,
+		user.HumanPasswordlessInitCodeRequestedType,
+	}
+	authUserLoginNameChangedTypes = []eventstore.EventType{
+		user.UserDomainClaimedType,
+		user.UserUserNameChangedType,
+	}
+	authUserOrgLoginNamesChangedTypes = []eventstore.EventType{
+		org.OrgDomainVerifiedEventType,
+		org.OrgDomainRemovedEventType,
+		org.DomainPolicyAddedEventType,
+		org.DomainPolicyChangedEventType,
+		org.DomainPolicyRemovedEventType,
+	}
+)
+
+func (p *authUserProjection) reducers() []handler.AggregateReducer {
+	userReducers := make([]handler.EventReducer, 0, len(authUserAddedTypes)+len(authUserChangedTypes)+len(authUserLoginNameChangedTypes)+1)
+	userReducers = appendEventReducers(userReducers, p.reduceUserAdded, authUserAddedTypes...)
+	userReducers = appendEventReducers(userReducers, p.reduceUserChanged, authUserChangedTypes...)
+	userReducers = appendEventReducers(userReducers, p.reduceLoginNameChanged, authUserLoginNameChangedTypes...)
+	userReducers = appendEventReducers(userReducers, p.reduceUserRemoved, user.UserRemovedType)
+
+	orgReducers := make([]handler.EventReducer, 0, len(authUserOrgLoginNamesChangedTypes)+2)
+	orgReducers = appendEventReducers(orgReducers, p.reduceOrgLoginNamesChanged, authUserOrgLoginNamesChangedTypes...)
+	orgReducers = appendEventReducers(orgReducers, p.reduceOrgPrimaryDomainSet, org.OrgDomainPrimarySetEventType)
+	orgReducers = appendEventReducers(orgReducers, p.reduceOwnerRemoved, org.OrgRemovedEventType)
+
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     user.AggregateType,
+			EventRedusers: userReducers,
+		},
+		{
+			Aggregate:     org.AggregateType,
+			EventRedusers: orgReducers,
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AuthUserColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *authUserProjection) reduceUserAdded(event eventstore.Event) (*handler.Statement, error) {
+	authUser := new(view_model.UserView)
+	if err := authUser.AppendEvent(eventstore.MapEventToV1Event(event)); err != nil {
+		return nil, err
+	}
+	if err := fillUserLoginNames(p.Eventstore, authUser); err != nil {
+		return nil, err
+	}
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		return usr_view.PutUser(db, projectionName, authUser)
+	}), nil
+}
+
+func (p *authUserProjection) reduceUserChanged(event eventstore.Event) (*handler.Statement, error) {
+	return p.updateUser(event, false), nil
+}
+
+func (p *authUserProjection) reduceLoginNameChanged(event eventstore.Event) (*handler.Statement, error) {
+	return p.updateUser(event, true), nil
+}
+
+func (p *authUserProjection) updateUser(event eventstore.Event, loginNamesChanged bool) *handler.Statement {
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		authUser, err := usr_view.UserByID(db, projectionName, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			logging.WithFields(
+				"instance", event.Aggregate().InstanceID,
+				"userID", event.Aggregate().ID,
+				"eventType", event.Type(),
+			).Info("user not found in projection")
+			authUser, err = userFromEvents(p.Eventstore, event)
+			if err != nil {
+				return err
+			}
+		}
+		if err = authUser.AppendEvent(eventstore.MapEventToV1Event(event)); err != nil {
+			return err
+		}
+		if loginNamesChanged {
+			if err = fillUserLoginNames(p.Eventstore, authUser); err != nil {
+				return err
+			}
+		}
+		return usr_view.PutUser(db, projectionName, authUser)
+	})
+}
+
+func (p *authUserProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Rk2cW", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthUserColumnID, e.Aggregate().ID),
+			handler.NewCond(AuthUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authUserProjection) reduceOrgLoginNamesChanged(event eventstore.Event) (*handler.Statement, error) {
+	userLoginMustBeDomain, _, domains, err := loginNameInformation(p.Eventstore, event.Aggregate().ID, event.Aggregate().InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		users, err := usr_view.UsersByOrgID(db, projectionName, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return nil
+		}
+		for _, authUser := range users {
+			authUser.SetLoginNames(userLoginMustBeDomain, domains)
+		}
+		return usr_view.PutUsers(db, projectionName, users...)
+	}), nil
+}
+
+func (p *authUserProjection) reduceOrgPrimaryDomainSet(event eventstore.Event) (*handler.Statement, error) {
+	userLoginMustBeDomain, primaryDomain, _, err := loginNameInformation(p.Eventstore, event.Aggregate().ID, event.Aggregate().InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !userLoginMustBeDomain {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		users, err := usr_view.UsersByOrgID(db, projectionName, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return nil
+		}
+		for _, authUser := range users {
+			authUser.PreferredLoginName = authUser.GenerateLoginName(primaryDomain, userLoginMustBeDomain)
+		}
+		return usr_view.PutUsers(db, projectionName, users...)
+	}), nil
+}
+
+func (p *authUserProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Mf5vX", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AuthUserColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AuthUserColumnSequence, e.Sequence()),
+			handler.NewCol(AuthUserColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(AuthUserColumnResourceOwner, e.Aggregate().ID),
+			handler.NewCond(AuthUserColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func appendEventReducers(reducers []handler.EventReducer, reduce handler.Reduce, eventTypes ...eventstore.EventType) []handler.EventReducer {
+	for _, eventType := range eventTypes {
+		reducers = append(reducers, handler.EventReducer{
+			Event:  eventType,
+			Reduce: reduce,
+		})
+	}
+	return reducers
+}
+
+// gormTx wraps the transaction of the statement handler
+// so the gorm based helpers of the v1 view models can be used inside a statement
+func gormTx(ex handler.Executer) (*gorm.DB, error) {
+	tx, ok := ex.(gorm.SQLCommon)
+	if !ok {
+		return nil, errors.ThrowInternal(nil, "HANDL-Tq9vE", "executer is not a sql transaction")
+	}
+	return gorm.Open("postgres", tx)
+}
+
+// userFromEvents reduces the user from all events previous to the given event
+func userFromEvents(es *eventstore.Eventstore, event eventstore.Event) (*view_model.UserView, error) {
+	events, err := es.Filter(
+		authz.WithInstanceID(context.Background(), event.Aggregate().InstanceID),
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(event.Aggregate().InstanceID).
+			AddQuery().
+			AggregateTypes(user.AggregateType).
+			AggregateIDs(event.Aggregate().ID).
+			SequenceLess(event.Sequence()).
+			Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	authUser := new(view_model.UserView)
+	for _, e := range events {
+		if err = authUser.AppendEvent(eventstore.MapEventToV1Event(e)); err != nil {
+			return nil, err
+		}
+	}
+	return authUser, nil
+}
+
+func fillUserLoginNames(es *eventstore.Eventstore, authUser *view_model.UserView) error {
+	userLoginMustBeDomain, primaryDomain, domains, err := loginNameInformation(es, authUser.ResourceOwner, authUser.InstanceID)
+	if err != nil {
+		return err
+	}
+	authUser.SetLoginNames(userLoginMustBeDomain, domains)
+	authUser.PreferredLoginName = authUser.GenerateLoginName(primaryDomain, userLoginMustBeDomain)
+	return nil
+}
+
+// loginNameInformation returns the domain settings of the organisation
+// if the organisation has no custom domain policy the default policy of the instance is used
+func loginNameInformation(es *eventstore.Eventstore, orgID, instanceID string) (userLoginMustBeDomain bool, primaryDomain string, domains []*org_model.OrgDomain, err error) {
+	ctx := authz.WithInstanceID(context.Background(), instanceID)
+	orgEvents, err := es.Filter(
+		ctx,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(instanceID).
+			AddQuery().
+			AggregateTypes(org.AggregateType).
+			AggregateIDs(orgID).
+			EventTypes(
+				org.OrgAddedEventType,
+				org.OrgChangedEventType,
+				org.OrgDeactivatedEventType,
+				org.OrgReactivatedEventType,
+				org.OrgDomainAddedEventType,
+				org.OrgDomainVerificationAddedEventType,
+				org.OrgDomainVerifiedEventType,
+				org.OrgDomainPrimarySetEventType,
+				org.OrgDomainRemovedEventType,
+				org.DomainPolicyAddedEventType,
+				org.DomainPolicyChangedEventType,
+				org.DomainPolicyRemovedEventType,
+			).
+			Builder(),
+	)
+	if err != nil {
+		return false, "", nil, err
+	}
+	esOrg := &org_es_model.Org{
+		ObjectRoot: es_models.ObjectRoot{
+			AggregateID: orgID,
+		},
+	}
+	for _, event := range orgEvents {
+		if err = esOrg.AppendEvent(eventstore.MapEventToV1Event(event)); err != nil {
+			return false, "", nil, err
+		}
+	}
+	if esOrg.Sequence == 0 {
+		return false, "", nil, errors.ThrowNotFound(nil, "HANDL-Wp4sZ", "Errors.Org.NotFound")
+	}
+	orgModel := org_es_model.OrgToModel(esOrg)
+	if orgModel.DomainPolicy != nil {
+		return orgModel.DomainPolicy.UserLoginMustBeDomain, orgModel.GetPrimaryDomain().Domain, orgModel.Domains, nil
+	}
+
+	instanceEvents, err := es.Filter(
+		ctx,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			InstanceID(instanceID).
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			AggregateIDs(instanceID).
+			EventTypes(
+				instance.DomainPolicyAddedEventType,
+				instance.DomainPolicyChangedEventType,
+			).
+			Builder(),
+	)
+	if err != nil {
+		return false, "", nil, err
+	}
+	for _, event := range instanceEvents {
+		switch e := event.(type) {
+		case *instance.DomainPolicyAddedEvent:
+			userLoginMustBeDomain = e.UserLoginMustBeDomain
+		case *instance.DomainPolicyChangedEvent:
+			if e.UserLoginMustBeDomain != nil {
+				userLoginMustBeDomain = *e.UserLoginMustBeDomain
+			}
+		}
+	}
+	return userLoginMustBeDomain, orgModel.GetPrimaryDomain().Domain, orgModel.Domains, nil
+}
@@ -0,0 +1,290 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	usr_view "github.com/zitadel/zitadel/internal/user/repository/view"
+	view_model "github.com/zitadel/zitadel/internal/user/repository/view/model"
+)
+
+const (
+	AuthUserSessionProjectionTable = "projections.auth_user_sessions"
+
+	AuthUserSessionColumnCreationDate                 = "creation_date"
+	AuthUserSessionColumnChangeDate                   = "change_date"
+	AuthUserSessionColumnResourceOwner                = "resource_owner"
+	AuthUserSessionColumnState                        = "state"
+	AuthUserSessionColumnUserAgentID                  = "user_agent_id"
+	AuthUserSessionColumnUserID                       = "user_id"
+	AuthUserSessionColumnUserName                     = "user_name"
+	AuthUserSessionColumnPasswordVerification         = "password_verification"
+	AuthUserSessionColumnSecondFactorVerification     = "second_factor_verification"
+	AuthUserSessionColumnMultiFactorVerification      = "multi_factor_verification"
+	AuthUserSessionColumnSequence                     = "sequence"
+	AuthUserSessionColumnSecondFactorVerificationType = "second_factor_verification_type"
+	AuthUserSessionColumnMultiFactorVerificationType  = "multi_factor_verification_type"
+	AuthUserSessionColumnDisplayName                  = "user_display_name"
+	AuthUserSessionColumnLoginName                    = "login_name"
+	AuthUserSessionColumnExternalLoginVerification    = "external_login_verification"
+	AuthUserSessionColumnSelectedIDPConfigID          = "selected_idp_config_id"
+	AuthUserSessionColumnPasswordlessVerification     = "passwordless_verification"
+	AuthUserSessionColumnAvatarKey                    = "avatar_key"
+	AuthUserSessionColumnInstanceID                   = "instance_id"
+)
+
+// authUserSessionProjection replaces the former auth.user_sessions view of the auth api
+// the session is reduced using the v1 view model, therefore the current state is read inside the projection transaction
+type authUserSessionProjection struct {
+	crdb.StatementHandler
+}
+
+func newAuthUserSessionProjection(ctx context.Context, config crdb.StatementHandlerConfig) *authUserSessionProjection {
+	p := new(authUserSessionProjection)
+	config.ProjectionName = AuthUserSessionProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(AuthUserSessionColumnCreationDate, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnChangeDate, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnResourceOwner, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnState, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnUserAgentID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthUserSessionColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(AuthUserSessionColumnUserName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnPasswordVerification, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnSecondFactorVerification, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnMultiFactorVerification, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnSequence, crdb.ColumnTypeInt64, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnSecondFactorVerificationType, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnMultiFactorVerificationType, crdb.ColumnTypeEnum, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnDisplayName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnLoginName, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnExternalLoginVerification, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnSelectedIDPConfigID, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnPasswordlessVerification, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnAvatarKey, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AuthUserSessionColumnInstanceID, crdb.ColumnTypeText),
+		},
+			crdb.NewPrimaryKey(AuthUserSessionColumnInstanceID, AuthUserSessionColumnUserAgentID, AuthUserSessionColumnUserID),
+			crdb.WithIndex(crdb.NewIndex("user", []string{AuthUserSessionColumnUserID})),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{AuthUserSessionColumnResourceOwner})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+var (
+	authUserSessionCheckTypes = []eventstore.EventType{
+		user.UserV1PasswordCheckSucceededType,
+		user.UserV1PasswordCheckFailedType,
+		user.UserV1MFAOTPCheckSucceededType,
+		user.UserV1MFAOTPCheckFailedType,
+		user.UserV1SignedOutType,
+		user.HumanPasswordCheckSucceededType,
+		user.HumanPasswordCheckFailedType,
+		user.UserIDPLoginCheckSucceededType,
+		user.HumanMFAOTPCheckSucceededType,
+		user.HumanMFAOTPCheckFailedType,
+		user.HumanU2FTokenCheckSucceededType,
+		user.HumanU2FTokenCheckFailedType,
+		user.HumanPasswordlessTokenCheckSucceededType,
+		user.HumanPasswordlessTokenCheckFailedType,
+		user.HumanMagicLinkCheckSucceededType,
+		user.HumanMagicLinkCheckFailedType,
+		user.HumanSignedOutType,
+	}
+	authUserSessionUserChangedTypes = []eventstore.EventType{
+		user.UserV1PasswordChangedType,
+		user.UserV1MFAOTPRemovedType,
+		user.UserV1ProfileChangedType,
+		user.UserLockedType,
+		user.UserDeactivatedType,
+		user.HumanPasswordChangedType,
+		user.HumanMFAOTPRemovedType,
+		user.HumanProfileChangedType,
+		user.HumanAvatarAddedType,
+		user.HumanAvatarRemovedType,
+		user.UserDomainClaimedType,
+		user.UserUserNameChangedType,
+		user.UserIDPLinkRemovedType,
+		user.UserIDPLinkCascadeRemovedType,
+		user.HumanPasswordlessTokenRemovedType,
+		user.HumanU2FTokenRemovedType,
+	}
+)
+
+func (p *authUserSessionProjection) reducers() []handler.AggregateReducer {
+	userReducers := make([]handler.EventReducer, 0, len(authUserSessionCheckTypes)+len(authUserSessionUserChangedTypes)+1)
+	userReducers = appendEventReducers(userReducers, p.reduceSessionChecked, authUserSessionCheckTypes...)
+	userReducers = appendEventReducers(userReducers, p.reduceUserChanged, authUserSessionUserChangedTypes...)
+	userReducers = appendEventReducers(userReducers, p.reduceUserRemoved, user.UserRemovedType)
+
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     user.AggregateType,
+			EventRedusers: userReducers,
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgDomainPrimarySetEventType,
+					Reduce: p.reduceOrgPrimaryDomainSet,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AuthUserSessionColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *authUserSessionProjection) reduceSessionChecked(event eventstore.Event) (*handler.Statement, error) {
+	v1Event := eventstore.MapEventToV1Event(event)
+	eventData, err := view_model.UserSessionFromEvent(v1Event)
+	if err != nil {
+		return nil, err
+	}
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		session, err := usr_view.UserSessionByIDs(db, projectionName, eventData.UserAgentID, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			session = &view_model.UserSessionView{
+				CreationDate:  event.CreationDate(),
+				ResourceOwner: event.Aggregate().ResourceOwner,
+				UserAgentID:   eventData.UserAgentID,
+				UserID:        event.Aggregate().ID,
+				State:         int32(domain.UserSessionStateActive),
+				InstanceID:    event.Aggregate().InstanceID,
+			}
+		}
+		if err = session.AppendEvent(v1Event); err != nil {
+			return err
+		}
+		if err = fillSessionUserInfo(db, session); err != nil {
+			return err
+		}
+		return usr_view.PutUserSession(db, projectionName, session)
+	}), nil
+}
+
+func (p *authUserSessionProjection) reduceUserChanged(event eventstore.Event) (*handler.Statement, error) {
+	v1Event := eventstore.MapEventToV1Event(event)
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		sessions, err := usr_view.UserSessionsByUserID(db, projectionName, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			return nil
+		}
+		for _, session := range sessions {
+			if err = session.AppendEvent(v1Event); err != nil {
+				return err
+			}
+			if err = fillSessionUserInfo(db, session); err != nil {
+				return err
+			}
+		}
+		return usr_view.PutUserSessions(db, projectionName, sessions...)
+	}), nil
+}
+
+func (p *authUserSessionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Ks8dN", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthUserSessionColumnUserID, e.Aggregate().ID),
+			handler.NewCond(AuthUserSessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *authUserSessionProjection) reduceOrgPrimaryDomainSet(event eventstore.Event) (*handler.Statement, error) {
+	userLoginMustBeDomain, primaryDomain, _, err := loginNameInformation(p.Eventstore, event.Aggregate().ID, event.Aggregate().InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !userLoginMustBeDomain {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	return crdb.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		db, err := gormTx(ex)
+		if err != nil {
+			return err
+		}
+		sessions, err := usr_view.UserSessionsByOrgID(db, projectionName, event.Aggregate().ID, event.Aggregate().InstanceID)
+		if err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			return nil
+		}
+		for _, session := range sessions {
+			session.LoginName = session.UserName + "@" + primaryDomain
+		}
+		return usr_view.PutUserSessions(db, projectionName, sessions...)
+	}), nil
+}
+
+func (p *authUserSessionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Zc6hB", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AuthUserSessionColumnResourceOwner, e.Aggregate().ID),
+			handler.NewCond(AuthUserSessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func fillSessionUserInfo(db *gorm.DB, session *view_model.UserSessionView) error {
+	authUser, err := usr_view.UserByID(db, AuthUserProjectionTable, session.UserID, session.InstanceID)
+	if err != nil {
+		return err
+	}
+	session.UserName = authUser.UserName
+	session.LoginName = authUser.PreferredLoginName
+	session.DisplayName = authUser.DisplayName
+	session.AvatarKey = authUser.AvatarKey
+	return nil
+}
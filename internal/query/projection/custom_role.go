@@ -0,0 +1,139 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	CustomRoleProjectionTable = "projections.custom_roles"
+
+	CustomRoleColumnRole          = "role"
+	CustomRoleColumnAggregateID   = "aggregate_id"
+	CustomRoleColumnCreationDate  = "creation_date"
+	CustomRoleColumnChangeDate    = "change_date"
+	CustomRoleColumnSequence      = "sequence"
+	CustomRoleColumnResourceOwner = "resource_owner"
+	CustomRoleColumnInstanceID    = "instance_id"
+	CustomRoleColumnDisplayName   = "display_name"
+	CustomRoleColumnPermissions   = "permissions"
+)
+
+type customRoleProjection struct {
+	crdb.StatementHandler
+}
+
+func newCustomRoleProjection(ctx context.Context, config crdb.StatementHandlerConfig) *customRoleProjection {
+	p := new(customRoleProjection)
+	config.ProjectionName = CustomRoleProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(CustomRoleColumnRole, crdb.ColumnTypeText),
+			crdb.NewColumn(CustomRoleColumnAggregateID, crdb.ColumnTypeText),
+			crdb.NewColumn(CustomRoleColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(CustomRoleColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(CustomRoleColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(CustomRoleColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(CustomRoleColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(CustomRoleColumnDisplayName, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(CustomRoleColumnPermissions, crdb.ColumnTypeTextArray),
+		},
+			crdb.NewPrimaryKey(CustomRoleColumnInstanceID, CustomRoleColumnRole),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *customRoleProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.CustomRoleAddedEventType,
+					Reduce: p.reduceCustomRoleAdded,
+				},
+				{
+					Event:  instance.CustomRoleChangedEventType,
+					Reduce: p.reduceCustomRoleChanged,
+				},
+				{
+					Event:  instance.CustomRoleRemovedEventType,
+					Reduce: p.reduceCustomRoleRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(CustomRoleColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *customRoleProjection) reduceCustomRoleAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.CustomRoleAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kw2nf", "reduce.wrong.event.type %s", instance.CustomRoleAddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(CustomRoleColumnRole, e.Role),
+			handler.NewCol(CustomRoleColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(CustomRoleColumnCreationDate, e.CreationDate()),
+			handler.NewCol(CustomRoleColumnChangeDate, e.CreationDate()),
+			handler.NewCol(CustomRoleColumnSequence, e.Sequence()),
+			handler.NewCol(CustomRoleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(CustomRoleColumnDisplayName, e.DisplayName),
+			handler.NewCol(CustomRoleColumnPermissions, database.StringArray(e.Permissions)),
+		},
+	), nil
+}
+
+func (p *customRoleProjection) reduceCustomRoleChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.CustomRoleChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Bd9fw", "reduce.wrong.event.type %s", instance.CustomRoleChangedEventType)
+	}
+
+	columns := make([]handler.Column, 0, 4)
+	columns = append(columns, handler.NewCol(CustomRoleColumnChangeDate, e.CreationDate()),
+		handler.NewCol(CustomRoleColumnSequence, e.Sequence()))
+	if e.DisplayName != nil {
+		columns = append(columns, handler.NewCol(CustomRoleColumnDisplayName, *e.DisplayName))
+	}
+	if e.Permissions != nil {
+		columns = append(columns, handler.NewCol(CustomRoleColumnPermissions, database.StringArray(e.Permissions)))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(CustomRoleColumnRole, e.Role),
+			handler.NewCond(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *customRoleProjection) reduceCustomRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.CustomRoleRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Xe2mq", "reduce.wrong.event.type %s", instance.CustomRoleRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CustomRoleColumnRole, e.Role),
+			handler.NewCond(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
@@ -0,0 +1,178 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	GroupProjectionTable = "projections.groups"
+
+	GroupColumnID            = "id"
+	GroupColumnCreationDate  = "creation_date"
+	GroupColumnChangeDate    = "change_date"
+	GroupColumnSequence      = "sequence"
+	GroupColumnResourceOwner = "resource_owner"
+	GroupColumnInstanceID    = "instance_id"
+	GroupColumnName          = "name"
+	GroupColumnDescription   = "description"
+	GroupColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupProjection {
+	p := new(groupProjection)
+	config.ProjectionName = GroupProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnName, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupColumnDescription, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(GroupColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupColumnInstanceID, GroupColumnID),
+			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{GroupColumnResourceOwner})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.GroupAddedType,
+					Reduce: p.reduceGroupAdded,
+				},
+				{
+					Event:  group.GroupChangedType,
+					Reduce: p.reduceGroupChanged,
+				},
+				{
+					Event:  group.GroupRemovedType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp2nf", "reduce.wrong.event.type %s", group.GroupAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupColumnID, e.Aggregate().ID),
+			handler.NewCol(GroupColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupColumnSequence, e.Sequence()),
+			handler.NewCol(GroupColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(GroupColumnName, e.Name),
+			handler.NewCol(GroupColumnDescription, e.Description),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp3og", "reduce.wrong.event.type %s", group.GroupChangedType)
+	}
+	if e.Name == nil && e.Description == nil {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	columns := make([]handler.Column, 0, 4)
+	columns = append(columns,
+		handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+		handler.NewCol(GroupColumnSequence, e.Sequence()),
+	)
+	if e.Name != nil {
+		columns = append(columns, handler.NewCol(GroupColumnName, *e.Name))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(GroupColumnDescription, *e.Description))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, e.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gp4ph", "reduce.wrong.event.type %s", group.GroupRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, e.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gp5qi", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupColumnSequence, e.Sequence()),
+			handler.NewCol(GroupColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
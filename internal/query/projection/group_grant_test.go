@@ -0,0 +1,186 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestGroupGrantProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceGrantAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantAddedType),
+					group.AggregateType,
+					[]byte(`{"grantId": "grant-id", "projectId": "project-id", "roleKeys": ["role"]}`),
+				), group.GrantAddedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceGrantAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("group"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.group_grants (id, group_id, project_id, project_grant_id, role_keys, creation_date, change_date, sequence, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"grant-id",
+								"agg-id",
+								"project-id",
+								"",
+								database.StringArray{"role"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantChangedType),
+					group.AggregateType,
+					[]byte(`{"grantId": "grant-id", "roleKeys": ["role"]}`),
+				), group.GrantChangedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceGrantChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("group"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants SET (change_date, sequence, role_keys) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								database.StringArray{"role"},
+								"grant-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(group.GrantRemovedType),
+					group.AggregateType,
+					[]byte(`{"grantId": "grant-id"}`),
+				), group.GrantRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceGrantRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("group"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"grant-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectGrantRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.GrantRemovedType),
+					project.AggregateType,
+					[]byte(`{"grantId": "grant-id"}`),
+				), project.GrantRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceProjectGrantRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants WHERE (project_grant_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"grant-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceRoleRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.RoleRemovedType),
+					project.AggregateType,
+					[]byte(`{"key": "key"}`),
+				), project.RoleRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants SET role_keys = array_remove(role_keys, $1) WHERE (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"key",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupGrantProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	GroupMemberProjectionTable = "projections.group_members"
+
+	GroupMemberColumnGroupID       = "group_id"
+	GroupMemberColumnUserID        = "user_id"
+	GroupMemberColumnCreationDate  = "creation_date"
+	GroupMemberColumnChangeDate    = "change_date"
+	GroupMemberColumnSequence      = "sequence"
+	GroupMemberColumnResourceOwner = "resource_owner"
+	GroupMemberColumnInstanceID    = "instance_id"
+	GroupMemberColumnOwnerRemoved  = "owner_removed"
+)
+
+type groupMemberProjection struct {
+	crdb.StatementHandler
+}
+
+func newGroupMemberProjection(ctx context.Context, config crdb.StatementHandlerConfig) *groupMemberProjection {
+	p := new(groupMemberProjection)
+	config.ProjectionName = GroupMemberProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(GroupMemberColumnGroupID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMemberColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMemberColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupMemberColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(GroupMemberColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(GroupMemberColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMemberColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(GroupMemberColumnOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(GroupMemberColumnInstanceID, GroupMemberColumnGroupID, GroupMemberColumnUserID),
+			crdb.WithIndex(crdb.NewIndex("user_id", []string{GroupMemberColumnUserID})),
+			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{GroupMemberColumnOwnerRemoved})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *groupMemberProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  group.MemberAddedType,
+					Reduce: p.reduceMemberAdded,
+				},
+				{
+					Event:  group.MemberRemovedType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  group.GroupRemovedType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupMemberColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupMemberProjection) reduceMemberAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.MemberAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm2nf", "reduce.wrong.event.type %s", group.MemberAddedType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMemberColumnGroupID, e.Aggregate().ID),
+			handler.NewCol(GroupMemberColumnUserID, e.UserID),
+			handler.NewCol(GroupMemberColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupMemberColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupMemberColumnSequence, e.Sequence()),
+			handler.NewCol(GroupMemberColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMemberProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.MemberRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm3og", "reduce.wrong.event.type %s", group.MemberRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupMemberColumnUserID, e.UserID),
+			handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMemberProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm4ph", "reduce.wrong.event.type %s", group.GroupRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberColumnGroupID, e.Aggregate().ID),
+			handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Gm5qi", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberColumnUserID, e.Aggregate().ID),
+			handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupMemberProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Gm6rj", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMemberColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupMemberColumnSequence, e.Sequence()),
+			handler.NewCol(GroupMemberColumnOwnerRemoved, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMemberColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
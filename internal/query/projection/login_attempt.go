@@ -0,0 +1,221 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/bruteforce"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	LoginAttemptTable = "projections.login_attempts"
+
+	LoginAttemptInstanceIDCol   = "instance_id"
+	LoginAttemptKeyTypeCol      = "key_type"
+	LoginAttemptKeyCol          = "key"
+	LoginAttemptSequenceCol     = "sequence"
+	LoginAttemptCreationDateCol = "creation_date"
+)
+
+// loginAttemptProjection stores a row per failed password, otp, u2f and passwordless check
+// for the user and for the IP address the check was made from
+type loginAttemptProjection struct {
+	crdb.StatementHandler
+}
+
+func newLoginAttemptProjection(ctx context.Context, config crdb.StatementHandlerConfig) *loginAttemptProjection {
+	p := new(loginAttemptProjection)
+	config.ProjectionName = LoginAttemptTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(LoginAttemptInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LoginAttemptKeyTypeCol, crdb.ColumnTypeEnum),
+			crdb.NewColumn(LoginAttemptKeyCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LoginAttemptSequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(LoginAttemptCreationDateCol, crdb.ColumnTypeTimestamp),
+		},
+			crdb.NewPrimaryKey(LoginAttemptInstanceIDCol, LoginAttemptKeyTypeCol, LoginAttemptKeyCol, LoginAttemptSequenceCol),
+			crdb.WithIndex(crdb.NewIndex("creation_date", []string{LoginAttemptInstanceIDCol, LoginAttemptCreationDateCol})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *loginAttemptProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanPasswordCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.UserV1PasswordCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.HumanMFAOTPCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.UserV1MFAOTPCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.HumanU2FTokenCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.HumanMagicLinkCheckFailedType,
+					Reduce: p.reduceCheckFailed,
+				},
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.UserV1PasswordCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.HumanMFAOTPCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.UserV1MFAOTPCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.HumanU2FTokenCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.HumanMagicLinkCheckSucceededType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.UserUnlockedType,
+					Reduce: p.reduceUserReset,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserReset,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.LoginBlockClearedEventType,
+					Reduce: p.reduceBlockCleared,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(LoginAttemptInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *loginAttemptProjection) reduceCheckFailed(event eventstore.Event) (*handler.Statement, error) {
+	var info *user.AuthRequestInfo
+	switch e := event.(type) {
+	case *user.HumanPasswordCheckFailedEvent:
+		info = e.AuthRequestInfo
+	case *user.HumanOTPCheckFailedEvent:
+		info = e.AuthRequestInfo
+	case *user.HumanU2FCheckFailedEvent:
+		info = e.AuthRequestInfo
+	case *user.HumanPasswordlessCheckFailedEvent:
+		info = e.AuthRequestInfo
+	case *user.HumanMagicLinkCheckFailedEvent:
+		info = e.AuthRequestInfo
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lat1a", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckFailedType, user.HumanMFAOTPCheckFailedType, user.HumanU2FTokenCheckFailedType, user.HumanPasswordlessTokenCheckFailedType, user.HumanMagicLinkCheckFailedType})
+	}
+	stmts := loginAttemptStatements(event, domain.LoginBlockKeyTypeUser, event.Aggregate().ID)
+	if info != nil && info.BrowserInfo != nil && info.RemoteIP != nil {
+		stmts = append(stmts, loginAttemptStatements(event, domain.LoginBlockKeyTypeIP, info.RemoteIP.String())...)
+	}
+	return crdb.NewMultiStatement(event, stmts...), nil
+}
+
+// loginAttemptStatements adds the failed attempt and removes the attempts of the key
+// which are too old to be counted anymore
+func loginAttemptStatements(event eventstore.Event, keyType domain.LoginBlockKeyType, key string) []func(eventstore.Event) crdb.Exec {
+	return []func(eventstore.Event) crdb.Exec{
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(LoginAttemptInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewCol(LoginAttemptKeyTypeCol, keyType),
+				handler.NewCol(LoginAttemptKeyCol, key),
+				handler.NewCol(LoginAttemptSequenceCol, event.Sequence()),
+				handler.NewCol(LoginAttemptCreationDateCol, event.CreationDate()),
+			},
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginAttemptInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewCond(LoginAttemptKeyTypeCol, keyType),
+				handler.NewCond(LoginAttemptKeyCol, key),
+				handler.NewLessThanCond(LoginAttemptCreationDateCol, event.CreationDate().Add(-bruteforce.MaxWindow)),
+			},
+		),
+	}
+}
+
+func (p *loginAttemptProjection) reduceUserReset(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanPasswordCheckSucceededEvent,
+		*user.HumanOTPCheckSucceededEvent,
+		*user.HumanU2FCheckSucceededEvent,
+		*user.HumanPasswordlessCheckSucceededEvent,
+		*user.HumanMagicLinkCheckSucceededEvent,
+		*user.UserUnlockedEvent,
+		*user.UserRemovedEvent:
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lat2b", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckSucceededType, user.HumanMFAOTPCheckSucceededType, user.HumanU2FTokenCheckSucceededType, user.HumanPasswordlessTokenCheckSucceededType, user.HumanMagicLinkCheckSucceededType, user.UserUnlockedType, user.UserRemovedType})
+	}
+	return crdb.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(LoginAttemptInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(LoginAttemptKeyTypeCol, domain.LoginBlockKeyTypeUser),
+			handler.NewCond(LoginAttemptKeyCol, event.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *loginAttemptProjection) reduceBlockCleared(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.LoginBlockClearedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lat3c", "reduce.wrong.event.type %s", instance.LoginBlockClearedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LoginAttemptInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(LoginAttemptKeyTypeCol, e.KeyType),
+			handler.NewCond(LoginAttemptKeyCol, e.Key),
+		},
+	), nil
+}
@@ -0,0 +1,280 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func TestLoginAttemptProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceCheckFailed password without ip",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordCheckFailedType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanPasswordCheckFailedEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceCheckFailed,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_attempts (instance_id, key_type, key, sequence, creation_date) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+								uint64(15),
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3) AND (creation_date < $4)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCheckFailed otp with ip",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanMFAOTPCheckFailedType),
+					user.AggregateType,
+					[]byte(`{
+						"id": "auth-request-id",
+						"remoteIP": "192.168.0.1"
+					}`),
+				), user.HumanOTPCheckFailedEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceCheckFailed,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_attempts (instance_id, key_type, key, sequence, creation_date) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+								uint64(15),
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3) AND (creation_date < $4)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.login_attempts (instance_id, key_type, key, sequence, creation_date) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeIP,
+								"192.168.0.1",
+								uint64(15),
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3) AND (creation_date < $4)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeIP,
+								"192.168.0.1",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserReset password check succeeded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordCheckSucceededType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanPasswordCheckSucceededEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceUserReset,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserReset magic link check succeeded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanMagicLinkCheckSucceededType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanMagicLinkCheckSucceededEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceUserReset,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserReset user unlocked",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserUnlockedType),
+					user.AggregateType,
+					nil,
+				), user.UserUnlockedEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceUserReset,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeUser,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceBlockCleared",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.LoginBlockClearedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"keyType": 1,
+						"key": "192.168.0.1"
+					}`),
+				), instance.LoginBlockClearedEventMapper),
+			},
+			reduce: (&loginAttemptProjection{}).reduceBlockCleared,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1) AND (key_type = $2) AND (key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.LoginBlockKeyTypeIP,
+								"192.168.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(LoginAttemptInstanceIDCol),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_attempts WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, LoginAttemptTable, tt.want)
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	LoginPolicyTable = "projections.login_policies4"
+	LoginPolicyTable = "projections.login_policies6"
 
 	LoginPolicyIDCol                    = "aggregate_id"
 	LoginPolicyInstanceIDCol            = "instance_id"
@@ -33,6 +33,7 @@ const (
 	AllowDomainDiscovery                = "allow_domain_discovery"
 	DisableLoginWithEmail               = "disable_login_with_email"
 	DisableLoginWithPhone               = "disable_login_with_phone"
+	AllowMagicLinkCol                   = "allow_magic_link"
 	DefaultRedirectURI                  = "default_redirect_uri"
 	PasswordCheckLifetimeCol            = "password_check_lifetime"
 	ExternalLoginCheckLifetimeCol       = "external_login_check_lifetime"
@@ -40,6 +41,13 @@ const (
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
+	RiskRulesEnabledCol                 = "risk_enabled"
+	RiskRulesNewDeviceCol               = "risk_new_device"
+	RiskRulesNewNetworkCol              = "risk_new_network"
+	RiskRulesNewCountryCol              = "risk_new_country"
+	RiskRulesImpossibleTravelSpeedCol   = "risk_impossible_travel_speed"
+	RiskRulesMaxRecentFailuresCol       = "risk_max_recent_failures"
+	RiskRulesRecentFailuresWindowCol    = "risk_recent_failures_window"
 )
 
 type loginPolicyProjection struct {
@@ -70,6 +78,7 @@ func newLoginPolicyProjection(ctx context.Context, config crdb.StatementHandlerC
 			crdb.NewColumn(AllowDomainDiscovery, crdb.ColumnTypeBool),
 			crdb.NewColumn(DisableLoginWithEmail, crdb.ColumnTypeBool),
 			crdb.NewColumn(DisableLoginWithPhone, crdb.ColumnTypeBool),
+			crdb.NewColumn(AllowMagicLinkCol, crdb.ColumnTypeBool, crdb.Default(false)),
 			crdb.NewColumn(DefaultRedirectURI, crdb.ColumnTypeText, crdb.Nullable()),
 			crdb.NewColumn(PasswordCheckLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(ExternalLoginCheckLifetimeCol, crdb.ColumnTypeInt64),
@@ -77,6 +86,13 @@ func newLoginPolicyProjection(ctx context.Context, config crdb.StatementHandlerC
 			crdb.NewColumn(SecondFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(MultiFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(LoginPolicyOwnerRemovedCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(RiskRulesEnabledCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(RiskRulesNewDeviceCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(RiskRulesNewNetworkCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(RiskRulesNewCountryCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(RiskRulesImpossibleTravelSpeedCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RiskRulesMaxRecentFailuresCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RiskRulesRecentFailuresWindowCol, crdb.ColumnTypeInt64, crdb.Default(0)),
 		},
 			crdb.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
 			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{LoginPolicyOwnerRemovedCol})),
@@ -119,6 +135,10 @@ func (p *loginPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.LoginPolicySecondFactorRemovedEventType,
 					Reduce: p.reduce2FARemoved,
 				},
+				{
+					Event:  org.LoginPolicyRiskRulesSetEventType,
+					Reduce: p.reduceRiskRulesSet,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOwnerRemoved,
@@ -152,6 +172,10 @@ func (p *loginPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.LoginPolicySecondFactorRemovedEventType,
 					Reduce: p.reduce2FARemoved,
 				},
+				{
+					Event:  instance.LoginPolicyRiskRulesSetEventType,
+					Reduce: p.reduceRiskRulesSet,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(LoginPolicyInstanceIDCol),
@@ -192,6 +216,7 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(AllowDomainDiscovery, policyEvent.AllowDomainDiscovery),
 		handler.NewCol(DisableLoginWithEmail, policyEvent.DisableLoginWithEmail),
 		handler.NewCol(DisableLoginWithPhone, policyEvent.DisableLoginWithPhone),
+		handler.NewCol(AllowMagicLinkCol, policyEvent.AllowMagicLink),
 		handler.NewCol(DefaultRedirectURI, policyEvent.DefaultRedirectURI),
 		handler.NewCol(PasswordCheckLifetimeCol, policyEvent.PasswordCheckLifetime),
 		handler.NewCol(ExternalLoginCheckLifetimeCol, policyEvent.ExternalLoginCheckLifetime),
@@ -246,6 +271,9 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.DisableLoginWithPhone != nil {
 		cols = append(cols, handler.NewCol(DisableLoginWithPhone, *policyEvent.DisableLoginWithPhone))
 	}
+	if policyEvent.AllowMagicLink != nil {
+		cols = append(cols, handler.NewCol(AllowMagicLinkCol, *policyEvent.AllowMagicLink))
+	}
 	if policyEvent.DefaultRedirectURI != nil {
 		cols = append(cols, handler.NewCol(DefaultRedirectURI, *policyEvent.DefaultRedirectURI))
 	}
@@ -389,6 +417,37 @@ func (p *loginPolicyProjection) reduce2FARemoved(event eventstore.Event) (*handl
 	), nil
 }
 
+func (p *loginPolicyProjection) reduceRiskRulesSet(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.RiskRulesSetEvent
+	switch e := event.(type) {
+	case *instance.LoginPolicyRiskRulesSetEvent:
+		policyEvent = e.RiskRulesSetEvent
+	case *org.LoginPolicyRiskRulesSetEvent:
+		policyEvent = e.RiskRulesSetEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Rsk8w", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginPolicyRiskRulesSetEventType, instance.LoginPolicyRiskRulesSetEventType})
+	}
+
+	return crdb.NewUpdateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(LoginPolicyChangeDateCol, policyEvent.CreationDate()),
+			handler.NewCol(LoginPolicySequenceCol, policyEvent.Sequence()),
+			handler.NewCol(RiskRulesEnabledCol, policyEvent.Enabled),
+			handler.NewCol(RiskRulesNewDeviceCol, policyEvent.NewDevice),
+			handler.NewCol(RiskRulesNewNetworkCol, policyEvent.NewNetwork),
+			handler.NewCol(RiskRulesNewCountryCol, policyEvent.NewCountry),
+			handler.NewCol(RiskRulesImpossibleTravelSpeedCol, policyEvent.ImpossibleTravelSpeed),
+			handler.NewCol(RiskRulesMaxRecentFailuresCol, policyEvent.MaxRecentFailures),
+			handler.NewCol(RiskRulesRecentFailuresWindowCol, policyEvent.RecentFailuresWindow),
+		},
+		[]handler.Condition{
+			handler.NewCond(LoginPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(LoginPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *loginPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
@@ -0,0 +1,94 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	OIDCClientRegistrationSettingsProjectionTable = "projections.oidc_client_registration_settings"
+
+	OIDCClientRegistrationSettingsColumnAggregateID                = "aggregate_id"
+	OIDCClientRegistrationSettingsColumnCreationDate               = "creation_date"
+	OIDCClientRegistrationSettingsColumnChangeDate                 = "change_date"
+	OIDCClientRegistrationSettingsColumnResourceOwner              = "resource_owner"
+	OIDCClientRegistrationSettingsColumnInstanceID                 = "instance_id"
+	OIDCClientRegistrationSettingsColumnSequence                   = "sequence"
+	OIDCClientRegistrationSettingsColumnAllowedGrantTypes          = "allowed_grant_types"
+	OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes = "allowed_redirect_uri_prefixes"
+)
+
+type oidcClientRegistrationSettingsProjection struct {
+	crdb.StatementHandler
+}
+
+func newOIDCClientRegistrationSettingsProjection(ctx context.Context, config crdb.StatementHandlerConfig) *oidcClientRegistrationSettingsProjection {
+	p := new(oidcClientRegistrationSettingsProjection)
+	config.ProjectionName = OIDCClientRegistrationSettingsProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnAggregateID, crdb.ColumnTypeText),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnAllowedGrantTypes, crdb.ColumnTypeEnumArray, crdb.Nullable()),
+			crdb.NewColumn(OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes, crdb.ColumnTypeTextArray, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(OIDCClientRegistrationSettingsColumnInstanceID, OIDCClientRegistrationSettingsColumnAggregateID),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *oidcClientRegistrationSettingsProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.OIDCClientRegistrationSettingsSetEventType,
+					Reduce: p.reduceOIDCClientRegistrationSettingsSet,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OIDCClientRegistrationSettingsColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *oidcClientRegistrationSettingsProjection) reduceOIDCClientRegistrationSettingsSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.OIDCClientRegistrationSettingsSetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Hq2nw", "reduce.wrong.event.type %s", instance.OIDCClientRegistrationSettingsSetEventType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OIDCClientRegistrationSettingsColumnInstanceID, nil),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnAggregateID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(OIDCClientRegistrationSettingsColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnCreationDate, e.CreationDate()),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnSequence, e.Sequence()),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnAllowedGrantTypes, database.EnumArray[domain.OIDCGrantType](e.AllowedGrantTypes)),
+			handler.NewCol(OIDCClientRegistrationSettingsColumnAllowedRedirectURIPrefixes, database.StringArray(e.AllowedRedirectURIPrefixes)),
+		},
+	), nil
+}
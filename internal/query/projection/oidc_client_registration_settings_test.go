@@ -0,0 +1,98 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestOIDCClientRegistrationSettingsProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceOIDCClientRegistrationSettingsSet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.OIDCClientRegistrationSettingsSetEventType),
+					instance.AggregateType,
+					[]byte(`{"allowedGrantTypes": [0, 2], "allowedRedirectUriPrefixes": ["https://partner.example.com/"]}`),
+				), instance.OIDCClientRegistrationSettingsSetEventMapper),
+			},
+			reduce: (&oidcClientRegistrationSettingsProjection{}).reduceOIDCClientRegistrationSettingsSet,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.oidc_client_registration_settings (instance_id, aggregate_id, resource_owner, creation_date, change_date, sequence, allowed_grant_types, allowed_redirect_uri_prefixes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, aggregate_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, allowed_grant_types, allowed_redirect_uri_prefixes) = (EXCLUDED.resource_owner, EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.allowed_grant_types, EXCLUDED.allowed_redirect_uri_prefixes)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+								database.StringArray{"https://partner.example.com/"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.InstanceRemovedEventType),
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(OIDCClientRegistrationSettingsColumnInstanceID),
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.oidc_client_registration_settings WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, OIDCClientRegistrationSettingsProjectionTable, tt.want)
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 const (
-	PasswordComplexityTable = "projections.password_complexity_policies2"
+	PasswordComplexityTable = "projections.password_complexity_policies3"
 
 	ComplexityPolicyIDCol            = "id"
 	ComplexityPolicyCreationDateCol  = "creation_date"
@@ -29,6 +29,7 @@ const (
 	ComplexityPolicyHasUppercaseCol  = "has_uppercase"
 	ComplexityPolicyHasSymbolCol     = "has_symbol"
 	ComplexityPolicyHasNumberCol     = "has_number"
+	ComplexityPolicyCheckBreachedCol = "check_breached"
 	ComplexityPolicyOwnerRemovedCol  = "owner_removed"
 )
 
@@ -55,6 +56,7 @@ func newPasswordComplexityProjection(ctx context.Context, config crdb.StatementH
 			crdb.NewColumn(ComplexityPolicyHasUppercaseCol, crdb.ColumnTypeBool),
 			crdb.NewColumn(ComplexityPolicyHasSymbolCol, crdb.ColumnTypeBool),
 			crdb.NewColumn(ComplexityPolicyHasNumberCol, crdb.ColumnTypeBool),
+			crdb.NewColumn(ComplexityPolicyCheckBreachedCol, crdb.ColumnTypeBool, crdb.Default(false)),
 			crdb.NewColumn(ComplexityPolicyOwnerRemovedCol, crdb.ColumnTypeBool, crdb.Default(false)),
 		},
 			crdb.NewPrimaryKey(ComplexityPolicyInstanceIDCol, ComplexityPolicyIDCol),
@@ -134,6 +136,7 @@ func (p *passwordComplexityProjection) reduceAdded(event eventstore.Event) (*han
 			handler.NewCol(ComplexityPolicyHasUppercaseCol, policyEvent.HasUppercase),
 			handler.NewCol(ComplexityPolicyHasSymbolCol, policyEvent.HasSymbol),
 			handler.NewCol(ComplexityPolicyHasNumberCol, policyEvent.HasNumber),
+			handler.NewCol(ComplexityPolicyCheckBreachedCol, policyEvent.CheckBreached),
 			handler.NewCol(ComplexityPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(ComplexityPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
 			handler.NewCol(ComplexityPolicyIsDefaultCol, isDefault),
@@ -169,6 +172,9 @@ func (p *passwordComplexityProjection) reduceChanged(event eventstore.Event) (*h
 	if policyEvent.HasNumber != nil {
 		cols = append(cols, handler.NewCol(ComplexityPolicyHasNumberCol, *policyEvent.HasNumber))
 	}
+	if policyEvent.CheckBreached != nil {
+		cols = append(cols, handler.NewCol(ComplexityPolicyCheckBreachedCol, *policyEvent.CheckBreached))
+	}
 	return crdb.NewUpdateStatement(
 		&policyEvent,
 		cols,
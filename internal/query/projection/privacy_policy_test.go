@@ -32,7 +32,8 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 						"tosLink": "http://tos.link",
 						"privacyLink": "http://privacy.link",
 						"helpLink": "http://help.link",
-						"supportEmail": "support@example.com"}`),
+						"supportEmail": "support@example.com",
+						"allowSelfDelete": true}`),
 				), org.PrivacyPolicyAddedEventMapper),
 			},
 			reduce: (&privacyPolicyProjection{}).reduceAdded,
@@ -43,7 +44,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.privacy_policies3 (creation_date, change_date, sequence, id, state, privacy_link, tos_link, help_link, support_email, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO projections.privacy_policies4 (creation_date, change_date, sequence, id, state, privacy_link, tos_link, help_link, support_email, allow_self_delete, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -54,6 +55,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 								"http://tos.link",
 								"http://help.link",
 								domain.EmailAddress("support@example.com"),
+								true,
 								false,
 								"ro-id",
 								"instance-id",
@@ -74,7 +76,8 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 						"tosLink": "http://tos.link",
 						"privacyLink": "http://privacy.link",
 						"helpLink": "http://help.link",
-						"supportEmail": "support@example.com"}`),
+						"supportEmail": "support@example.com",
+						"allowSelfDelete": true}`),
 				), org.PrivacyPolicyChangedEventMapper),
 			},
 			want: wantReduce{
@@ -84,7 +87,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.privacy_policies3 SET (change_date, sequence, privacy_link, tos_link, help_link, support_email) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedStmt: "UPDATE projections.privacy_policies4 SET (change_date, sequence, privacy_link, tos_link, help_link, support_email, allow_self_delete) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -92,6 +95,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 								"http://tos.link",
 								"http://help.link",
 								domain.EmailAddress("support@example.com"),
+								true,
 								"agg-id",
 								"instance-id",
 							},
@@ -117,7 +121,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.privacy_policies3 WHERE (id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.privacy_policies4 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -143,7 +147,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.privacy_policies3 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.privacy_policies4 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
@@ -163,7 +167,8 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 						"tosLink": "http://tos.link",
 						"privacyLink": "http://privacy.link",
 						"helpLink": "http://help.link",
-						"supportEmail": "support@example.com"}`),
+						"supportEmail": "support@example.com",
+						"allowSelfDelete": true}`),
 				), instance.PrivacyPolicyAddedEventMapper),
 			},
 			want: wantReduce{
@@ -173,7 +178,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.privacy_policies3 (creation_date, change_date, sequence, id, state, privacy_link, tos_link, help_link, support_email, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO projections.privacy_policies4 (creation_date, change_date, sequence, id, state, privacy_link, tos_link, help_link, support_email, allow_self_delete, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -185,6 +190,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 								"http://help.link",
 								domain.EmailAddress("support@example.com"),
 								true,
+								true,
 								"ro-id",
 								"instance-id",
 							},
@@ -204,7 +210,8 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 						"tosLink": "http://tos.link",
 						"privacyLink": "http://privacy.link",
 						"helpLink": "http://help.link",
-						"supportEmail": "support@example.com"}`),
+						"supportEmail": "support@example.com",
+						"allowSelfDelete": true}`),
 				), instance.PrivacyPolicyChangedEventMapper),
 			},
 			want: wantReduce{
@@ -214,7 +221,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.privacy_policies3 SET (change_date, sequence, privacy_link, tos_link, help_link, support_email) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedStmt: "UPDATE projections.privacy_policies4 SET (change_date, sequence, privacy_link, tos_link, help_link, support_email, allow_self_delete) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -222,6 +229,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 								"http://tos.link",
 								"http://help.link",
 								domain.EmailAddress("support@example.com"),
+								true,
 								"agg-id",
 								"instance-id",
 							},
@@ -247,7 +255,7 @@ func TestPrivacyPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.privacy_policies3 SET (change_date, sequence, owner_removed) = ($1, $2, $3) WHERE (instance_id = $4) AND (resource_owner = $5)",
+							expectedStmt: "UPDATE projections.privacy_policies4 SET (change_date, sequence, owner_removed) = ($1, $2, $3) WHERE (instance_id = $4) AND (resource_owner = $5)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
@@ -58,26 +59,44 @@ var (
 	SMTPConfigProjection                *smtpConfigProjection
 	SMSConfigProjection                 *smsConfigProjection
 	OIDCSettingsProjection              *oidcSettingsProjection
+	OIDCClientRegistrationProjection    *oidcClientRegistrationSettingsProjection
 	DebugNotificationProviderProjection *debugNotificationProviderProjection
 	KeyProjection                       *keyProjection
 	SecurityPolicyProjection            *securityPolicyProjection
 	NotificationPolicyProjection        *notificationPolicyProjection
 	NotificationsProjection             interface{}
 	NotificationsQuotaProjection        interface{}
+	StylingProjection                   interface{}
 	DeviceAuthProjection                *deviceAuthProjection
 	SessionProjection                   *sessionProjection
+	CustomRoleProjection                *customRoleProjection
+	GroupProjection                     *groupProjection
+	GroupMemberProjection               *groupMemberProjection
+	GroupGrantProjection                *groupGrantProjection
+	LoginAttemptProjection              *loginAttemptProjection
+	AuthUserProjection                  *authUserProjection
+	AuthUserSessionProjection           *authUserSessionProjection
+	AuthTokenProjection                 *authTokenProjection
+	AuthRefreshTokenProjection          *authRefreshTokenProjection
 )
 
 type projection interface {
 	Start()
 	Init(ctx context.Context) error
+	Name() string
+	Status(ctx context.Context) ([]*crdb.ProjectionStatus, error)
+	Rebuild(ctx context.Context) error
+	Trigger(ctx context.Context, instances ...string) error
 }
 
 var (
 	projections []projection
+	// client is the database of the projections, the projections of the instances in other shards are stored in the shards
+	client *database.DB
 )
 
 func Create(ctx context.Context, sqlClient *database.DB, es *eventstore.Eventstore, config Config, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, certEncryptionAlgorithm crypto.EncryptionAlgorithm) error {
+	client = sqlClient
 	projectionConfig = crdb.StatementHandlerConfig{
 		ProjectionHandlerConfig: handler.ProjectionHandlerConfig{
 			HandlerConfig: handler.HandlerConfig{
@@ -137,20 +156,34 @@ func Create(ctx context.Context, sqlClient *database.DB, es *eventstore.Eventsto
 	SMTPConfigProjection = newSMTPConfigProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["smtp_configs"]))
 	SMSConfigProjection = newSMSConfigProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sms_config"]))
 	OIDCSettingsProjection = newOIDCSettingsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_settings"]))
+	OIDCClientRegistrationProjection = newOIDCClientRegistrationSettingsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_client_registration_settings"]))
 	DebugNotificationProviderProjection = newDebugNotificationProviderProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_notification_provider"]))
 	KeyProjection = newKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["keys"]), keyEncryptionAlgorithm, certEncryptionAlgorithm)
 	SecurityPolicyProjection = newSecurityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["security_policies"]))
 	NotificationPolicyProjection = newNotificationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_policies"]))
 	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_auth"]))
 	SessionProjection = newSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions"]))
+	CustomRoleProjection = newCustomRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_roles"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
+	GroupMemberProjection = newGroupMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_members"]))
+	GroupGrantProjection = newGroupGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_grants"]))
+	LoginAttemptProjection = newLoginAttemptProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_attempts"]))
+	AuthUserProjection = newAuthUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_users"]))
+	AuthUserSessionProjection = newAuthUserSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_user_sessions"]))
+	AuthTokenProjection = newAuthTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_tokens"]))
+	AuthRefreshTokenProjection = newAuthRefreshTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_refresh_tokens"]))
 	newProjectionsList()
 	return nil
 }
 
+// Init initializes the projections in the databases of all shards
 func Init(ctx context.Context) error {
-	for _, p := range projections {
-		if err := p.Init(ctx); err != nil {
-			return err
+	for _, shard := range client.ShardNames() {
+		shardCtx := database.WithShard(ctx, shard)
+		for _, p := range projections {
+			if err := p.Init(shardCtx); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -162,6 +195,67 @@ func Start() {
 	}
 }
 
+// Status returns the progress of all projections per instance of all shards
+func Status(ctx context.Context) ([]*crdb.ProjectionStatus, error) {
+	statuses := make([]*crdb.ProjectionStatus, 0, len(projections))
+	for _, shard := range client.ShardNames() {
+		shardCtx := database.WithShard(ctx, shard)
+		for _, projection := range projections {
+			status, err := projection.Status(shardCtx)
+			if err != nil {
+				return nil, err
+			}
+			statuses = append(statuses, status...)
+		}
+	}
+	return statuses, nil
+}
+
+// Names returns the names of all projections
+func Names() []string {
+	names := make([]string, len(projections))
+	for i, projection := range projections {
+		names[i] = projection.Name()
+	}
+	return names
+}
+
+// Rebuild rebuilds the projections with the given names one after another
+func Rebuild(ctx context.Context, names ...string) error {
+	toRebuild := make([]projection, len(names))
+	for i, name := range names {
+		for _, projection := range projections {
+			if projection.Name() == name || projection.Name() == "projections."+name {
+				toRebuild[i] = projection
+				break
+			}
+		}
+		if toRebuild[i] == nil {
+			return errors.ThrowNotFoundf(nil, "PROJE-Rb1nF", "projection %s not found", name)
+		}
+	}
+	for _, shard := range client.ShardNames() {
+		shardCtx := database.WithShard(ctx, shard)
+		for _, projection := range toRebuild {
+			if err := projection.Rebuild(shardCtx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Trigger reduces the outstanding events of the instances in all projections
+// of the shard selected in the context
+func Trigger(ctx context.Context, instanceIDs ...string) error {
+	for _, projection := range projections {
+		if err := projection.Trigger(ctx, instanceIDs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ApplyCustomConfig(customConfig CustomConfig) crdb.StatementHandlerConfig {
 	return applyCustomConfig(projectionConfig, customConfig)
 }
@@ -234,11 +328,21 @@ func newProjectionsList() {
 		SMTPConfigProjection,
 		SMSConfigProjection,
 		OIDCSettingsProjection,
+		OIDCClientRegistrationProjection,
 		DebugNotificationProviderProjection,
 		KeyProjection,
 		SecurityPolicyProjection,
 		NotificationPolicyProjection,
 		DeviceAuthProjection,
 		SessionProjection,
+		CustomRoleProjection,
+		GroupProjection,
+		GroupMemberProjection,
+		GroupGrantProjection,
+		LoginAttemptProjection,
+		AuthUserProjection,
+		AuthUserSessionProjection,
+		AuthTokenProjection,
+		AuthRefreshTokenProjection,
 	}
 }
@@ -2,6 +2,8 @@ package projection
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
@@ -18,7 +20,7 @@ import (
 )
 
 const (
-	UserGrantProjectionTable = "projections.user_grants3"
+	UserGrantProjectionTable = "projections.user_grants4"
 
 	UserGrantID                   = "id"
 	UserGrantCreationDate         = "creation_date"
@@ -38,6 +40,8 @@ const (
 	UserGrantGrantedOrgRemoved    = "granted_org_removed"
 	UserGrantRoles                = "roles"
 	UserGrantOwnerRemoved         = "owner_removed"
+	UserGrantValidFrom            = "valid_from"
+	UserGrantValidUntil           = "valid_until"
 )
 
 type userGrantProjection struct {
@@ -68,6 +72,8 @@ func newUserGrantProjection(ctx context.Context, config crdb.StatementHandlerCon
 			crdb.NewColumn(UserGrantGrantedOrgRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
 			crdb.NewColumn(UserGrantRoles, crdb.ColumnTypeTextArray, crdb.Nullable()),
 			crdb.NewColumn(UserGrantOwnerRemoved, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(UserGrantValidFrom, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(UserGrantValidUntil, crdb.ColumnTypeTimestamp, crdb.Nullable()),
 		},
 			crdb.NewPrimaryKey(UserGrantInstanceID, UserGrantID),
 			crdb.WithIndex(crdb.NewIndex("user_id", []string{UserGrantUserID})),
@@ -116,6 +122,26 @@ func (p *userGrantProjection) reducers() []handler.AggregateReducer {
 					Event:  usergrant.UserGrantReactivatedType,
 					Reduce: p.reduceReactivated,
 				},
+				{
+					Event:  usergrant.UserGrantRequestedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  usergrant.UserGrantApprovedType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  usergrant.UserGrantRejectedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  usergrant.UserGrantValidityChangedType,
+					Reduce: p.reduceValidityChanged,
+				},
+				{
+					Event:  usergrant.UserGrantExpiredType,
+					Reduce: p.reduceExpired,
+				},
 			},
 		},
 		{
@@ -174,48 +200,59 @@ func (p *userGrantProjection) reducers() []handler.AggregateReducer {
 }
 
 func (p *userGrantProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*usergrant.UserGrantAddedEvent)
-	if !ok {
-		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-MQHVB", "reduce.wrong.event.type %s", usergrant.UserGrantAddedType)
+	var (
+		userID, projectID, projectGrantID string
+		roleKeys                          []string
+		state                             domain.UserGrantState
+	)
+	switch e := event.(type) {
+	case *usergrant.UserGrantAddedEvent:
+		userID, projectID, projectGrantID, roleKeys = e.UserID, e.ProjectID, e.ProjectGrantID, e.RoleKeys
+		state = domain.UserGrantStateActive
+	case *usergrant.UserGrantRequestedEvent:
+		userID, projectID, projectGrantID, roleKeys = e.UserID, e.ProjectID, e.ProjectGrantID, e.RoleKeys
+		state = domain.UserGrantStateRequested
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-MQHVB", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantAddedType, usergrant.UserGrantRequestedType})
 	}
 
-	ctx := setUserGrantContext(e.Aggregate())
-	userOwner, err := getResourceOwnerOfUser(ctx, p.Eventstore, e.Aggregate().InstanceID, e.UserID)
+	ctx := setUserGrantContext(event.Aggregate())
+	userOwner, err := getResourceOwnerOfUser(ctx, p.Eventstore, event.Aggregate().InstanceID, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	projectOwner := ""
 	grantOwner := ""
-	if e.ProjectGrantID != "" {
-		grantOwner, err = getGrantedOrgOfGrantedProject(ctx, p.Eventstore, e.Aggregate().InstanceID, e.ProjectID, e.ProjectGrantID)
+	if projectGrantID != "" {
+		grantOwner, err = getGrantedOrgOfGrantedProject(ctx, p.Eventstore, event.Aggregate().InstanceID, projectID, projectGrantID)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		projectOwner, err = getResourceOwnerOfProject(ctx, p.Eventstore, e.Aggregate().InstanceID, e.ProjectID)
+		projectOwner, err = getResourceOwnerOfProject(ctx, p.Eventstore, event.Aggregate().InstanceID, projectID)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	return crdb.NewCreateStatement(
-		e,
+		event,
 		[]handler.Column{
-			handler.NewCol(UserGrantID, e.Aggregate().ID),
-			handler.NewCol(UserGrantResourceOwner, e.Aggregate().ResourceOwner),
-			handler.NewCol(UserGrantInstanceID, e.Aggregate().InstanceID),
-			handler.NewCol(UserGrantCreationDate, e.CreationDate()),
-			handler.NewCol(UserGrantChangeDate, e.CreationDate()),
-			handler.NewCol(UserGrantSequence, e.Sequence()),
-			handler.NewCol(UserGrantUserID, e.UserID),
+			handler.NewCol(UserGrantID, event.Aggregate().ID),
+			handler.NewCol(UserGrantResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserGrantInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(UserGrantCreationDate, event.CreationDate()),
+			handler.NewCol(UserGrantChangeDate, event.CreationDate()),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+			handler.NewCol(UserGrantUserID, userID),
 			handler.NewCol(UserGrantResourceOwnerUser, userOwner),
-			handler.NewCol(UserGrantProjectID, e.ProjectID),
+			handler.NewCol(UserGrantProjectID, projectID),
 			handler.NewCol(UserGrantResourceOwnerProject, projectOwner),
-			handler.NewCol(UserGrantGrantID, e.ProjectGrantID),
+			handler.NewCol(UserGrantGrantID, projectGrantID),
 			handler.NewCol(UserGrantGrantedOrg, grantOwner),
-			handler.NewCol(UserGrantRoles, database.StringArray(e.RoleKeys)),
-			handler.NewCol(UserGrantState, domain.UserGrantStateActive),
+			handler.NewCol(UserGrantRoles, database.StringArray(roleKeys)),
+			handler.NewCol(UserGrantState, state),
 		},
 	), nil
 }
@@ -248,10 +285,10 @@ func (p *userGrantProjection) reduceChanged(event eventstore.Event) (*handler.St
 
 func (p *userGrantProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
 	switch event.(type) {
-	case *usergrant.UserGrantRemovedEvent, *usergrant.UserGrantCascadeRemovedEvent:
+	case *usergrant.UserGrantRemovedEvent, *usergrant.UserGrantCascadeRemovedEvent, *usergrant.UserGrantRejectedEvent:
 		// ok
 	default:
-		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-7OBEC", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantRemovedType, usergrant.UserGrantCascadeRemovedType})
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-7OBEC", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantRemovedType, usergrant.UserGrantCascadeRemovedType, usergrant.UserGrantRejectedType})
 	}
 
 	return crdb.NewDeleteStatement(
@@ -283,7 +320,7 @@ func (p *userGrantProjection) reduceDeactivated(event eventstore.Event) (*handle
 }
 
 func (p *userGrantProjection) reduceReactivated(event eventstore.Event) (*handler.Statement, error) {
-	if _, ok := event.(*usergrant.UserGrantDeactivatedEvent); !ok {
+	if _, ok := event.(*usergrant.UserGrantReactivatedEvent); !ok {
 		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-DGsKh", "reduce.wrong.event.type %s", usergrant.UserGrantReactivatedType)
 	}
 
@@ -301,6 +338,70 @@ func (p *userGrantProjection) reduceReactivated(event eventstore.Event) (*handle
 	), nil
 }
 
+func (p *userGrantProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*usergrant.UserGrantApprovedEvent); !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Aq3ws", "reduce.wrong.event.type %s", usergrant.UserGrantApprovedType)
+	}
+
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, event.CreationDate()),
+			handler.NewCol(UserGrantState, domain.UserGrantStateActive),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, event.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantProjection) reduceValidityChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantValidityChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Vm2sd", "reduce.wrong.event.type %s", usergrant.UserGrantValidityChangedType)
+	}
+
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, e.CreationDate()),
+			handler.NewCol(UserGrantValidFrom, nullTime(e.ValidFrom)),
+			handler.NewCol(UserGrantValidUntil, nullTime(e.ValidUntil)),
+			handler.NewCol(UserGrantSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, e.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*usergrant.UserGrantExpiredEvent); !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Ex8fs", "reduce.wrong.event.type %s", usergrant.UserGrantExpiredType)
+	}
+
+	return crdb.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, event.CreationDate()),
+			handler.NewCol(UserGrantState, domain.UserGrantStateExpired),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, event.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// nullTime stores a zero time as null
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
 func (p *userGrantProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	if _, ok := event.(*user.UserRemovedEvent); !ok {
 		return nil, errors.ThrowInvalidArgumentf(nil, "PROJE-Bner2a", "reduce.wrong.event.type %s", user.UserRemovedType)
@@ -49,6 +49,8 @@ func newUserMetadataProjection(ctx context.Context, config crdb.StatementHandler
 			crdb.NewPrimaryKey(UserMetadataColumnInstanceID, UserMetadataColumnUserID, UserMetadataColumnKey),
 			crdb.WithIndex(crdb.NewIndex("resource_owner", []string{UserGrantResourceOwner})),
 			crdb.WithIndex(crdb.NewIndex("owner_removed", []string{UserMetadataColumnOwnerRemoved})),
+			// used to search users by their metadata, the value is not part of the index as it can be up to 500KB
+			crdb.WithIndex(crdb.NewIndex("key", []string{UserMetadataColumnInstanceID, UserMetadataColumnKey})),
 		),
 	)
 
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
@@ -18,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/action"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	iam_repo "github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/keypair"
@@ -31,6 +33,7 @@ import (
 type Queries struct {
 	eventstore *eventstore.Eventstore
 	client     *database.DB
+	caches     caches
 
 	idpConfigEncryption  crypto.EncryptionAlgorithm
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error)
@@ -46,6 +49,20 @@ type Queries struct {
 	multifactors                        domain.MultifactorConfigs
 }
 
+// RegisterEventMappers registers the mappers of all events reduced by the projections
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	iam_repo.RegisterEventMappers(es)
+	usr_repo.RegisterEventMappers(es)
+	org.RegisterEventMappers(es)
+	project.RegisterEventMappers(es)
+	action.RegisterEventMappers(es)
+	keypair.RegisterEventMappers(es)
+	usergrant.RegisterEventMappers(es)
+	session.RegisterEventMappers(es)
+	idpintent.RegisterEventMappers(es)
+	group.RegisterEventMappers(es)
+}
+
 func StartQueries(
 	ctx context.Context,
 	es *eventstore.Eventstore,
@@ -55,6 +72,7 @@ func StartQueries(
 	idpConfigEncryption, otpEncryption, keyEncryptionAlgorithm, certEncryptionAlgorithm crypto.EncryptionAlgorithm,
 	zitadelRoles []authz.RoleMapping,
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error),
+	queryCache cache.Cache,
 ) (repo *Queries, err error) {
 	statikLoginFS, err := fs.NewWithNamespace("login")
 	if err != nil {
@@ -76,16 +94,9 @@ func StartQueries(
 		NotificationTranslationFileContents: make(map[string][]byte),
 		zitadelRoles:                        zitadelRoles,
 		sessionTokenVerifier:                sessionTokenVerifier,
+		caches:                              newCaches(queryCache),
 	}
-	iam_repo.RegisterEventMappers(repo.eventstore)
-	usr_repo.RegisterEventMappers(repo.eventstore)
-	org.RegisterEventMappers(repo.eventstore)
-	project.RegisterEventMappers(repo.eventstore)
-	action.RegisterEventMappers(repo.eventstore)
-	keypair.RegisterEventMappers(repo.eventstore)
-	usergrant.RegisterEventMappers(repo.eventstore)
-	session.RegisterEventMappers(repo.eventstore)
-	idpintent.RegisterEventMappers(repo.eventstore)
+	RegisterEventMappers(repo.eventstore)
 
 	repo.idpConfigEncryption = idpConfigEncryption
 	repo.multifactors = domain.MultifactorConfigs{
@@ -99,6 +110,7 @@ func StartQueries(
 	if err != nil {
 		return nil, err
 	}
+	repo.caches.registerInvalidation()
 	projection.Start()
 
 	return repo, nil
@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+)
+
+// readClient returns the client for queries which tolerate staleness.
+// The replica is used if configured, the projection is not triggered
+// and the replica has processed the position required by the call (read-your-writes).
+// Otherwise the primary is returned.
+// Both are the databases of the shard selected in the context.
+func (q *Queries) readClient(ctx context.Context, shouldTriggerBulk bool, projection table) *sql.DB {
+	client := q.client.Shard(ctx)
+	if client.Replica == nil || shouldTriggerBulk {
+		return client.DB
+	}
+	position := call.PositionFromContext(ctx)
+	if position == 0 {
+		return client.Replica
+	}
+	sequence, err := replicaSequence(ctx, client.Replica, projection.name, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		logging.WithError(err).WithField("projection", projection.name).Warn("unable to read sequence of replica, fallback to primary")
+		return client.DB
+	}
+	if sequence < position {
+		return client.DB
+	}
+	return client.Replica
+}
+
+// replicaSequence returns the highest sequence the projection processed on the replica.
+// Projections process the events of all their aggregate types ordered by sequence,
+// so the highest sequence is the position the projection is up to date with.
+func replicaSequence(ctx context.Context, replica *sql.DB, projectionName, instanceID string) (uint64, error) {
+	stmt, args, err := sq.Select("COALESCE(MAX(" + CurrentSequenceColCurrentSequence.name + "), 0)").
+		From(currentSequencesTable.name).
+		Where(sq.Eq{
+			CurrentSequenceColProjectionName.name: projectionName,
+			CurrentSequenceColInstanceID.name:     instanceID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+	var sequence uint64
+	err = replica.QueryRowContext(ctx, stmt, args...).Scan(&sequence)
+	return sequence, err
+}
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+func TestQueries_readClient(t *testing.T) {
+	sequenceStmt := regexp.QuoteMeta("SELECT COALESCE(MAX(current_sequence), 0) FROM projections.current_sequences WHERE instance_id = $1 AND projection_name = $2")
+	tests := []struct {
+		name              string
+		withReplica       bool
+		shouldTriggerBulk bool
+		position          uint64
+		expect            func(sqlmock.Sqlmock)
+		wantReplica       bool
+	}{
+		{
+			name:        "no replica",
+			withReplica: false,
+			wantReplica: false,
+		},
+		{
+			name:              "trigger bulk",
+			withReplica:       true,
+			shouldTriggerBulk: true,
+			wantReplica:       false,
+		},
+		{
+			name:        "no position",
+			withReplica: true,
+			wantReplica: true,
+		},
+		{
+			name:        "replica behind",
+			withReplica: true,
+			position:    10,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(sequenceStmt).
+					WithArgs("instance", "projections.orgs1").
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence"}).AddRow(9))
+			},
+			wantReplica: false,
+		},
+		{
+			name:        "replica up to date",
+			withReplica: true,
+			position:    10,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(sequenceStmt).
+					WithArgs("instance", "projections.orgs1").
+					WillReturnRows(sqlmock.NewRows([]string{"current_sequence"}).AddRow(10))
+			},
+			wantReplica: true,
+		},
+		{
+			name:        "replica error",
+			withReplica: true,
+			position:    10,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(sequenceStmt).
+					WithArgs("instance", "projections.orgs1").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantReplica: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, _, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("unable to mock primary: %v", err)
+			}
+			defer primary.Close()
+			q := &Queries{client: &database.DB{DB: primary}}
+
+			if tt.withReplica {
+				replica, mock, err := sqlmock.New()
+				if err != nil {
+					t.Fatalf("unable to mock replica: %v", err)
+				}
+				defer replica.Close()
+				if tt.expect != nil {
+					tt.expect(mock)
+				}
+				q.client.Replica = replica
+				defer func() {
+					if err := mock.ExpectationsWereMet(); err != nil {
+						t.Errorf("expectations not met: %v", err)
+					}
+				}()
+			}
+
+			ctx := call.WithPosition(authz.WithInstanceID(context.Background(), "instance"), tt.position)
+			got := q.readClient(ctx, tt.shouldTriggerBulk, orgsTable)
+			if (got == q.client.Replica) != tt.wantReplica {
+				t.Errorf("readClient() returned replica: %v, want %v", got == q.client.Replica, tt.wantReplica)
+			}
+		})
+	}
+}
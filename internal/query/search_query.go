@@ -438,6 +438,70 @@ func (s *BoolQuery) comp() sq.Sqlizer {
 	return sq.Eq{s.Column.identifier(): s.Value}
 }
 
+type BytesQuery struct {
+	Column  Column
+	Value   []byte
+	Compare BytesComparison
+}
+
+func NewBytesQuery(col Column, value []byte, compare BytesComparison) (*BytesQuery, error) {
+	if compare < 0 || compare >= bytesCompareMax {
+		return nil, ErrInvalidCompare
+	}
+	if col.isZero() {
+		return nil, ErrMissingColumn
+	}
+	return &BytesQuery{
+		Column:  col,
+		Value:   value,
+		Compare: compare,
+	}, nil
+}
+
+func (q *BytesQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (s *BytesQuery) comp() sq.Sqlizer {
+	switch s.Compare {
+	case BytesEquals:
+		return sq.Eq{s.Column.identifier(): s.Value}
+	case BytesStartsWith:
+		// LIKE is not supported for bytes on all databases,
+		// therefore the prefix is compared as range
+		lower := sq.GtOrEq{s.Column.identifier(): s.Value}
+		upper := bytesPrefixEnd(s.Value)
+		if upper == nil {
+			return lower
+		}
+		return sq.And{lower, sq.Lt{s.Column.identifier(): upper}}
+	}
+	return nil
+}
+
+// bytesPrefixEnd returns the smallest value greater than all values starting with prefix
+// or nil if there is no such value
+func bytesPrefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+type BytesComparison int
+
+const (
+	BytesEquals BytesComparison = iota
+	BytesStartsWith
+
+	bytesCompareMax
+)
+
 var (
 	//countColumn represents the default counter for search responses
 	countColumn = Column{
@@ -1382,3 +1382,177 @@ func TestNumberComparisonFromMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBytesQuery(t *testing.T) {
+	type args struct {
+		column  Column
+		value   []byte
+		compare BytesComparison
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *BytesQuery
+		wantErr func(error) bool
+	}{
+		{
+			name: "too low compare",
+			args: args{
+				column:  testCol,
+				value:   []byte("hurst"),
+				compare: -1,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidCompare)
+			},
+		},
+		{
+			name: "too high compare",
+			args: args{
+				column:  testCol,
+				value:   []byte("hurst"),
+				compare: bytesCompareMax,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidCompare)
+			},
+		},
+		{
+			name: "no column",
+			args: args{
+				column:  Column{},
+				value:   []byte("hurst"),
+				compare: BytesEquals,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrMissingColumn)
+			},
+		},
+		{
+			name: "correct",
+			args: args{
+				column:  testCol,
+				value:   []byte("hurst"),
+				compare: BytesEquals,
+			},
+			want: &BytesQuery{
+				Column:  testCol,
+				Value:   []byte("hurst"),
+				Compare: BytesEquals,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBytesQuery(tt.args.column, tt.args.value, tt.args.compare)
+			if err != nil && tt.wantErr == nil {
+				t.Errorf("NewBytesQuery() no error expected got %v", err)
+				return
+			} else if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("NewBytesQuery() unexpeted error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewBytesQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytesQuery_comp(t *testing.T) {
+	type fields struct {
+		Column  Column
+		Value   []byte
+		Compare BytesComparison
+	}
+	type want struct {
+		query interface{}
+		isNil bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "equals",
+			fields: fields{
+				Column:  testCol,
+				Value:   []byte("Hurst"),
+				Compare: BytesEquals,
+			},
+			want: want{
+				query: sq.Eq{"test_table.test_col": []byte("Hurst")},
+			},
+		},
+		{
+			name: "starts with",
+			fields: fields{
+				Column:  testCol,
+				Value:   []byte("Hurst"),
+				Compare: BytesStartsWith,
+			},
+			want: want{
+				query: sq.And{
+					sq.GtOrEq{"test_table.test_col": []byte("Hurst")},
+					sq.Lt{"test_table.test_col": []byte("Hursu")},
+				},
+			},
+		},
+		{
+			name: "starts with max byte",
+			fields: fields{
+				Column:  testCol,
+				Value:   []byte{0x01, 0xff},
+				Compare: BytesStartsWith,
+			},
+			want: want{
+				query: sq.And{
+					sq.GtOrEq{"test_table.test_col": []byte{0x01, 0xff}},
+					sq.Lt{"test_table.test_col": []byte{0x02}},
+				},
+			},
+		},
+		{
+			name: "starts with only max bytes",
+			fields: fields{
+				Column:  testCol,
+				Value:   []byte{0xff, 0xff},
+				Compare: BytesStartsWith,
+			},
+			want: want{
+				query: sq.GtOrEq{"test_table.test_col": []byte{0xff, 0xff}},
+			},
+		},
+		{
+			name: "too high comparison",
+			fields: fields{
+				Column:  testCol,
+				Value:   []byte("Hurst"),
+				Compare: bytesCompareMax,
+			},
+			want: want{
+				isNil: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &BytesQuery{
+				Column:  tt.fields.Column,
+				Value:   tt.fields.Value,
+				Compare: tt.fields.Compare,
+			}
+			query := s.comp()
+			if query == nil && tt.want.isNil {
+				return
+			} else if tt.want.isNil && query != nil {
+				t.Error("query should not be nil")
+			}
+
+			if !reflect.DeepEqual(query, tt.want.query) {
+				t.Errorf("wrong query: want: %v, (%T), got: %v, (%T)", tt.want.query, tt.want.query, query, query)
+			}
+		})
+	}
+}
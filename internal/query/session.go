@@ -23,16 +23,17 @@ type Sessions struct {
 }
 
 type Session struct {
-	ID             string
-	CreationDate   time.Time
-	ChangeDate     time.Time
-	Sequence       uint64
-	State          domain.SessionState
-	ResourceOwner  string
-	Creator        string
-	UserFactor     SessionUserFactor
-	PasswordFactor SessionPasswordFactor
-	Metadata       map[string][]byte
+	ID              string
+	CreationDate    time.Time
+	ChangeDate      time.Time
+	Sequence        uint64
+	State           domain.SessionState
+	ResourceOwner   string
+	Creator         string
+	UserFactor      SessionUserFactor
+	PasswordFactor  SessionPasswordFactor
+	MagicLinkFactor SessionMagicLinkFactor
+	Metadata        map[string][]byte
 }
 
 type SessionUserFactor struct {
@@ -46,6 +47,10 @@ type SessionPasswordFactor struct {
 	PasswordCheckedAt time.Time
 }
 
+type SessionMagicLinkFactor struct {
+	MagicLinkCheckedAt time.Time
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -108,6 +113,10 @@ var (
 		name:  projection.SessionColumnPasswordCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnMagicLinkCheckedAt = Column{
+		name:  projection.SessionColumnMagicLinkCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -184,6 +193,10 @@ func NewSessionCreatorSearchQuery(creator string) (SearchQuery, error) {
 	return NewTextQuery(SessionColumnCreator, creator, TextEquals)
 }
 
+func NewSessionUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(SessionColumnUserID, userID, TextEquals)
+}
+
 func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*Session, string, error)) {
 	return sq.Select(
 			SessionColumnID.identifier(),
@@ -198,6 +211,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			LoginNameNameCol.identifier(),
 			HumanDisplayNameCol.identifier(),
 			SessionColumnPasswordCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 		).From(sessionsTable.identifier()).
@@ -207,13 +221,14 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session := new(Session)
 
 			var (
-				userID            sql.NullString
-				userCheckedAt     sql.NullTime
-				loginName         sql.NullString
-				displayName       sql.NullString
-				passwordCheckedAt sql.NullTime
-				metadata          database.Map[[]byte]
-				token             sql.NullString
+				userID             sql.NullString
+				userCheckedAt      sql.NullTime
+				loginName          sql.NullString
+				displayName        sql.NullString
+				passwordCheckedAt  sql.NullTime
+				magicLinkCheckedAt sql.NullTime
+				metadata           database.Map[[]byte]
+				token              sql.NullString
 			)
 
 			err := row.Scan(
@@ -229,6 +244,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&loginName,
 				&displayName,
 				&passwordCheckedAt,
+				&magicLinkCheckedAt,
 				&metadata,
 				&token,
 			)
@@ -245,6 +261,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session.UserFactor.LoginName = loginName.String
 			session.UserFactor.DisplayName = displayName.String
 			session.PasswordFactor.PasswordCheckedAt = passwordCheckedAt.Time
+			session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 			session.Metadata = metadata
 
 			return session, token.String, nil
@@ -265,6 +282,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			LoginNameNameCol.identifier(),
 			HumanDisplayNameCol.identifier(),
 			SessionColumnPasswordCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
@@ -277,12 +295,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session := new(Session)
 
 				var (
-					userID            sql.NullString
-					userCheckedAt     sql.NullTime
-					loginName         sql.NullString
-					displayName       sql.NullString
-					passwordCheckedAt sql.NullTime
-					metadata          database.Map[[]byte]
+					userID             sql.NullString
+					userCheckedAt      sql.NullTime
+					loginName          sql.NullString
+					displayName        sql.NullString
+					passwordCheckedAt  sql.NullTime
+					magicLinkCheckedAt sql.NullTime
+					metadata           database.Map[[]byte]
 				)
 
 				err := rows.Scan(
@@ -298,6 +317,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&loginName,
 					&displayName,
 					&passwordCheckedAt,
+					&magicLinkCheckedAt,
 					&metadata,
 					&sessions.Count,
 				)
@@ -310,6 +330,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session.UserFactor.LoginName = loginName.String
 				session.UserFactor.DisplayName = displayName.String
 				session.PasswordFactor.PasswordCheckedAt = passwordCheckedAt.Time
+				session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 				session.Metadata = metadata
 
 				sessions.Sessions = append(sessions.Sessions, session)
@@ -523,6 +523,7 @@ func (q *Queries) SearchUsers(ctx context.Context, queries *UserSearchQueries, w
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	client := q.readClient(ctx, false, userTable)
 	query, scan := prepareUsersQuery(ctx, q.client)
 	eq := sq.Eq{UserInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID()}
 	if !withOwnerRemoved {
@@ -534,7 +535,7 @@ func (q *Queries) SearchUsers(ctx context.Context, queries *UserSearchQueries, w
 		return nil, errors.ThrowInternal(err, "QUERY-Dgbg2", "Errors.Query.SQLStatment")
 	}
 
-	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	rows, err := client.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.ThrowInternal(err, "QUERY-AG4gs", "Errors.Internal")
 	}
@@ -689,6 +690,48 @@ func NewUserLoginNameExistsQuery(value string, comparison TextComparison) (Searc
 	)
 }
 
+// NewUserMetadataKeyExistsQuery searches for users with metadata of the given key
+func NewUserMetadataKeyExistsQuery(key string) (SearchQuery, error) {
+	return newUserMetadataExistsQuery(key)
+}
+
+// NewUserMetadataValueSearchQuery searches for users with metadata of the given key
+// whose value matches the comparison
+func NewUserMetadataValueSearchQuery(key string, value []byte, comparison BytesComparison) (SearchQuery, error) {
+	valueQuery, err := NewBytesQuery(UserMetadataValueCol, value, comparison)
+	if err != nil {
+		return nil, err
+	}
+	return newUserMetadataExistsQuery(key, valueQuery)
+}
+
+func newUserMetadataExistsQuery(key string, queries ...SearchQuery) (SearchQuery, error) {
+	//linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(UserMetadataInstanceIDCol, UserInstanceIDCol, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := NewColumnComparisonQuery(UserMetadataUserIDCol, UserIDCol, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	keyQuery, err := NewTextQuery(UserMetadataKeyCol, key, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	//full definition of the sub select
+	subSelect, err := NewSubSelect(UserMetadataUserIDCol, append([]SearchQuery{instanceQuery, userIDQuery, keyQuery}, queries...))
+	if err != nil {
+		return nil, err
+	}
+	// "WHERE * IN (*)" query with subquery as list-data provider
+	return NewListQuery(
+		UserIDCol,
+		subSelect,
+		ListIn,
+	)
+}
+
 func prepareLoginNamesQuery() (string, []interface{}, error) {
 	return sq.Select(
 		userLoginNamesUserIDCol.identifier(),
@@ -29,6 +29,10 @@ type UserGrant struct {
 	// GrantID represents the project grant id
 	GrantID string
 	State   domain.UserGrantState
+	// ValidFrom and ValidUntil restrict the time range in which the grant is effective
+	// a zero value means unrestricted
+	ValidFrom  time.Time
+	ValidUntil time.Time
 
 	UserID             string
 	Username           string
@@ -49,6 +53,18 @@ type UserGrant struct {
 	ProjectName string
 }
 
+// IsEffectiveAt returns true if the grant is neither pending approval nor expired
+// and t lies within its validity
+func (g *UserGrant) IsEffectiveAt(t time.Time) bool {
+	if g.State == domain.UserGrantStateRequested || g.State == domain.UserGrantStateExpired {
+		return false
+	}
+	if !g.ValidFrom.IsZero() && t.Before(g.ValidFrom) {
+		return false
+	}
+	return g.ValidUntil.IsZero() || t.Before(g.ValidUntil)
+}
+
 type UserGrants struct {
 	SearchResponse
 	UserGrants []*UserGrant
@@ -204,6 +220,14 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
 	UserGrantOwnerRemoved = Column{
 		name:  projection.UserGrantOwnerRemoved,
 		table: userGrantTable,
@@ -293,6 +317,32 @@ func (q *Queries) UserGrants(ctx context.Context, queries *UserGrantsQueries, sh
 	return grants, nil
 }
 
+// ExpiredUserGrants returns the active and inactive grants of the instance in ctx
+// whose validity ended before expiredBefore
+func (q *Queries) ExpiredUserGrants(ctx context.Context, expiredBefore time.Time) (_ *UserGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserGrantsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserGrantInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		UserGrantState.identifier():      []domain.UserGrantState{domain.UserGrantStateActive, domain.UserGrantStateInactive},
+	}
+	addUserGrantWithoutOwnerRemoved(eq)
+	stmt, args, err := query.Where(eq).
+		Where(sq.Lt{UserGrantValidUntil.identifier(): expiredBefore}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ex3bn", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ex4ns", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
 func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserGrant, error)) {
 	return sq.Select(
 			UserGrantID.identifier(),
@@ -302,6 +352,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -334,6 +386,9 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			g := new(UserGrant)
 
 			var (
+				validFrom  sql.NullTime
+				validUntil sql.NullTime
+
 				username           sql.NullString
 				firstName          sql.NullString
 				userType           sql.NullInt32
@@ -358,6 +413,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&g.GrantID,
 				&g.Roles,
 				&g.State,
+				&validFrom,
+				&validUntil,
 
 				&g.UserID,
 				&username,
@@ -384,6 +441,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				return nil, errors.ThrowInternal(err, "QUERY-oQPcP", "Errors.Internal")
 			}
 
+			g.ValidFrom = validFrom.Time
+			g.ValidUntil = validUntil.Time
 			g.Username = username.String
 			g.UserType = domain.UserType(userType.Int32)
 			g.UserResourceOwner = userOwner.String
@@ -410,6 +469,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -447,6 +508,9 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				g := new(UserGrant)
 
 				var (
+					validFrom  sql.NullTime
+					validUntil sql.NullTime
+
 					username           sql.NullString
 					userType           sql.NullInt32
 					userOwner          sql.NullString
@@ -471,6 +535,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&g.GrantID,
 					&g.Roles,
 					&g.State,
+					&validFrom,
+					&validUntil,
 
 					&g.UserID,
 					&username,
@@ -496,6 +562,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					return nil, err
 				}
 
+				g.ValidFrom = validFrom.Time
+				g.ValidUntil = validUntil.Time
 				g.Username = username.String
 				g.UserType = domain.UserType(userType.Int32)
 				g.UserResourceOwner = userOwner.String
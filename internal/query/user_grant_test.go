@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -15,14 +18,16 @@ import (
 
 var (
 	userGrantStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants3.id" +
-			", projections.user_grants3.creation_date" +
-			", projections.user_grants3.change_date" +
-			", projections.user_grants3.sequence" +
-			", projections.user_grants3.grant_id" +
-			", projections.user_grants3.roles" +
-			", projections.user_grants3.state" +
-			", projections.user_grants3.user_id" +
+		"SELECT projections.user_grants4.id" +
+			", projections.user_grants4.creation_date" +
+			", projections.user_grants4.change_date" +
+			", projections.user_grants4.sequence" +
+			", projections.user_grants4.grant_id" +
+			", projections.user_grants4.roles" +
+			", projections.user_grants4.state" +
+			", projections.user_grants4.valid_from" +
+			", projections.user_grants4.valid_until" +
+			", projections.user_grants4.user_id" +
 			", projections.users8.username" +
 			", projections.users8.type" +
 			", projections.users8.resource_owner" +
@@ -32,17 +37,17 @@ var (
 			", projections.users8_humans.display_name" +
 			", projections.users8_humans.avatar_key" +
 			", projections.login_names2.login_name" +
-			", projections.user_grants3.resource_owner" +
-			", projections.orgs.name" +
-			", projections.orgs.primary_domain" +
-			", projections.user_grants3.project_id" +
+			", projections.user_grants4.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", projections.user_grants4.project_id" +
 			", projections.projects3.name" +
-			" FROM projections.user_grants3" +
-			" LEFT JOIN projections.users8 ON projections.user_grants3.user_id = projections.users8.id AND projections.user_grants3.instance_id = projections.users8.instance_id" +
-			" LEFT JOIN projections.users8_humans ON projections.user_grants3.user_id = projections.users8_humans.user_id AND projections.user_grants3.instance_id = projections.users8_humans.instance_id" +
-			" LEFT JOIN projections.orgs ON projections.user_grants3.resource_owner = projections.orgs.id AND projections.user_grants3.instance_id = projections.orgs.instance_id" +
-			" LEFT JOIN projections.projects3 ON projections.user_grants3.project_id = projections.projects3.id AND projections.user_grants3.instance_id = projections.projects3.instance_id" +
-			" LEFT JOIN projections.login_names2 ON projections.user_grants3.user_id = projections.login_names2.user_id AND projections.user_grants3.instance_id = projections.login_names2.instance_id" +
+			" FROM projections.user_grants4" +
+			" LEFT JOIN projections.users8 ON projections.user_grants4.user_id = projections.users8.id AND projections.user_grants4.instance_id = projections.users8.instance_id" +
+			" LEFT JOIN projections.users8_humans ON projections.user_grants4.user_id = projections.users8_humans.user_id AND projections.user_grants4.instance_id = projections.users8_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON projections.user_grants4.resource_owner = projections.orgs1.id AND projections.user_grants4.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON projections.user_grants4.project_id = projections.projects3.id AND projections.user_grants4.instance_id = projections.projects3.instance_id" +
+			" LEFT JOIN projections.login_names2 ON projections.user_grants4.user_id = projections.login_names2.user_id AND projections.user_grants4.instance_id = projections.login_names2.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names2.is_primary = $1")
 	userGrantCols = []string{
@@ -53,6 +58,8 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"valid_from",
+		"valid_until",
 		"user_id",
 		"username",
 		"type",
@@ -70,14 +77,16 @@ var (
 		"name", //project name
 	}
 	userGrantsStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants3.id" +
-			", projections.user_grants3.creation_date" +
-			", projections.user_grants3.change_date" +
-			", projections.user_grants3.sequence" +
-			", projections.user_grants3.grant_id" +
-			", projections.user_grants3.roles" +
-			", projections.user_grants3.state" +
-			", projections.user_grants3.user_id" +
+		"SELECT projections.user_grants4.id" +
+			", projections.user_grants4.creation_date" +
+			", projections.user_grants4.change_date" +
+			", projections.user_grants4.sequence" +
+			", projections.user_grants4.grant_id" +
+			", projections.user_grants4.roles" +
+			", projections.user_grants4.state" +
+			", projections.user_grants4.valid_from" +
+			", projections.user_grants4.valid_until" +
+			", projections.user_grants4.user_id" +
 			", projections.users8.username" +
 			", projections.users8.type" +
 			", projections.users8.resource_owner" +
@@ -87,18 +96,18 @@ var (
 			", projections.users8_humans.display_name" +
 			", projections.users8_humans.avatar_key" +
 			", projections.login_names2.login_name" +
-			", projections.user_grants3.resource_owner" +
-			", projections.orgs.name" +
-			", projections.orgs.primary_domain" +
-			", projections.user_grants3.project_id" +
+			", projections.user_grants4.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", projections.user_grants4.project_id" +
 			", projections.projects3.name" +
 			", COUNT(*) OVER ()" +
-			" FROM projections.user_grants3" +
-			" LEFT JOIN projections.users8 ON projections.user_grants3.user_id = projections.users8.id AND projections.user_grants3.instance_id = projections.users8.instance_id" +
-			" LEFT JOIN projections.users8_humans ON projections.user_grants3.user_id = projections.users8_humans.user_id AND projections.user_grants3.instance_id = projections.users8_humans.instance_id" +
-			" LEFT JOIN projections.orgs ON projections.user_grants3.resource_owner = projections.orgs.id AND projections.user_grants3.instance_id = projections.orgs.instance_id" +
-			" LEFT JOIN projections.projects3 ON projections.user_grants3.project_id = projections.projects3.id AND projections.user_grants3.instance_id = projections.projects3.instance_id" +
-			" LEFT JOIN projections.login_names2 ON projections.user_grants3.user_id = projections.login_names2.user_id AND projections.user_grants3.instance_id = projections.login_names2.instance_id" +
+			" FROM projections.user_grants4" +
+			" LEFT JOIN projections.users8 ON projections.user_grants4.user_id = projections.users8.id AND projections.user_grants4.instance_id = projections.users8.instance_id" +
+			" LEFT JOIN projections.users8_humans ON projections.user_grants4.user_id = projections.users8_humans.user_id AND projections.user_grants4.instance_id = projections.users8_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON projections.user_grants4.resource_owner = projections.orgs1.id AND projections.user_grants4.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects3 ON projections.user_grants4.project_id = projections.projects3.id AND projections.user_grants4.instance_id = projections.projects3.instance_id" +
+			" LEFT JOIN projections.login_names2 ON projections.user_grants4.user_id = projections.login_names2.user_id AND projections.user_grants4.instance_id = projections.login_names2.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names2.is_primary = $1")
 	userGrantsCols = append(
@@ -151,6 +160,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -209,6 +220,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -267,6 +280,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -325,6 +340,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -383,6 +400,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -471,6 +490,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -538,6 +559,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -605,6 +628,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -672,6 +697,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -739,6 +766,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -806,6 +835,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -830,6 +861,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -929,3 +962,48 @@ func Test_UserGrantPrepares(t *testing.T) {
 		})
 	}
 }
+
+func TestUserGrant_IsEffectiveAt(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		grant *UserGrant
+		want  bool
+	}{
+		{
+			name:  "unrestricted",
+			grant: &UserGrant{State: domain.UserGrantStateActive},
+			want:  true,
+		},
+		{
+			name:  "requested",
+			grant: &UserGrant{State: domain.UserGrantStateRequested},
+			want:  false,
+		},
+		{
+			name:  "expired",
+			grant: &UserGrant{State: domain.UserGrantStateExpired},
+			want:  false,
+		},
+		{
+			name:  "not yet valid",
+			grant: &UserGrant{State: domain.UserGrantStateActive, ValidFrom: now.Add(time.Hour)},
+			want:  false,
+		},
+		{
+			name:  "validity ended",
+			grant: &UserGrant{State: domain.UserGrantStateActive, ValidUntil: now},
+			want:  false,
+		},
+		{
+			name:  "within validity",
+			grant: &UserGrant{State: domain.UserGrantStateActive, ValidFrom: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.grant.IsEffectiveAt(now))
+		})
+	}
+}
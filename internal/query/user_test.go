@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -1223,3 +1224,59 @@ func Test_UserPrepares(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserMetadataSearchQueries(t *testing.T) {
+	subSelect := `projections.users8.id IN ( SELECT projections.user_metadata4.user_id FROM projections.user_metadata4` +
+		` WHERE projections.user_metadata4.instance_id = projections.users8.instance_id` +
+		` AND projections.user_metadata4.user_id = projections.users8.id` +
+		` AND projections.user_metadata4.key = ?`
+	tests := []struct {
+		name     string
+		query    func() (SearchQuery, error)
+		wantStmt string
+		wantArgs []interface{}
+	}{
+		{
+			name: "key exists",
+			query: func() (SearchQuery, error) {
+				return NewUserMetadataKeyExistsQuery("tenant")
+			},
+			wantStmt: subSelect + ` )`,
+			wantArgs: []interface{}{"tenant"},
+		},
+		{
+			name: "value equals",
+			query: func() (SearchQuery, error) {
+				return NewUserMetadataValueSearchQuery("tenant", []byte("ab"), BytesEquals)
+			},
+			wantStmt: subSelect + ` AND projections.user_metadata4.value = ? )`,
+			wantArgs: []interface{}{"tenant", []byte("ab")},
+		},
+		{
+			name: "value starts with",
+			query: func() (SearchQuery, error) {
+				return NewUserMetadataValueSearchQuery("tenant", []byte("ab"), BytesStartsWith)
+			},
+			wantStmt: subSelect + ` AND (projections.user_metadata4.value >= ? AND projections.user_metadata4.value < ?) )`,
+			wantArgs: []interface{}{"tenant", []byte("ab"), []byte("ac")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := tt.query()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			stmt, args, err := query.comp().ToSql()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stmt != tt.wantStmt {
+				t.Errorf("wrong statement:\nwant: %s\ngot:  %s", tt.wantStmt, stmt)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("wrong args: want %v, got %v", tt.wantArgs, args)
+			}
+		})
+	}
+}
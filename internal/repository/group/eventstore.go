@@ -0,0 +1,16 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AggregateType, GroupAddedType, GroupAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GroupChangedType, GroupChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GroupRemovedType, GroupRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, MemberAddedType, MemberAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, MemberRemovedType, MemberRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantAddedType, GrantAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantChangedType, GrantChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, GrantRemovedType, GrantRemovedEventMapper)
+}
@@ -0,0 +1,189 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueGroupNameType  = "group_names"
+	groupEventTypePrefix = eventstore.EventType("group.")
+	GroupAddedType       = groupEventTypePrefix + "added"
+	GroupChangedType     = groupEventTypePrefix + "changed"
+	GroupRemovedType     = groupEventTypePrefix + "removed"
+)
+
+func NewAddGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupNameType,
+		name+resourceOwner,
+		"Errors.Group.AlreadyExists")
+}
+
+func NewRemoveGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueGroupNameType,
+		name+resourceOwner)
+}
+
+type GroupAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *GroupAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *GroupAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddGroupNameUniqueConstraint(e.Name, e.Aggregate().ResourceOwner)}
+}
+
+func NewGroupAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	description string,
+) *GroupAddedEvent {
+	return &GroupAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupAddedType,
+		),
+		Name:        name,
+		Description: description,
+	}
+}
+
+func GroupAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &GroupAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Ad2nf", "unable to unmarshal group")
+	}
+
+	return e, nil
+}
+
+type GroupChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	oldName     string
+}
+
+func (e *GroupChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *GroupChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	if e.oldName != "" && e.Name != nil {
+		return []*eventstore.EventUniqueConstraint{
+			NewRemoveGroupNameUniqueConstraint(e.oldName, e.Aggregate().ResourceOwner),
+			NewAddGroupNameUniqueConstraint(*e.Name, e.Aggregate().ResourceOwner),
+		}
+	}
+	return nil
+}
+
+func NewGroupChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	oldName string,
+	changes []GroupChanges,
+) (*GroupChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "GROUP-Ch3mf", "Errors.NoChangesFound")
+	}
+	changeEvent := &GroupChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupChangedType,
+		),
+		oldName: oldName,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type GroupChanges func(event *GroupChangedEvent)
+
+func ChangeName(name string) func(event *GroupChangedEvent) {
+	return func(e *GroupChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeDescription(description string) func(event *GroupChangedEvent) {
+	return func(e *GroupChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func GroupChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &GroupChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Ch4ng", "unable to unmarshal group")
+	}
+
+	return e, nil
+}
+
+type GroupRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	name                  string
+	memberAndGrantUniques []*eventstore.EventUniqueConstraint
+}
+
+func (e *GroupRemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *GroupRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	constraints := []*eventstore.EventUniqueConstraint{NewRemoveGroupNameUniqueConstraint(e.name, e.Aggregate().ResourceOwner)}
+	return append(constraints, e.memberAndGrantUniques...)
+}
+
+// NewGroupRemovedEvent removes the group with all its members and grants,
+// memberAndGrantUniques releases their unique constraints
+func NewGroupRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+	memberAndGrantUniques []*eventstore.EventUniqueConstraint,
+) *GroupRemovedEvent {
+	return &GroupRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupRemovedType,
+		),
+		name:                  name,
+		memberAndGrantUniques: memberAndGrantUniques,
+	}
+}
+
+func GroupRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &GroupRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueGroupMemberType = "group_members"
+	memberEventTypePrefix = groupEventTypePrefix + "member."
+	MemberAddedType       = memberEventTypePrefix + "added"
+	MemberRemovedType     = memberEventTypePrefix + "removed"
+)
+
+func NewAddGroupMemberUniqueConstraint(groupID, userID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupMemberType,
+		groupID+userID,
+		"Errors.Group.Member.AlreadyExists")
+}
+
+func NewRemoveGroupMemberUniqueConstraint(groupID, userID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueGroupMemberType,
+		groupID+userID)
+}
+
+type MemberAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId,omitempty"`
+}
+
+func (e *MemberAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MemberAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddGroupMemberUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewMemberAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *MemberAddedEvent {
+	return &MemberAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MemberAddedType,
+		),
+		UserID: userID,
+	}
+}
+
+func MemberAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &MemberAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Me2ad", "unable to unmarshal group member")
+	}
+
+	return e, nil
+}
+
+type MemberRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId,omitempty"`
+}
+
+func (e *MemberRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MemberRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveGroupMemberUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewMemberRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *MemberRemovedEvent {
+	return &MemberRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MemberRemovedType,
+		),
+		UserID: userID,
+	}
+}
+
+func MemberRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &MemberRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "GROUP-Me3rm", "unable to unmarshal group member")
+	}
+
+	return e, nil
+}
@@ -349,3 +349,52 @@ func LDAPIDPChangedEventMapper(event *repository.Event) (eventstore.Event, error
 
 	return e, nil
 }
+
+type LDAPIDPSyncedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string   `json:"id"`
+	Created     int      `json:"created"`
+	Updated     int      `json:"updated"`
+	Deactivated int      `json:"deactivated"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+func NewLDAPIDPSyncedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	created,
+	updated,
+	deactivated int,
+	errs []string,
+) *LDAPIDPSyncedEvent {
+	return &LDAPIDPSyncedEvent{
+		BaseEvent:   *base,
+		ID:          id,
+		Created:     created,
+		Updated:     updated,
+		Deactivated: deactivated,
+		Errors:      errs,
+	}
+}
+
+func (e *LDAPIDPSyncedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LDAPIDPSyncedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func LDAPIDPSyncedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LDAPIDPSyncedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IDP-Ls9yn", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,184 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueCustomRole           = "custom_role"
+	customRolePrefix           = "custom.role."
+	CustomRoleAddedEventType   = instanceEventTypePrefix + customRolePrefix + "added"
+	CustomRoleChangedEventType = instanceEventTypePrefix + customRolePrefix + "changed"
+	CustomRoleRemovedEventType = instanceEventTypePrefix + customRolePrefix + "removed"
+)
+
+func NewAddCustomRoleUniqueConstraint(role string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueCustomRole,
+		role,
+		"Errors.CustomRole.AlreadyExists")
+}
+
+func NewRemoveCustomRoleUniqueConstraint(role string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueCustomRole,
+		role)
+}
+
+type CustomRoleAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role        string   `json:"role"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func NewCustomRoleAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role,
+	displayName string,
+	permissions []string,
+) *CustomRoleAddedEvent {
+	return &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleAddedEventType,
+		),
+		Role:        role,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
+
+func (e *CustomRoleAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddCustomRoleUniqueConstraint(e.Role)}
+}
+
+func CustomRoleAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Sk3nf", "unable to unmarshal custom role added")
+	}
+
+	return e, nil
+}
+
+type CustomRoleChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role        string   `json:"role"`
+	DisplayName *string  `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+func NewCustomRoleChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role string,
+	changes []CustomRoleChanges,
+) (*CustomRoleChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IAM-Ms0fw", "Errors.NoChangesFound")
+	}
+	changeEvent := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleChangedEventType,
+		),
+		Role: role,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type CustomRoleChanges func(event *CustomRoleChangedEvent)
+
+func ChangeCustomRoleDisplayName(displayName string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.DisplayName = &displayName
+	}
+}
+
+func ChangeCustomRolePermissions(permissions []string) func(event *CustomRoleChangedEvent) {
+	return func(e *CustomRoleChangedEvent) {
+		e.Permissions = permissions
+	}
+}
+
+func (e *CustomRoleChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func CustomRoleChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Pq2nd", "unable to unmarshal custom role changed")
+	}
+
+	return e, nil
+}
+
+type CustomRoleRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Role string `json:"role"`
+}
+
+func NewCustomRoleRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	role string,
+) *CustomRoleRemovedEvent {
+	return &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomRoleRemovedEventType,
+		),
+		Role: role,
+	}
+}
+
+func (e *CustomRoleRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *CustomRoleRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveCustomRoleUniqueConstraint(e.Role)}
+}
+
+func CustomRoleRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &CustomRoleRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Ld2kq", "unable to unmarshal custom role removed")
+	}
+
+	return e, nil
+}
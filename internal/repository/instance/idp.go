@@ -31,6 +31,7 @@ const (
 	GoogleIDPChangedEventType           eventstore.EventType = "instance.idp.google.changed"
 	LDAPIDPAddedEventType               eventstore.EventType = "instance.idp.ldap.v2.added"
 	LDAPIDPChangedEventType             eventstore.EventType = "instance.idp.ldap.v2.changed"
+	LDAPIDPSyncedEventType              eventstore.EventType = "instance.idp.ldap.v2.synced"
 	IDPRemovedEventType                 eventstore.EventType = "instance.idp.removed"
 )
 
@@ -834,6 +835,45 @@ func LDAPIDPChangedEventMapper(event *repository.Event) (eventstore.Event, error
 	return &LDAPIDPChangedEvent{LDAPIDPChangedEvent: *e.(*idp.LDAPIDPChangedEvent)}, nil
 }
 
+type LDAPIDPSyncedEvent struct {
+	idp.LDAPIDPSyncedEvent
+}
+
+func NewLDAPIDPSyncedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	created,
+	updated,
+	deactivated int,
+	errs []string,
+) *LDAPIDPSyncedEvent {
+
+	return &LDAPIDPSyncedEvent{
+		LDAPIDPSyncedEvent: *idp.NewLDAPIDPSyncedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				LDAPIDPSyncedEventType,
+			),
+			id,
+			created,
+			updated,
+			deactivated,
+			errs,
+		),
+	}
+}
+
+func LDAPIDPSyncedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idp.LDAPIDPSyncedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LDAPIDPSyncedEvent{LDAPIDPSyncedEvent: *e.(*idp.LDAPIDPSyncedEvent)}, nil
+}
+
 type IDPRemovedEvent struct {
 	idp.RemovedEvent
 }
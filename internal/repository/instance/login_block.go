@@ -0,0 +1,60 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	LoginBlockClearedEventType eventstore.EventType = "instance.login.block.cleared"
+)
+
+// LoginBlockClearedEvent resets the failed login attempts of an IP address or a user
+type LoginBlockClearedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	KeyType domain.LoginBlockKeyType `json:"keyType"`
+	Key     string                   `json:"key"`
+}
+
+func (e *LoginBlockClearedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LoginBlockClearedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLoginBlockClearedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	keyType domain.LoginBlockKeyType,
+	key string,
+) *LoginBlockClearedEvent {
+	return &LoginBlockClearedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LoginBlockClearedEventType,
+		),
+		KeyType: keyType,
+		Key:     key,
+	}
+}
+
+func LoginBlockClearedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LoginBlockClearedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "INSTANCE-Blk3c", "unable to unmarshal login block cleared")
+	}
+
+	return e, nil
+}
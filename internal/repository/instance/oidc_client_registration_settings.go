@@ -0,0 +1,59 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	OIDCClientRegistrationSettingsSetEventType = instanceEventTypePrefix + "oidc.client.registration.settings.set"
+)
+
+type OIDCClientRegistrationSettingsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AllowedGrantTypes          []domain.OIDCGrantType `json:"allowedGrantTypes,omitempty"`
+	AllowedRedirectURIPrefixes []string               `json:"allowedRedirectUriPrefixes,omitempty"`
+}
+
+func (e *OIDCClientRegistrationSettingsSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *OIDCClientRegistrationSettingsSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOIDCClientRegistrationSettingsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	allowedGrantTypes []domain.OIDCGrantType,
+	allowedRedirectURIPrefixes []string,
+) *OIDCClientRegistrationSettingsSetEvent {
+	return &OIDCClientRegistrationSettingsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCClientRegistrationSettingsSetEventType,
+		),
+		AllowedGrantTypes:          allowedGrantTypes,
+		AllowedRedirectURIPrefixes: allowedRedirectURIPrefixes,
+	}
+}
+
+func OIDCClientRegistrationSettingsSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OIDCClientRegistrationSettingsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Rg3kq", "unable to unmarshal oidc client registration settings set")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,51 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	LoginPolicyRiskRulesSetEventType = instanceEventTypePrefix + policy.LoginPolicyRiskRulesSetEventType
+)
+
+type LoginPolicyRiskRulesSetEvent struct {
+	policy.RiskRulesSetEvent
+}
+
+func NewLoginPolicyRiskRulesSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	rules *domain.RiskRules,
+) *LoginPolicyRiskRulesSetEvent {
+	return &LoginPolicyRiskRulesSetEvent{
+		RiskRulesSetEvent: *policy.NewRiskRulesSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				LoginPolicyRiskRulesSetEventType),
+			rules.Enabled,
+			rules.NewDevice,
+			rules.NewNetwork,
+			rules.NewCountry,
+			rules.ImpossibleTravelSpeed,
+			rules.MaxRecentFailures,
+			rules.RecentFailuresWindow,
+		),
+	}
+}
+
+func RiskRulesSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := policy.RiskRulesSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginPolicyRiskRulesSetEvent{
+		RiskRulesSetEvent: *e.(*policy.RiskRulesSetEvent),
+	}, nil
+}
@@ -22,7 +22,9 @@ func NewPasswordAgePolicyAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	expireWarnDays,
-	maxAgeDays uint64,
+	maxAgeDays,
+	historyCount,
+	minAgeDays uint64,
 ) *PasswordAgePolicyAddedEvent {
 	return &PasswordAgePolicyAddedEvent{
 		PasswordAgePolicyAddedEvent: *policy.NewPasswordAgePolicyAddedEvent(
@@ -31,7 +33,9 @@ func NewPasswordAgePolicyAddedEvent(
 				aggregate,
 				PasswordAgePolicyAddedEventType),
 			expireWarnDays,
-			maxAgeDays),
+			maxAgeDays,
+			historyCount,
+			minAgeDays),
 	}
 }
 
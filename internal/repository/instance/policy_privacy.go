@@ -25,6 +25,7 @@ func NewPrivacyPolicyAddedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDelete bool,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
 		PrivacyPolicyAddedEvent: *policy.NewPrivacyPolicyAddedEvent(
@@ -35,7 +36,8 @@ func NewPrivacyPolicyAddedEvent(
 			tosLink,
 			privacyLink,
 			helpLink,
-			supportEmail),
+			supportEmail,
+			allowSelfDelete),
 	}
 }
 
@@ -0,0 +1,53 @@
+package org
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	OrgParentChangedEventType = orgEventTypePrefix + "parent.changed"
+)
+
+// OrgParentChangedEvent places the organisation below the parent organisation.
+// An empty ParentOrgID moves the organisation back to the top level of the instance.
+type OrgParentChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId,omitempty"`
+}
+
+func (e *OrgParentChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OrgParentChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOrgParentChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentChangedEvent {
+	return &OrgParentChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentChangedEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
+
+func OrgParentChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	parentChanged := &OrgParentChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, parentChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ORG-Pq3sd", "unable to unmarshal org parent changed")
+	}
+
+	return parentChanged, nil
+}
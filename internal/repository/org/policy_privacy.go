@@ -26,6 +26,7 @@ func NewPrivacyPolicyAddedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDelete bool,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
 		PrivacyPolicyAddedEvent: *policy.NewPrivacyPolicyAddedEvent(
@@ -36,7 +37,8 @@ func NewPrivacyPolicyAddedEvent(
 			tosLink,
 			privacyLink,
 			helpLink,
-			supportEmail),
+			supportEmail,
+			allowSelfDelete),
 	}
 }
 
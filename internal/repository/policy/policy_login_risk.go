@@ -0,0 +1,70 @@
+package policy
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	loginPolicyRiskRulesPrefix       = loginPolicyPrefix + "risk.rules."
+	LoginPolicyRiskRulesSetEventType = loginPolicyRiskRulesPrefix + "set"
+)
+
+type RiskRulesSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Enabled               bool          `json:"enabled,omitempty"`
+	NewDevice             bool          `json:"newDevice,omitempty"`
+	NewNetwork            bool          `json:"newNetwork,omitempty"`
+	NewCountry            bool          `json:"newCountry,omitempty"`
+	ImpossibleTravelSpeed uint32        `json:"impossibleTravelSpeed,omitempty"`
+	MaxRecentFailures     uint32        `json:"maxRecentFailures,omitempty"`
+	RecentFailuresWindow  time.Duration `json:"recentFailuresWindow,omitempty"`
+}
+
+func NewRiskRulesSetEvent(
+	base *eventstore.BaseEvent,
+	enabled,
+	newDevice,
+	newNetwork,
+	newCountry bool,
+	impossibleTravelSpeed,
+	maxRecentFailures uint32,
+	recentFailuresWindow time.Duration,
+) *RiskRulesSetEvent {
+	return &RiskRulesSetEvent{
+		BaseEvent:             *base,
+		Enabled:               enabled,
+		NewDevice:             newDevice,
+		NewNetwork:            newNetwork,
+		NewCountry:            newCountry,
+		ImpossibleTravelSpeed: impossibleTravelSpeed,
+		MaxRecentFailures:     maxRecentFailures,
+		RecentFailuresWindow:  recentFailuresWindow,
+	}
+}
+
+func RiskRulesSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &RiskRulesSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "POLIC-Rsk3e", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+func (e *RiskRulesSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *RiskRulesSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
@@ -19,6 +19,8 @@ type PasswordAgePolicyAddedEvent struct {
 
 	ExpireWarnDays uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     uint64 `json:"maxAgeDays,omitempty"`
+	HistoryCount   uint64 `json:"historyCount,omitempty"`
+	MinAgeDays     uint64 `json:"minAgeDays,omitempty"`
 }
 
 func (e *PasswordAgePolicyAddedEvent) Data() interface{} {
@@ -32,13 +34,17 @@ func (e *PasswordAgePolicyAddedEvent) UniqueConstraints() []*eventstore.EventUni
 func NewPasswordAgePolicyAddedEvent(
 	base *eventstore.BaseEvent,
 	expireWarnDays,
-	maxAgeDays uint64,
+	maxAgeDays,
+	historyCount,
+	minAgeDays uint64,
 ) *PasswordAgePolicyAddedEvent {
 
 	return &PasswordAgePolicyAddedEvent{
 		BaseEvent:      *base,
 		ExpireWarnDays: expireWarnDays,
 		MaxAgeDays:     maxAgeDays,
+		HistoryCount:   historyCount,
+		MinAgeDays:     minAgeDays,
 	}
 }
 
@@ -60,6 +66,8 @@ type PasswordAgePolicyChangedEvent struct {
 
 	ExpireWarnDays *uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     *uint64 `json:"maxAgeDays,omitempty"`
+	HistoryCount   *uint64 `json:"historyCount,omitempty"`
+	MinAgeDays     *uint64 `json:"minAgeDays,omitempty"`
 }
 
 func (e *PasswordAgePolicyChangedEvent) Data() interface{} {
@@ -100,6 +108,18 @@ func ChangeMaxAgeDays(maxAgeDays uint64) func(*PasswordAgePolicyChangedEvent) {
 	}
 }
 
+func ChangeHistoryCount(historyCount uint64) func(*PasswordAgePolicyChangedEvent) {
+	return func(e *PasswordAgePolicyChangedEvent) {
+		e.HistoryCount = &historyCount
+	}
+}
+
+func ChangeMinAgeDays(minAgeDays uint64) func(*PasswordAgePolicyChangedEvent) {
+	return func(e *PasswordAgePolicyChangedEvent) {
+		e.MinAgeDays = &minAgeDays
+	}
+}
+
 func PasswordAgePolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &PasswordAgePolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
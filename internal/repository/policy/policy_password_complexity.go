@@ -23,6 +23,8 @@ type PasswordComplexityPolicyAddedEvent struct {
 	HasUppercase bool   `json:"hasUppercase,omitempty"`
 	HasNumber    bool   `json:"hasNumber,omitempty"`
 	HasSymbol    bool   `json:"hasSymbol,omitempty"`
+	// CheckBreached rejects passwords found in known data breaches
+	CheckBreached bool `json:"checkBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyAddedEvent) Data() interface{} {
@@ -39,15 +41,17 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasLowerCase,
 	hasUpperCase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
-		BaseEvent:    *base,
-		MinLength:    minLength,
-		HasLowercase: hasLowerCase,
-		HasUppercase: hasUpperCase,
-		HasNumber:    hasNumber,
-		HasSymbol:    hasSymbol,
+		BaseEvent:     *base,
+		MinLength:     minLength,
+		HasLowercase:  hasLowerCase,
+		HasUppercase:  hasUpperCase,
+		HasNumber:     hasNumber,
+		HasSymbol:     hasSymbol,
+		CheckBreached: checkBreached,
 	}
 }
 
@@ -72,6 +76,8 @@ type PasswordComplexityPolicyChangedEvent struct {
 	HasUppercase *bool   `json:"hasUppercase,omitempty"`
 	HasNumber    *bool   `json:"hasNumber,omitempty"`
 	HasSymbol    *bool   `json:"hasSymbol,omitempty"`
+	// CheckBreached rejects passwords found in known data breaches
+	CheckBreached *bool `json:"checkBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyChangedEvent) Data() interface{} {
@@ -130,6 +136,12 @@ func ChangeHasSymbol(hasSymbol bool) func(*PasswordComplexityPolicyChangedEvent)
 	}
 }
 
+func ChangeCheckBreached(checkBreached bool) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.CheckBreached = &checkBreached
+	}
+}
+
 func PasswordComplexityPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &PasswordComplexityPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
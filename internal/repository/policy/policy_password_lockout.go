@@ -19,6 +19,7 @@ type LockoutPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
 	MaxPasswordAttempts uint64 `json:"maxPasswordAttempts,omitempty"`
+	MaxMFAAttempts      uint64 `json:"maxMFAAttempts,omitempty"`
 	ShowLockOutFailures bool   `json:"showLockOutFailures,omitempty"`
 }
 
@@ -32,13 +33,15 @@ func (e *LockoutPolicyAddedEvent) UniqueConstraints() []*eventstore.EventUniqueC
 
 func NewLockoutPolicyAddedEvent(
 	base *eventstore.BaseEvent,
-	maxAttempts uint64,
+	maxAttempts,
+	maxMFAAttempts uint64,
 	showLockOutFailures bool,
 ) *LockoutPolicyAddedEvent {
 
 	return &LockoutPolicyAddedEvent{
 		BaseEvent:           *base,
 		MaxPasswordAttempts: maxAttempts,
+		MaxMFAAttempts:      maxMFAAttempts,
 		ShowLockOutFailures: showLockOutFailures,
 	}
 }
@@ -60,6 +63,7 @@ type LockoutPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
 	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
+	MaxMFAAttempts      *uint64 `json:"maxMFAAttempts,omitempty"`
 	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
 }
 
@@ -95,6 +99,12 @@ func ChangeMaxAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
 	}
 }
 
+func ChangeMaxMFAAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxMFAAttempts = &maxAttempts
+	}
+}
+
 func ChangeShowLockOutFailures(showLockOutFailures bool) func(*LockoutPolicyChangedEvent) {
 	return func(e *LockoutPolicyChangedEvent) {
 		e.ShowLockOutFailures = &showLockOutFailures
@@ -22,6 +22,8 @@ type PrivacyPolicyAddedEvent struct {
 	PrivacyLink  string              `json:"privacyLink,omitempty"`
 	HelpLink     string              `json:"helpLink,omitempty"`
 	SupportEmail domain.EmailAddress `json:"supportEmail,omitempty"`
+	// AllowSelfDelete allows the users to delete their own account
+	AllowSelfDelete bool `json:"allowSelfDelete,omitempty"`
 }
 
 func (e *PrivacyPolicyAddedEvent) Data() interface{} {
@@ -38,13 +40,15 @@ func NewPrivacyPolicyAddedEvent(
 	privacyLink,
 	helpLink string,
 	supportEmail domain.EmailAddress,
+	allowSelfDelete bool,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
-		BaseEvent:    *base,
-		TOSLink:      tosLink,
-		PrivacyLink:  privacyLink,
-		HelpLink:     helpLink,
-		SupportEmail: supportEmail,
+		BaseEvent:       *base,
+		TOSLink:         tosLink,
+		PrivacyLink:     privacyLink,
+		HelpLink:        helpLink,
+		SupportEmail:    supportEmail,
+		AllowSelfDelete: allowSelfDelete,
 	}
 }
 
@@ -63,10 +67,11 @@ func PrivacyPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, e
 type PrivacyPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	TOSLink      *string              `json:"tosLink,omitempty"`
-	PrivacyLink  *string              `json:"privacyLink,omitempty"`
-	HelpLink     *string              `json:"helpLink,omitempty"`
-	SupportEmail *domain.EmailAddress `json:"supportEmail,omitempty"`
+	TOSLink         *string              `json:"tosLink,omitempty"`
+	PrivacyLink     *string              `json:"privacyLink,omitempty"`
+	HelpLink        *string              `json:"helpLink,omitempty"`
+	SupportEmail    *domain.EmailAddress `json:"supportEmail,omitempty"`
+	AllowSelfDelete *bool                `json:"allowSelfDelete,omitempty"`
 }
 
 func (e *PrivacyPolicyChangedEvent) Data() interface{} {
@@ -119,6 +124,12 @@ func ChangeSupportEmail(supportEmail domain.EmailAddress) func(*PrivacyPolicyCha
 	}
 }
 
+func ChangeAllowSelfDelete(allowSelfDelete bool) func(*PrivacyPolicyChangedEvent) {
+	return func(e *PrivacyPolicyChangedEvent) {
+		e.AllowSelfDelete = &allowSelfDelete
+	}
+}
+
 func PrivacyPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &PrivacyPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -43,5 +43,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, ApplicationKeyAddedEventType, ApplicationKeyAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper).
-		RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+		RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, OIDCInitialAccessTokenAddedType, OIDCInitialAccessTokenAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, OIDCInitialAccessTokenRemovedType, OIDCInitialAccessTokenRemovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, OIDCRegistrationAccessTokenSetType, OIDCRegistrationAccessTokenSetEventMapper)
 }
@@ -0,0 +1,152 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+)
+
+const (
+	oidcInitialAccessTokenEventTypePrefix = projectEventTypePrefix + "oidc.initial.access.token."
+	OIDCInitialAccessTokenAddedType       = oidcInitialAccessTokenEventTypePrefix + "added"
+	OIDCInitialAccessTokenRemovedType     = oidcInitialAccessTokenEventTypePrefix + "removed"
+
+	OIDCRegistrationAccessTokenSetType = applicationEventTypePrefix + "oidc.registration.access.token.set"
+)
+
+// OIDCInitialAccessTokenAddedEvent allows the registration of oidc clients
+// on the project by using the dynamic client registration endpoint
+type OIDCInitialAccessTokenAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID        string    `json:"tokenId"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+func (e *OIDCInitialAccessTokenAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OIDCInitialAccessTokenAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOIDCInitialAccessTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+	expirationDate time.Time,
+) *OIDCInitialAccessTokenAddedEvent {
+	return &OIDCInitialAccessTokenAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCInitialAccessTokenAddedType,
+		),
+		TokenID:        tokenID,
+		ExpirationDate: expirationDate,
+	}
+}
+
+func OIDCInitialAccessTokenAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OIDCInitialAccessTokenAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-Kf8sw", "unable to unmarshal initial access token added")
+	}
+
+	return e, nil
+}
+
+type OIDCInitialAccessTokenRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+}
+
+func (e *OIDCInitialAccessTokenRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OIDCInitialAccessTokenRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOIDCInitialAccessTokenRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+) *OIDCInitialAccessTokenRemovedEvent {
+	return &OIDCInitialAccessTokenRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCInitialAccessTokenRemovedType,
+		),
+		TokenID: tokenID,
+	}
+}
+
+func OIDCInitialAccessTokenRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OIDCInitialAccessTokenRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-2nFsk", "unable to unmarshal initial access token removed")
+	}
+
+	return e, nil
+}
+
+// OIDCRegistrationAccessTokenSetEvent binds a (new) registration access token
+// to a dynamically registered client. Previously issued tokens are invalidated.
+type OIDCRegistrationAccessTokenSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID   string `json:"appId"`
+	TokenID string `json:"tokenId"`
+}
+
+func (e *OIDCRegistrationAccessTokenSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *OIDCRegistrationAccessTokenSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOIDCRegistrationAccessTokenSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	tokenID string,
+) *OIDCRegistrationAccessTokenSetEvent {
+	return &OIDCRegistrationAccessTokenSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCRegistrationAccessTokenSetType,
+		),
+		AppID:   appID,
+		TokenID: tokenID,
+	}
+}
+
+func OIDCRegistrationAccessTokenSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OIDCRegistrationAccessTokenSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PROJECT-M2pd0", "unable to unmarshal registration access token set")
+	}
+
+	return e, nil
+}
@@ -11,13 +11,14 @@ import (
 )
 
 const (
-	sessionEventPrefix  = "session."
-	AddedType           = sessionEventPrefix + "added"
-	UserCheckedType     = sessionEventPrefix + "user.checked"
-	PasswordCheckedType = sessionEventPrefix + "password.checked"
-	TokenSetType        = sessionEventPrefix + "token.set"
-	MetadataSetType     = sessionEventPrefix + "metadata.set"
-	TerminateType       = sessionEventPrefix + "terminated"
+	sessionEventPrefix   = "session."
+	AddedType            = sessionEventPrefix + "added"
+	UserCheckedType      = sessionEventPrefix + "user.checked"
+	PasswordCheckedType  = sessionEventPrefix + "password.checked"
+	MagicLinkCheckedType = sessionEventPrefix + "magiclink.checked"
+	TokenSetType         = sessionEventPrefix + "token.set"
+	MetadataSetType      = sessionEventPrefix + "metadata.set"
+	TerminateType        = sessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -141,6 +142,47 @@ func PasswordCheckedEventMapper(event *repository.Event) (eventstore.Event, erro
 	return added, nil
 }
 
+type MagicLinkCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *MagicLinkCheckedEvent) Data() interface{} {
+	return e
+}
+
+func (e *MagicLinkCheckedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewMagicLinkCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *MagicLinkCheckedEvent {
+	return &MagicLinkCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+func MagicLinkCheckedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	added := &MagicLinkCheckedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, added)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "SESSION-Mlk4d", "unable to unmarshal magic link checked")
+	}
+
+	return added, nil
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
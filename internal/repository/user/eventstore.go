@@ -60,6 +60,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, HumanPasswordCodeAddedType, HumanPasswordCodeAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordCodeSentType, HumanPasswordCodeSentEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordChangeSentType, HumanPasswordChangeSentEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanPasswordBreachedType, HumanPasswordBreachedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanPasswordBreachSentType, HumanPasswordBreachSentEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper).
 		RegisterFilterEventMapper(AggregateType, UserIDPLinkAddedType, UserIDPLinkAddedEventMapper).
@@ -106,6 +108,10 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeSentType, HumanPasswordlessInitCodeSentEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeCheckFailedType, HumanPasswordlessInitCodeCodeCheckFailedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanPasswordlessInitCodeCheckSucceededType, HumanPasswordlessInitCodeCodeCheckSucceededEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeAddedType, HumanMagicLinkCodeAddedEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeSentType, HumanMagicLinkCodeSentEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckSucceededType, HumanMagicLinkCheckSucceededEventMapper).
+		RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckFailedType, HumanMagicLinkCheckFailedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenAddedType, HumanRefreshTokenAddedEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRenewedType, HumanRefreshTokenRenewedEventEventMapper).
 		RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRemovedType, HumanRefreshTokenRemovedEventEventMapper).
@@ -119,4 +125,36 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, MachineSecretRemovedType, MachineSecretRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, MachineSecretCheckSucceededType, MachineSecretCheckSucceededEventMapper).
 		RegisterFilterEventMapper(AggregateType, MachineSecretCheckFailedType, MachineSecretCheckFailedEventMapper)
+
+	registerPersonalDataFields(es)
+}
+
+var (
+	profileFields = []string{"firstName", "lastName", "nickName", "displayName", "gender"}
+	emailFields   = []string{"email"}
+	phoneFields   = []string{"phone"}
+	addressFields = []string{"country", "locality", "postalCode", "region", "streetAddress"}
+)
+
+// registerPersonalDataFields registers the fields of the user events containing personal data,
+// so they are unreadable after the user was removed
+func registerPersonalDataFields(es *eventstore.Eventstore) {
+	humanFields := make([]string, 0, len(profileFields)+len(emailFields)+len(phoneFields)+len(addressFields))
+	humanFields = append(humanFields, profileFields...)
+	humanFields = append(humanFields, emailFields...)
+	humanFields = append(humanFields, phoneFields...)
+	humanFields = append(humanFields, addressFields...)
+
+	es.RegisterPersonalDataFields(UserV1AddedType, humanFields...).
+		RegisterPersonalDataFields(UserV1RegisteredType, humanFields...).
+		RegisterPersonalDataFields(HumanAddedType, humanFields...).
+		RegisterPersonalDataFields(HumanRegisteredType, humanFields...).
+		RegisterPersonalDataFields(UserV1ProfileChangedType, profileFields...).
+		RegisterPersonalDataFields(HumanProfileChangedType, profileFields...).
+		RegisterPersonalDataFields(UserV1EmailChangedType, emailFields...).
+		RegisterPersonalDataFields(HumanEmailChangedType, emailFields...).
+		RegisterPersonalDataFields(UserV1PhoneChangedType, phoneFields...).
+		RegisterPersonalDataFields(HumanPhoneChangedType, phoneFields...).
+		RegisterPersonalDataFields(UserV1AddressChangedType, addressFields...).
+		RegisterPersonalDataFields(HumanAddressChangedType, addressFields...)
 }
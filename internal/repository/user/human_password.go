@@ -21,6 +21,8 @@ const (
 	HumanPasswordCodeSentType       = passwordEventPrefix + "code.sent"
 	HumanPasswordCheckSucceededType = passwordEventPrefix + "check.succeeded"
 	HumanPasswordCheckFailedType    = passwordEventPrefix + "check.failed"
+	HumanPasswordBreachedType       = passwordEventPrefix + "breached"
+	HumanPasswordBreachSentType     = passwordEventPrefix + "breach.sent"
 )
 
 type HumanPasswordChangedEvent struct {
@@ -173,6 +175,63 @@ func HumanPasswordChangeSentEventMapper(event *repository.Event) (eventstore.Eve
 	}, nil
 }
 
+// HumanPasswordBreachedEvent is pushed if the current password of the user was found in known data breaches on login
+type HumanPasswordBreachedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasswordBreachedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanPasswordBreachedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordBreachedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasswordBreachedEvent {
+	return &HumanPasswordBreachedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordBreachedType,
+		),
+	}
+}
+
+func HumanPasswordBreachedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanPasswordBreachedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type HumanPasswordBreachSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasswordBreachSentEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanPasswordBreachSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordBreachSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasswordBreachSentEvent {
+	return &HumanPasswordBreachSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordBreachSentType,
+		),
+	}
+}
+
+func HumanPasswordBreachSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanPasswordBreachSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
 type HumanPasswordCheckSucceededEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	*AuthRequestInfo
@@ -11,5 +11,10 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(AggregateType, UserGrantRemovedType, UserGrantRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, UserGrantCascadeRemovedType, UserGrantCascadeRemovedEventMapper).
 		RegisterFilterEventMapper(AggregateType, UserGrantDeactivatedType, UserGrantDeactivatedEventMapper).
-		RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper)
+		RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantValidityChangedType, UserGrantValidityChangedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantExpiredType, UserGrantExpiredEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRequestedType, UserGrantRequestedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantApprovedType, UserGrantApprovedEventMapper).
+		RegisterFilterEventMapper(AggregateType, UserGrantRejectedType, UserGrantRejectedEventMapper)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 
@@ -12,15 +13,20 @@ import (
 )
 
 const (
-	UniqueUserGrant             = "user_grant"
-	userGrantEventTypePrefix    = eventstore.EventType("user.grant.")
-	UserGrantAddedType          = userGrantEventTypePrefix + "added"
-	UserGrantChangedType        = userGrantEventTypePrefix + "changed"
-	UserGrantCascadeChangedType = userGrantEventTypePrefix + "cascade.changed"
-	UserGrantRemovedType        = userGrantEventTypePrefix + "removed"
-	UserGrantCascadeRemovedType = userGrantEventTypePrefix + "cascade.removed"
-	UserGrantDeactivatedType    = userGrantEventTypePrefix + "deactivated"
-	UserGrantReactivatedType    = userGrantEventTypePrefix + "reactivated"
+	UniqueUserGrant              = "user_grant"
+	userGrantEventTypePrefix     = eventstore.EventType("user.grant.")
+	UserGrantAddedType           = userGrantEventTypePrefix + "added"
+	UserGrantChangedType         = userGrantEventTypePrefix + "changed"
+	UserGrantCascadeChangedType  = userGrantEventTypePrefix + "cascade.changed"
+	UserGrantRemovedType         = userGrantEventTypePrefix + "removed"
+	UserGrantCascadeRemovedType  = userGrantEventTypePrefix + "cascade.removed"
+	UserGrantDeactivatedType     = userGrantEventTypePrefix + "deactivated"
+	UserGrantReactivatedType     = userGrantEventTypePrefix + "reactivated"
+	UserGrantValidityChangedType = userGrantEventTypePrefix + "validity.changed"
+	UserGrantExpiredType         = userGrantEventTypePrefix + "expired"
+	UserGrantRequestedType       = userGrantEventTypePrefix + "requested"
+	UserGrantApprovedType        = userGrantEventTypePrefix + "approved"
+	UserGrantRejectedType        = userGrantEventTypePrefix + "rejected"
 )
 
 func NewAddUserGrantUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.EventUniqueConstraint {
@@ -301,3 +307,195 @@ func UserGrantReactivatedEventMapper(event *repository.Event) (eventstore.Event,
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+type UserGrantValidityChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	ValidFrom            time.Time `json:"validFrom,omitempty"`
+	ValidUntil           time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *UserGrantValidityChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantValidityChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserGrantValidityChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validFrom,
+	validUntil time.Time,
+) *UserGrantValidityChangedEvent {
+	return &UserGrantValidityChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantValidityChangedType,
+		),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+func UserGrantValidityChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantValidityChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Vb2hq", "unable to unmarshal user grant validity")
+	}
+
+	return e, nil
+}
+
+type UserGrantExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserGrantExpiredEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UserGrantExpiredEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiredEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserGrantExpiredEvent {
+	return &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiredType,
+		),
+	}
+}
+
+func UserGrantExpiredEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// UserGrantRequestedEvent creates a user grant which is only effective after it got approved
+type UserGrantRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string   `json:"userId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+func (e *UserGrantRequestedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserGrantRequestedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewUserGrantRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string) *UserGrantRequestedEvent {
+	return &UserGrantRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRequestedType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+func UserGrantRequestedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &UserGrantRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "UGRANT-Rq8sd", "unable to unmarshal user grant")
+	}
+
+	return e, nil
+}
+
+type UserGrantApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserGrantApprovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UserGrantApprovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserGrantApprovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserGrantApprovedEvent {
+	return &UserGrantApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantApprovedType,
+		),
+	}
+}
+
+func UserGrantApprovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UserGrantApprovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+// UserGrantRejectedEvent removes a requested user grant
+type UserGrantRejectedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	userID               string `json:"-"`
+	projectID            string `json:"-"`
+	projectGrantID       string `json:"-"`
+}
+
+func (e *UserGrantRejectedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UserGrantRejectedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.userID, e.projectID, e.projectGrantID)}
+}
+
+func NewUserGrantRejectedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+) *UserGrantRejectedEvent {
+	return &UserGrantRejectedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantRejectedType,
+		),
+		userID:         userID,
+		projectID:      projectID,
+		projectGrantID: projectGrantID,
+	}
+}
+
+func UserGrantRejectedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UserGrantRejectedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
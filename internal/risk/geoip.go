@@ -0,0 +1,150 @@
+package risk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const earthRadiusKM = 6371
+
+type Location struct {
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// DistanceKM returns the great-circle distance between the locations
+func (l *Location) DistanceKM(other *Location) float64 {
+	lat1, lat2 := radians(l.Latitude), radians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(other.Longitude - l.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKM * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+type ipRange struct {
+	start    net.IP
+	end      net.IP
+	location *Location
+}
+
+// GeoIPDatabase is a local lookup table of IP ranges.
+//
+// Each line of the CSV consists of the first and last IP of the range,
+// the ISO 3166 country code, latitude and longitude, e.g.:
+// 192.0.2.0,192.0.2.255,CH,47.37,8.54
+type GeoIPDatabase struct {
+	v4 []*ipRange
+	v6 []*ipRange
+}
+
+// LoadGeoIPDatabase reads the database from the CSV file at path
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "RISK-Geo1a", "unable to open geoip database")
+	}
+	defer file.Close()
+	return ReadGeoIPDatabase(file)
+}
+
+// ReadGeoIPDatabase parses the CSV lines of r, lines starting with # are ignored
+func ReadGeoIPDatabase(r io.Reader) (*GeoIPDatabase, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 5
+	reader.TrimLeadingSpace = true
+	db := new(GeoIPDatabase)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.ThrowInvalidArgument(err, "RISK-Geo2b", "unable to read geoip database")
+		}
+		entry, err := parseRange(record)
+		if err != nil {
+			return nil, err
+		}
+		if len(entry.start) == net.IPv4len {
+			db.v4 = append(db.v4, entry)
+			continue
+		}
+		db.v6 = append(db.v6, entry)
+	}
+	sortRanges(db.v4)
+	sortRanges(db.v6)
+	return db, nil
+}
+
+func parseRange(record []string) (*ipRange, error) {
+	start, end := normalizeIP(net.ParseIP(record[0])), normalizeIP(net.ParseIP(record[1]))
+	if start == nil || end == nil || len(start) != len(end) || bytes.Compare(start, end) > 0 {
+		return nil, errors.ThrowInvalidArgumentf(nil, "RISK-Geo3c", "invalid ip range %s - %s", record[0], record[1])
+	}
+	latitude, err := strconv.ParseFloat(record[3], 64)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgumentf(err, "RISK-Geo4d", "invalid latitude %s", record[3])
+	}
+	longitude, err := strconv.ParseFloat(record[4], 64)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgumentf(err, "RISK-Geo5e", "invalid longitude %s", record[4])
+	}
+	return &ipRange{
+		start: start,
+		end:   end,
+		location: &Location{
+			Country:   strings.ToUpper(record[2]),
+			Latitude:  latitude,
+			Longitude: longitude,
+		},
+	}, nil
+}
+
+func sortRanges(ranges []*ipRange) {
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+}
+
+// Locate returns the location of the range containing ip.
+// Ranges are expected not to overlap.
+func (db *GeoIPDatabase) Locate(ip net.IP) (*Location, bool) {
+	ip = normalizeIP(ip)
+	if ip == nil {
+		return nil, false
+	}
+	ranges := db.v6
+	if len(ip) == net.IPv4len {
+		ranges = db.v4
+	}
+	i := sort.Search(len(ranges), func(i int) bool {
+		return bytes.Compare(ranges[i].start, ip) > 0
+	})
+	if i == 0 || bytes.Compare(ip, ranges[i-1].end) > 0 {
+		return nil, false
+	}
+	return ranges[i-1].location, true
+}
+
+// normalizeIP returns the 4 byte representation of IPv4 addresses
+func normalizeIP(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
@@ -0,0 +1,95 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+const testGeoIPDatabase = `# start,end,country,latitude,longitude
+198.51.100.0,198.51.100.255,us,40.71,-74.01
+192.0.2.0,192.0.2.255,CH,47.37,8.54
+2001:db8::,2001:db8:ffff:ffff:ffff:ffff:ffff:ffff,JP,35.68,139.69
+`
+
+func TestGeoIPDatabase_Locate(t *testing.T) {
+	db, err := ReadGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ip      string
+		country string
+		found   bool
+	}{
+		{
+			name:    "start of range",
+			ip:      "192.0.2.0",
+			country: "CH",
+			found:   true,
+		},
+		{
+			name:    "end of range",
+			ip:      "198.51.100.255",
+			country: "US",
+			found:   true,
+		},
+		{
+			name:    "ipv4 mapped ipv6",
+			ip:      "::ffff:192.0.2.10",
+			country: "CH",
+			found:   true,
+		},
+		{
+			name:    "ipv6",
+			ip:      "2001:db8::1",
+			country: "JP",
+			found:   true,
+		},
+		{
+			name:  "between ranges",
+			ip:    "192.0.3.1",
+			found: false,
+		},
+		{
+			name:  "before first range",
+			ip:    "10.0.0.1",
+			found: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, found := db.Locate(net.ParseIP(tt.ip))
+			require.Equal(t, tt.found, found)
+			if found {
+				assert.Equal(t, tt.country, location.Country)
+			}
+		})
+	}
+}
+
+func TestReadGeoIPDatabase_invalid(t *testing.T) {
+	_, err := ReadGeoIPDatabase(strings.NewReader("192.0.2.255,192.0.2.0,CH,47.37,8.54"))
+	assert.True(t, errors.IsErrorInvalidArgument(err))
+
+	_, err = ReadGeoIPDatabase(strings.NewReader("192.0.2.0,2001:db8::,CH,47.37,8.54"))
+	assert.True(t, errors.IsErrorInvalidArgument(err))
+
+	_, err = ReadGeoIPDatabase(strings.NewReader("192.0.2.0,192.0.2.255,CH,north,8.54"))
+	assert.True(t, errors.IsErrorInvalidArgument(err))
+
+	_, err = ReadGeoIPDatabase(strings.NewReader("192.0.2.0,192.0.2.255,CH"))
+	assert.True(t, errors.IsErrorInvalidArgument(err))
+}
+
+func TestLocation_DistanceKM(t *testing.T) {
+	zurich := &Location{Latitude: 47.37, Longitude: 8.54}
+	newYork := &Location{Latitude: 40.71, Longitude: -74.01}
+	assert.InDelta(t, 6320, zurich.DistanceKM(newYork), 20)
+	assert.Zero(t, zurich.DistanceKM(zurich))
+}
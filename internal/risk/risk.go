@@ -0,0 +1,204 @@
+package risk
+
+import (
+	"net"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// defaultHistoryWindow is used if no HistoryWindow is configured
+const defaultHistoryWindow = 90 * 24 * time.Hour
+
+type Config struct {
+	// GeoIPDatabase is the path of a CSV file mapping IP ranges to countries and coordinates,
+	// country and travel signals are skipped if empty
+	GeoIPDatabase string
+	// HistoryWindow defines how long the successful logins of a user are used to recognise known devices, networks and countries
+	HistoryWindow time.Duration
+}
+
+// NewEvaluator creates an evaluator with the configured GeoIP database
+func (c *Config) NewEvaluator() (*Evaluator, error) {
+	evaluator := NewEvaluator(nil)
+	if c.HistoryWindow > 0 {
+		evaluator.historyWindow = c.HistoryWindow
+	}
+	if c.GeoIPDatabase == "" {
+		return evaluator, nil
+	}
+	db, err := LoadGeoIPDatabase(c.GeoIPDatabase)
+	if err != nil {
+		return nil, err
+	}
+	evaluator.locator = db
+	return evaluator, nil
+}
+
+// Locator resolves the location of an IP
+type Locator interface {
+	Locate(ip net.IP) (*Location, bool)
+}
+
+// Attempt is the login attempt to evaluate
+type Attempt struct {
+	UserAgentID string
+	IP          net.IP
+	Time        time.Time
+}
+
+// Record is a previous first factor check of the user
+type Record struct {
+	UserAgentID string
+	IP          net.IP
+	Time        time.Time
+	Succeeded   bool
+}
+
+type Evaluator struct {
+	locator       Locator
+	historyWindow time.Duration
+	now           func() time.Time
+}
+
+func NewEvaluator(locator Locator) *Evaluator {
+	return &Evaluator{
+		locator:       locator,
+		historyWindow: defaultHistoryWindow,
+		now:           time.Now,
+	}
+}
+
+// HistorySince returns the time from which on the previous checks of the user are relevant for the rules.
+// It is zero if no rule uses the history.
+func (e *Evaluator) HistorySince(rules *domain.RiskRules, now time.Time) time.Time {
+	if rules == nil || !rules.Enabled {
+		return time.Time{}
+	}
+	var window time.Duration
+	if rules.NewDevice || rules.NewNetwork || rules.NewCountry || rules.ImpossibleTravelSpeed > 0 {
+		window = e.historyWindow
+	}
+	if rules.MaxRecentFailures > 0 && rules.RecentFailuresWindow > window {
+		window = rules.RecentFailuresWindow
+	}
+	if window == 0 {
+		return time.Time{}
+	}
+	return now.Add(-window)
+}
+
+// Evaluate checks the attempt against the previous checks of the user (ordered by time).
+// Signals based on unknown devices, networks and countries are only reported
+// if the user already logged in successfully before.
+func (e *Evaluator) Evaluate(rules *domain.RiskRules, attempt *Attempt, history []*Record) *domain.RiskAssessment {
+	assessment := &domain.RiskAssessment{
+		Level:       domain.RiskLevelLow,
+		EvaluatedAt: e.now(),
+	}
+	if attempt.Time.IsZero() {
+		attempt.Time = assessment.EvaluatedAt
+	}
+	location := e.locate(attempt.IP)
+	if location != nil {
+		assessment.Country = location.Country
+	}
+	if rules == nil || !rules.Enabled {
+		return assessment
+	}
+
+	known := newKnownOrigins()
+	var lastSuccess *Record
+	var lastLocation *Location
+	var failures uint32
+	for _, record := range history {
+		if !record.Succeeded {
+			if rules.MaxRecentFailures > 0 && record.Time.After(attempt.Time.Add(-rules.RecentFailuresWindow)) {
+				failures++
+			}
+			continue
+		}
+		recordLocation := e.locate(record.IP)
+		known.add(record, recordLocation)
+		lastSuccess = record
+		lastLocation = recordLocation
+	}
+
+	if lastSuccess != nil {
+		if rules.NewDevice && attempt.UserAgentID != "" && !known.devices[attempt.UserAgentID] {
+			assessment.Signals = append(assessment.Signals, domain.RiskSignalNewDevice)
+		}
+		if rules.NewNetwork && attempt.IP != nil && !known.networks[network(attempt.IP)] {
+			assessment.Signals = append(assessment.Signals, domain.RiskSignalNewNetwork)
+		}
+		if rules.NewCountry && location != nil && len(known.countries) > 0 && !known.countries[location.Country] {
+			assessment.Signals = append(assessment.Signals, domain.RiskSignalNewCountry)
+		}
+		if rules.ImpossibleTravelSpeed > 0 && location != nil && lastLocation != nil &&
+			isImpossibleTravel(lastLocation, location, attempt.Time.Sub(lastSuccess.Time), rules.ImpossibleTravelSpeed) {
+			assessment.Signals = append(assessment.Signals, domain.RiskSignalImpossibleTravel)
+		}
+	}
+	if rules.MaxRecentFailures > 0 && failures >= rules.MaxRecentFailures {
+		assessment.Signals = append(assessment.Signals, domain.RiskSignalRecentFailures)
+	}
+	if len(assessment.Signals) > 0 {
+		assessment.Level = domain.RiskLevelElevated
+	}
+	return assessment
+}
+
+func (e *Evaluator) locate(ip net.IP) *Location {
+	if e.locator == nil || ip == nil {
+		return nil
+	}
+	location, ok := e.locator.Locate(ip)
+	if !ok {
+		return nil
+	}
+	return location
+}
+
+type knownOrigins struct {
+	devices   map[string]bool
+	networks  map[string]bool
+	countries map[string]bool
+}
+
+func newKnownOrigins() *knownOrigins {
+	return &knownOrigins{
+		devices:   make(map[string]bool),
+		networks:  make(map[string]bool),
+		countries: make(map[string]bool),
+	}
+}
+
+func (k *knownOrigins) add(record *Record, location *Location) {
+	if record.UserAgentID != "" {
+		k.devices[record.UserAgentID] = true
+	}
+	if record.IP != nil {
+		k.networks[network(record.IP)] = true
+	}
+	if location != nil {
+		k.countries[location.Country] = true
+	}
+}
+
+// network returns the /24 range of IPv4 and the /48 range of IPv6 addresses
+func network(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// minTravelDuration prevents inaccurate locations of consecutive logins from being reported as impossible travel
+const minTravelDuration = 5 * time.Minute
+
+func isImpossibleTravel(from, to *Location, elapsed time.Duration, maxSpeed uint32) bool {
+	if elapsed < minTravelDuration {
+		elapsed = minTravelDuration
+	}
+	return from.DistanceKM(to)/elapsed.Hours() > float64(maxSpeed)
+}
@@ -0,0 +1,227 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	db, err := ReadGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	allRules := &domain.RiskRules{
+		Enabled:               true,
+		NewDevice:             true,
+		NewNetwork:            true,
+		NewCountry:            true,
+		ImpossibleTravelSpeed: 1000,
+		MaxRecentFailures:     3,
+		RecentFailuresWindow:  time.Hour,
+	}
+	knownLogin := &Record{
+		UserAgentID: "agent1",
+		IP:          net.ParseIP("192.0.2.10"),
+		Time:        now.Add(-24 * time.Hour),
+		Succeeded:   true,
+	}
+	failure := func(ago time.Duration) *Record {
+		return &Record{
+			UserAgentID: "agent1",
+			IP:          net.ParseIP("192.0.2.10"),
+			Time:        now.Add(-ago),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		rules   *domain.RiskRules
+		attempt *Attempt
+		history []*Record
+		level   domain.RiskLevel
+		signals []domain.RiskSignal
+		country string
+	}{
+		{
+			name:  "disabled",
+			rules: &domain.RiskRules{NewDevice: true},
+			attempt: &Attempt{
+				UserAgentID: "agent2",
+				IP:          net.ParseIP("192.0.2.10"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin},
+			level:   domain.RiskLevelLow,
+			country: "CH",
+		},
+		{
+			name:  "known origin",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("192.0.2.99"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin},
+			level:   domain.RiskLevelLow,
+			country: "CH",
+		},
+		{
+			name:  "first login",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent2",
+				IP:          net.ParseIP("198.51.100.1"),
+				Time:        now,
+			},
+			level:   domain.RiskLevelLow,
+			country: "US",
+		},
+		{
+			name:  "new device",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent2",
+				IP:          net.ParseIP("192.0.2.10"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin},
+			level:   domain.RiskLevelElevated,
+			signals: []domain.RiskSignal{domain.RiskSignalNewDevice},
+			country: "CH",
+		},
+		{
+			name:  "new network and country",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("198.51.100.1"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin},
+			level:   domain.RiskLevelElevated,
+			signals: []domain.RiskSignal{domain.RiskSignalNewNetwork, domain.RiskSignalNewCountry},
+			country: "US",
+		},
+		{
+			name:  "impossible travel",
+			rules: &domain.RiskRules{Enabled: true, ImpossibleTravelSpeed: 1000},
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("198.51.100.1"),
+				Time:        now,
+			},
+			history: []*Record{
+				knownLogin,
+				{
+					UserAgentID: "agent1",
+					IP:          net.ParseIP("192.0.2.10"),
+					Time:        now.Add(-time.Hour),
+					Succeeded:   true,
+				},
+			},
+			level:   domain.RiskLevelElevated,
+			signals: []domain.RiskSignal{domain.RiskSignalImpossibleTravel},
+			country: "US",
+		},
+		{
+			name:  "possible travel",
+			rules: &domain.RiskRules{Enabled: true, ImpossibleTravelSpeed: 1000},
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("198.51.100.1"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin},
+			level:   domain.RiskLevelLow,
+			country: "US",
+		},
+		{
+			name:  "recent failures",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("192.0.2.10"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin, failure(2 * time.Hour), failure(30 * time.Minute), failure(20 * time.Minute), failure(time.Minute)},
+			level:   domain.RiskLevelElevated,
+			signals: []domain.RiskSignal{domain.RiskSignalRecentFailures},
+			country: "CH",
+		},
+		{
+			name:  "failures outside of window",
+			rules: allRules,
+			attempt: &Attempt{
+				UserAgentID: "agent1",
+				IP:          net.ParseIP("192.0.2.10"),
+				Time:        now,
+			},
+			history: []*Record{knownLogin, failure(3 * time.Hour), failure(2 * time.Hour), failure(time.Minute)},
+			level:   domain.RiskLevelLow,
+			country: "CH",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEvaluator(db)
+			e.now = func() time.Time { return now }
+			got := e.Evaluate(tt.rules, tt.attempt, tt.history)
+			assert.Equal(t, tt.level, got.Level)
+			assert.Equal(t, tt.signals, got.Signals)
+			assert.Equal(t, tt.country, got.Country)
+			assert.Equal(t, now, got.EvaluatedAt)
+		})
+	}
+}
+
+func TestEvaluator_Evaluate_withoutGeoIP(t *testing.T) {
+	got := NewEvaluator(nil).Evaluate(
+		&domain.RiskRules{Enabled: true, NewCountry: true, ImpossibleTravelSpeed: 1},
+		&Attempt{IP: net.ParseIP("198.51.100.1")},
+		[]*Record{{IP: net.ParseIP("192.0.2.10"), Time: time.Now(), Succeeded: true}},
+	)
+	assert.Equal(t, domain.RiskLevelLow, got.Level)
+	assert.Empty(t, got.Signals)
+}
+
+func TestEvaluator_HistorySince(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		rules *domain.RiskRules
+		want  time.Time
+	}{
+		{
+			name:  "disabled, zero",
+			rules: &domain.RiskRules{NewDevice: true},
+			want:  time.Time{},
+		},
+		{
+			name:  "no rule using the history, zero",
+			rules: &domain.RiskRules{Enabled: true},
+			want:  time.Time{},
+		},
+		{
+			name:  "known origins, history window",
+			rules: &domain.RiskRules{Enabled: true, NewNetwork: true, MaxRecentFailures: 3, RecentFailuresWindow: time.Hour},
+			want:  now.Add(-defaultHistoryWindow),
+		},
+		{
+			name:  "recent failures only, failures window",
+			rules: &domain.RiskRules{Enabled: true, MaxRecentFailures: 3, RecentFailuresWindow: time.Hour},
+			want:  now.Add(-time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewEvaluator(nil).HistorySince(tt.rules, now))
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package shard
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+const (
+	moveBatchSize = 1000
+
+	// projectionTablesStmt selects the tables of the projections containing the data of instances
+	projectionTablesStmt = "SELECT c.table_name FROM information_schema.columns c" +
+		" JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name" +
+		" WHERE c.table_schema = 'projections' AND c.column_name = 'instance_id' AND t.table_type = 'BASE TABLE'"
+)
+
+var instanceIDRegexp = regexp.MustCompile(`^[0-9a-zA-Z]+$`)
+
+type Config struct {
+	// GracePeriod is the time the requests of an instance have to finish
+	// after the instance is marked as moving and before its data is copied
+	GracePeriod time.Duration
+}
+
+type instanceColumn struct {
+	name string
+	typ  string
+}
+
+// instanceTable describes the data of an instance which cannot be derived from the (not archived) events
+// and therefore has to be moved together with the events.
+// The personal data keys are not moved, they are stored in the default database for all shards.
+type instanceTable struct {
+	name    string
+	columns []instanceColumn
+}
+
+var (
+	eventColumns = []instanceColumn{
+		{"id", "UUID"},
+		{"event_type", "TEXT"},
+		{"aggregate_type", "TEXT"},
+		{"aggregate_id", "TEXT"},
+		{"aggregate_version", "TEXT"},
+		{"event_sequence", "BIGINT"},
+		{"previous_aggregate_sequence", "BIGINT"},
+		{"previous_aggregate_type_sequence", "INT8"},
+		{"creation_date", "TIMESTAMPTZ"},
+		{"event_data", "JSONB"},
+		{"editor_user", "TEXT"},
+		{"editor_service", "TEXT"},
+		{"resource_owner", "TEXT"},
+		{"instance_id", "TEXT"},
+		{"created_at", "TIMESTAMPTZ"},
+	}
+
+	instanceTables = []instanceTable{
+		{
+			name:    "eventstore.events",
+			columns: eventColumns,
+		},
+		{
+			name:    "eventstore.events_archive",
+			columns: append(eventColumns[:len(eventColumns):len(eventColumns)], instanceColumn{"archived_at", "TIMESTAMPTZ"}),
+		},
+		{
+			name: "eventstore.unique_constraints",
+			columns: []instanceColumn{
+				{"instance_id", "TEXT"},
+				{"unique_type", "TEXT"},
+				{"unique_field", "TEXT"},
+			},
+		},
+		{
+			name: "eventstore.archived_sequences",
+			columns: []instanceColumn{
+				{"instance_id", "TEXT"},
+				{"aggregate_type", "TEXT"},
+				{"sequence", "BIGINT"},
+			},
+		},
+		{
+			name: "eventstore.snapshots",
+			columns: []instanceColumn{
+				{"instance_id", "TEXT"},
+				{"aggregate_id", "TEXT"},
+				{"snapshot_type", "TEXT"},
+				{"resource_owner", "TEXT"},
+				{"version", "TEXT"},
+				{"sequence", "BIGINT"},
+				{"change_date", "TIMESTAMPTZ"},
+				{"state", "JSONB"},
+				{"created_at", "TIMESTAMPTZ"},
+			},
+		},
+		{
+			name: "system.assets",
+			columns: []instanceColumn{
+				{"instance_id", "TEXT"},
+				{"asset_type", "TEXT"},
+				{"resource_owner", "TEXT"},
+				{"name", "TEXT"},
+				{"content_type", "TEXT"},
+				{"data", "BYTEA"},
+				{"updated_at", "TIMESTAMPTZ"},
+			},
+		},
+	}
+)
+
+// Mover moves instances between the shards
+type Mover struct {
+	client         *database.DB
+	gracePeriod    time.Duration
+	routingChanged func()
+}
+
+// NewMover creates a mover of instances,
+// routingChanged is called as soon as the routing of an instance changed, e.g. to invalidate caches
+func NewMover(client *database.DB, config *Config, routingChanged func()) *Mover {
+	mover := &Mover{
+		client:         client,
+		routingChanged: routingChanged,
+	}
+	if config != nil {
+		mover.gracePeriod = config.GracePeriod
+	}
+	return mover
+}
+
+// Move moves the instance to the shard by copying its events, unique constraints, snapshots and assets.
+// The instance is unavailable during the move, the eventstore rejects its events as soon as it is marked as moving.
+// Data of the instance left in the shard by a previous or failed move is removed before the copy,
+// so a failed move can be restarted. After the routing is switched, the data is removed from the previous shard
+// and the projections of the new shard replay the events.
+func (m *Mover) Move(ctx context.Context, instanceID, target string) (err error) {
+	if !instanceIDRegexp.MatchString(instanceID) {
+		return errors.ThrowInvalidArgument(nil, "SHARD-Iu8qa", "Errors.Instance.IDMissing")
+	}
+	if !m.client.HasShard(target) {
+		return errors.ThrowInvalidArgument(nil, "SHARD-Qk2ds", "Errors.Instance.Shard.NotFound")
+	}
+	source, err := m.client.ShardOf(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if source == target {
+		return errors.ThrowPreconditionFailed(nil, "SHARD-Wm4cn", "Errors.Instance.Shard.Unchanged")
+	}
+
+	if err = m.client.SetMoving(ctx, instanceID, source); err != nil {
+		return err
+	}
+	m.routingChanged()
+	defer func() {
+		if err == nil {
+			return
+		}
+		// the instance stays available on its previous shard
+		resetErr := m.client.SetShard(ctx, instanceID, source)
+		logging.WithFields("instance", instanceID, "shard", source).OnError(resetErr).Error("unable to reset shard of instance")
+		m.routingChanged()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(m.gracePeriod):
+	}
+
+	sourceDB := m.client.Shard(database.WithShard(ctx, source)).DB
+	move := &instanceMove{
+		source:     sourceDB,
+		target:     m.client.Shard(database.WithShard(ctx, target)).DB,
+		instanceID: instanceID,
+	}
+	if err = move.execute(ctx); err != nil {
+		return errors.ThrowInternal(err, "SHARD-Hc3ow", "Errors.Instance.Shard.MoveFailed")
+	}
+
+	if err = m.client.SetShard(ctx, instanceID, target); err != nil {
+		return err
+	}
+	m.routingChanged()
+	logging.WithFields("instance", instanceID, "source", source, "target", target).Info("instance moved")
+
+	// the instance is already routed to the new shard, therefore the move succeeded even if the removal fails
+	removeErr := removeInstance(ctx, sourceDB, instanceID)
+	logging.WithFields("instance", instanceID, "shard", source).OnError(removeErr).Warn("unable to remove data of moved instance from previous shard")
+
+	// the projections replay the events in the background, the scheduler of the shard retries on failure
+	go func(ctx context.Context) {
+		err := projection.Trigger(ctx, instanceID)
+		logging.WithFields("instance", instanceID, "shard", target).OnError(err).Warn("unable to reduce events of moved instance")
+	}(database.WithShard(context.Background(), target))
+	return nil
+}
+
+// instanceMove copies all data of an instance from the source to the target database
+type instanceMove struct {
+	source     *sql.DB
+	target     *sql.DB
+	instanceID string
+}
+
+func (m *instanceMove) execute(ctx context.Context) error {
+	if err := removeInstance(ctx, m.target, m.instanceID); err != nil {
+		return err
+	}
+	for _, table := range instanceTables {
+		count, err := m.copyTable(ctx, table)
+		if err != nil {
+			return err
+		}
+		logging.WithFields("table", table.name, "instance", m.instanceID, "rows", count).Info("table copied")
+	}
+	return m.continueSequence(ctx)
+}
+
+func (m *instanceMove) copyTable(ctx context.Context, table instanceTable) (count int, err error) {
+	selects := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		// the values are transferred as text to be independent of the types of the driver
+		selects[i] = column.name + "::TEXT"
+	}
+	rows, err := m.source.QueryContext(ctx,
+		"SELECT "+strings.Join(selects, ", ")+" FROM "+table.name+" WHERE instance_id = $1",
+		m.instanceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	batch := make([][]interface{}, 0, moveBatchSize)
+	for rows.Next() {
+		values := make([]sql.NullString, len(table.columns))
+		dest := make([]interface{}, len(values))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return count, err
+		}
+		row := make([]interface{}, len(values))
+		for i, value := range values {
+			if value.Valid {
+				row[i] = value.String
+			}
+		}
+		batch = append(batch, row)
+		if len(batch) < moveBatchSize {
+			continue
+		}
+		if err = m.insert(ctx, table, batch); err != nil {
+			return count, err
+		}
+		count += len(batch)
+		batch = batch[:0]
+	}
+	if err = rows.Err(); err != nil {
+		return count, err
+	}
+	if err = m.insert(ctx, table, batch); err != nil {
+		return count, err
+	}
+	return count + len(batch), nil
+}
+
+func (m *instanceMove) insert(ctx context.Context, table instanceTable, batch [][]interface{}) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	names := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		names[i] = column.name
+	}
+	insert := sq.Insert(table.name).
+		Columns(names...).
+		PlaceholderFormat(sq.Dollar)
+	for _, row := range batch {
+		values := make([]interface{}, len(row))
+		for i, value := range row {
+			values[i] = sq.Expr("?::"+table.columns[i].typ, value)
+		}
+		insert = insert.Values(values...)
+	}
+	stmt, args, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = m.target.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+// continueSequence makes sure new events of the instance on the target
+// get a sequence after the moved events
+func (m *instanceMove) continueSequence(ctx context.Context) error {
+	var latest int64
+	err := m.target.QueryRowContext(ctx,
+		"SELECT GREATEST("+
+			"(SELECT COALESCE(MAX(event_sequence), 0) FROM eventstore.events WHERE instance_id = $1), "+
+			"(SELECT COALESCE(MAX(event_sequence), 0) FROM eventstore.events_archive WHERE instance_id = $1))",
+		m.instanceID,
+	).Scan(&latest)
+	if err != nil {
+		return err
+	}
+	sequenceName := "eventstore.i_" + m.instanceID + "_seq"
+	if _, err = m.target.ExecContext(ctx, "CREATE SEQUENCE IF NOT EXISTS "+sequenceName); err != nil {
+		return err
+	}
+	if latest == 0 {
+		return nil
+	}
+	_, err = m.target.ExecContext(ctx, "SELECT setval($1, $2)", sequenceName, latest)
+	return err
+}
+
+// removeInstance removes the data and the projections of the instance from the database
+func removeInstance(ctx context.Context, db *sql.DB, instanceID string) error {
+	tables := make([]string, 0, len(instanceTables))
+	for _, table := range instanceTables {
+		tables = append(tables, table.name)
+	}
+	rows, err := db.QueryContext(ctx, projectionTablesStmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			return err
+		}
+		tables = append(tables, "projections."+table)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if _, err = db.ExecContext(ctx, "DELETE FROM "+table+" WHERE instance_id = $1", instanceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
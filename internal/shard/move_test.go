@@ -0,0 +1,126 @@
+package shard
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+)
+
+func TestMover_Move_invalid(t *testing.T) {
+	mover := NewMover(&database.DB{}, nil, func() {
+		t.Error("routing must not change")
+	})
+	tests := []struct {
+		name       string
+		instanceID string
+		shard      string
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "invalid instance id",
+			instanceID: "instance' OR 1=1",
+			shard:      "",
+			wantErr:    errors.IsErrorInvalidArgument,
+		},
+		{
+			name:       "shard not configured",
+			instanceID: "instance",
+			shard:      "eu2",
+			wantErr:    errors.IsErrorInvalidArgument,
+		},
+		{
+			name:       "shard unchanged",
+			instanceID: "instance",
+			shard:      "",
+			wantErr:    errors.IsPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mover.Move(context.Background(), tt.instanceID, tt.shard)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestInstanceMove_copyTable(t *testing.T) {
+	source, sourceMock, err := sqlmock.New()
+	require.NoError(t, err)
+	target, targetMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	table := instanceTable{
+		name: "eventstore.unique_constraints",
+		columns: []instanceColumn{
+			{"instance_id", "TEXT"},
+			{"unique_type", "TEXT"},
+			{"unique_field", "TEXT"},
+		},
+	}
+	sourceMock.ExpectQuery(regexp.QuoteMeta("SELECT instance_id::TEXT, unique_type::TEXT, unique_field::TEXT FROM eventstore.unique_constraints WHERE instance_id = $1")).
+		WithArgs("instance").
+		WillReturnRows(sqlmock.NewRows([]string{"instance_id", "unique_type", "unique_field"}).
+			AddRow("instance", "usernames", "gigi").
+			AddRow("instance", "org_name", "caos"),
+		)
+	targetMock.ExpectExec(regexp.QuoteMeta("INSERT INTO eventstore.unique_constraints (instance_id,unique_type,unique_field) VALUES ($1::TEXT,$2::TEXT,$3::TEXT),($4::TEXT,$5::TEXT,$6::TEXT)")).
+		WithArgs("instance", "usernames", "gigi", "instance", "org_name", "caos").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	move := &instanceMove{source: source, target: target, instanceID: "instance"}
+	count, err := move.copyTable(context.Background(), table)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, sourceMock.ExpectationsWereMet())
+	assert.NoError(t, targetMock.ExpectationsWereMet())
+}
+
+func TestInstanceMove_continueSequence(t *testing.T) {
+	target, targetMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	targetMock.ExpectQuery(regexp.QuoteMeta("SELECT GREATEST(")).
+		WithArgs("instance").
+		WillReturnRows(sqlmock.NewRows([]string{"greatest"}).AddRow(42))
+	targetMock.ExpectExec(regexp.QuoteMeta("CREATE SEQUENCE IF NOT EXISTS eventstore.i_instance_seq")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	targetMock.ExpectExec(regexp.QuoteMeta("SELECT setval($1, $2)")).
+		WithArgs("eventstore.i_instance_seq", 42).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	move := &instanceMove{target: target, instanceID: "instance"}
+	require.NoError(t, move.continueSequence(context.Background()))
+	assert.NoError(t, targetMock.ExpectationsWereMet())
+}
+
+func TestRemoveInstance(t *testing.T) {
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(projectionTablesStmt)).
+		WillReturnRows(sqlmock.NewRows([]string{"table_name"}).
+			AddRow("users8").
+			AddRow("current_sequences"),
+		)
+	for _, table := range instanceTables {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM " + table.name + " WHERE instance_id = $1")).
+			WithArgs("instance").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM projections.users8 WHERE instance_id = $1")).
+		WithArgs("instance").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM projections.current_sequences WHERE instance_id = $1")).
+		WithArgs("instance").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, removeInstance(context.Background(), client, "instance"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
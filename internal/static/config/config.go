@@ -1,9 +1,8 @@
 package config
 
 import (
-	"database/sql"
-
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	z_db "github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/static/database"
@@ -16,7 +15,7 @@ type AssetStorageConfig struct {
 	Config map[string]interface{} `mapstructure:",remain"`
 }
 
-func (a *AssetStorageConfig) NewStorage(client *sql.DB) (static.Storage, error) {
+func (a *AssetStorageConfig) NewStorage(client *z_db.DB) (static.Storage, error) {
 	t, ok := storage[a.Type]
 	if !ok {
 		return nil, errors.ThrowInternalf(nil, "STATIC-dsbjh", "config type %s not supported", a.Type)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Masterminds/squirrel"
 
+	z_db "github.com/zitadel/zitadel/internal/database"
 	caos_errors "github.com/zitadel/zitadel/internal/errors"
 	"github.com/zitadel/zitadel/internal/static"
 )
@@ -29,11 +30,12 @@ const (
 	AssetColUpdatedAt     = "updated_at"
 )
 
+// crdbStorage stores the assets of an instance in the database of its shard
 type crdbStorage struct {
-	client *sql.DB
+	client *z_db.DB
 }
 
-func NewStorage(client *sql.DB, _ map[string]interface{}) (static.Storage, error) {
+func NewStorage(client *z_db.DB, _ map[string]interface{}) (static.Storage, error) {
 	return &crdbStorage{client: client}, nil
 }
 
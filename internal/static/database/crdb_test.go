@@ -13,6 +13,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 
+	z_db "github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/static"
 )
 
@@ -112,7 +113,7 @@ func Test_crdbStorage_CreateObject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &crdbStorage{
-				client: tt.fields.client.db,
+				client: &z_db.DB{DB: tt.fields.client.db},
 			}
 			got, err := c.PutObject(tt.args.ctx, tt.args.instanceID, tt.args.location, tt.args.resourceOwner, tt.args.name, tt.args.contentType, tt.args.objectType, tt.args.data, tt.args.objectSize)
 			if (err != nil) != tt.wantErr {
@@ -166,7 +167,7 @@ func Test_crdbStorage_RemoveObject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &crdbStorage{
-				client: tt.fields.client.db,
+				client: &z_db.DB{DB: tt.fields.client.db},
 			}
 			err := c.RemoveObject(tt.args.ctx, tt.args.instanceID, tt.args.resourceOwner, tt.args.name)
 			if (err != nil) != tt.wantErr {
@@ -216,7 +217,7 @@ func Test_crdbStorage_RemoveObjects(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &crdbStorage{
-				client: tt.fields.client.db,
+				client: &z_db.DB{DB: tt.fields.client.db},
 			}
 			err := c.RemoveObjects(tt.args.ctx, tt.args.instanceID, tt.args.resourceOwner, tt.args.objectType)
 			if (err != nil) != tt.wantErr {
@@ -260,7 +261,7 @@ func Test_crdbStorage_RemoveInstanceObjects(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &crdbStorage{
-				client: tt.fields.client.db,
+				client: &z_db.DB{DB: tt.fields.client.db},
 			}
 			err := c.RemoveInstanceObjects(tt.args.ctx, tt.args.instanceID)
 			if (err != nil) != tt.wantErr {
@@ -2,12 +2,13 @@ package static
 
 import (
 	"context"
-	"database/sql"
 	"io"
 	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
 )
 
-type CreateStorage func(client *sql.DB, rawConfig map[string]interface{}) (Storage, error)
+type CreateStorage func(client *database.DB, rawConfig map[string]interface{}) (Storage, error)
 
 type Storage interface {
 	PutObject(ctx context.Context, instanceID, location, resourceOwner, name, contentType string, objectType ObjectType, object io.Reader, objectSize int64) (*Asset, error)
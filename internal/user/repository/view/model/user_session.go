@@ -93,7 +93,9 @@ func (v *UserSessionView) AppendEvent(event *models.Event) error {
 	v.ChangeDate = event.CreationDate
 	switch eventstore.EventType(event.Type) {
 	case user.UserV1PasswordCheckSucceededType,
-		user.HumanPasswordCheckSucceededType:
+		user.HumanPasswordCheckSucceededType,
+		// a magic link is checked instead of the password and therefore counts as first factor
+		user.HumanMagicLinkCheckSucceededType:
 		v.PasswordVerification = event.CreationDate
 		v.State = int32(domain.UserSessionStateActive)
 	case user.UserIDPLoginCheckSucceededType:
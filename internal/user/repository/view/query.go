@@ -1,6 +1,8 @@
 package view
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/errors"
 	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/repository/user"
@@ -18,3 +20,18 @@ func UserByIDQuery(id, instanceID string, latestSequence uint64) (*es_models.Sea
 		InstanceIDFilter(instanceID).
 		SearchQuery(), nil
 }
+
+// UserEventsByTypesQuery returns the events of the given types of the user, which were created after the given time
+func UserEventsByTypesQuery(id, instanceID string, creationDate time.Time, eventTypes ...es_models.EventType) (*es_models.SearchQuery, error) {
+	if id == "" {
+		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-Rk2md", "Errors.User.UserIDMissing")
+	}
+	return es_models.NewSearchQuery().
+		AddQuery().
+		AggregateTypeFilter(user.AggregateType).
+		AggregateIDFilter(id).
+		EventTypesFilter(eventTypes...).
+		CreationDateNewerFilter(creationDate).
+		InstanceIDFilter(instanceID).
+		SearchQuery(), nil
+}
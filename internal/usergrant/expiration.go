@@ -0,0 +1,120 @@
+package usergrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/errors"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	locksTable    = "projections.locks"
+	expirationJob = "user_grant_expiration"
+	lockDuration  = 10 * time.Second
+)
+
+type ExpirationConfig struct {
+	// Enabled starts the background job which deactivates user grants after their validity ended
+	Enabled bool
+	// Interval defines how often the expired grants are searched
+	Interval time.Duration
+}
+
+type expiration struct {
+	commands *command.Commands
+	queries  *query.Queries
+	locker   crdb.Locker
+	interval time.Duration
+}
+
+// StartExpiration periodically searches all instances for user grants whose validity ended
+// and pushes an expired event for each of them
+// The instances are locked, so the grants of an instance are only expired by one node at a time.
+// The lock is released after the run, so any node can take over on the next interval.
+func StartExpiration(ctx context.Context, config *ExpirationConfig, commands *command.Commands, queries *query.Queries, client *database.DB) {
+	if config == nil || !config.Enabled {
+		return
+	}
+	e := &expiration{
+		commands: commands,
+		queries:  queries,
+		locker:   crdb.NewLocker(client.DB, locksTable, expirationJob),
+		interval: config.Interval,
+	}
+	go e.run(ctx)
+}
+
+func (e *expiration) run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.expireAll(ctx)
+		}
+	}
+}
+
+func (e *expiration) expireAll(ctx context.Context) {
+	instances, err := e.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		logging.WithError(err).Warn("unable to search instances for expired user grants")
+		return
+	}
+	for _, instance := range instances.Instances {
+		e.expireInstance(database.WithShard(authz.WithInstance(ctx, instance), instance.Shard()))
+	}
+}
+
+func (e *expiration) expireInstance(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := e.locker.Lock(ctx, lockDuration, authz.GetInstance(ctx).InstanceID())
+	err, ok := <-errs
+	if err != nil || !ok {
+		// another node expires the grants of the instance
+		if errors.IsErrorAlreadyExists(err) {
+			return
+		}
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID()).OnError(err).Warn("unable to lock instance for expired user grants")
+		return
+	}
+	defer func() {
+		// stop renewing the lock before it's released
+		cancel()
+		err := e.locker.Unlock(authz.GetInstance(ctx).InstanceID())
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID()).OnError(err).Warn("unable to unlock instance for expired user grants")
+	}()
+	// the lock is renewed until the grants are expired
+	go func() {
+		for err := range errs {
+			if err != nil && ctx.Err() == nil {
+				logging.WithFields("instance", authz.GetInstance(ctx).InstanceID()).WithError(err).Warn("lock for expired user grants lost")
+				cancel()
+			}
+		}
+	}()
+
+	grants, err := e.queries.ExpiredUserGrants(ctx, time.Now())
+	if err != nil {
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID()).WithError(err).Warn("unable to search expired user grants")
+		return
+	}
+	for _, grant := range grants.UserGrants {
+		if ctx.Err() != nil {
+			return
+		}
+		_, err = e.commands.ExpireUserGrant(ctx, grant.ID, grant.ResourceOwner)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "grant", grant.ID).OnError(err).Warn("unable to expire user grant")
+	}
+}
@@ -169,6 +169,25 @@ func LatestSequence(db *gorm.DB, table, viewName, instanceID string) (*CurrentSe
 	return nil, caos_errs.ThrowInternalf(err, "VIEW-9LyCB", "unable to get latest sequence of %s", viewName)
 }
 
+// LatestProjectionSequence returns the highest sequence the projection processed for the instance
+// projections store their sequences per aggregate type, the highest one is the latest processed event
+func LatestProjectionSequence(db *gorm.DB, table, projectionName, instanceID string) (*CurrentSequence, error) {
+	sequence := &CurrentSequence{
+		ViewName:   projectionName,
+		InstanceID: instanceID,
+	}
+	err := db.Table(table).
+		Select("current_sequence, timestamp AS event_timestamp").
+		Where("projection_name = ? AND instance_id = ?", projectionName, instanceID).
+		Order("current_sequence DESC").
+		Limit(1).
+		Scan(sequence).Error
+	if err == nil || gorm.IsRecordNotFoundError(err) {
+		return sequence, nil
+	}
+	return nil, caos_errs.ThrowInternalf(err, "VIEW-Kq3mS", "unable to get latest sequence of %s", projectionName)
+}
+
 func LatestSequences(db *gorm.DB, table, viewName string, instanceIDs []string) ([]*CurrentSequence, error) {
 	searchQueries := []sequenceSearchQuery{
 		{key: sequenceSearchKey(SequenceSearchKeyViewName), value: viewName, method: domain.SearchMethodEquals},